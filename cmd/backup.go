@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"syscall"
+
+	"ismartcoding/plainnas/internal/backup"
+	"ismartcoding/plainnas/internal/db"
+
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup <file>",
+	Short: "Export PlainNAS state (tags, settings, sessions, catalog) to a backup file",
+	Long: `Writes a snapshot of the database to a gzip-compressed JSON lines file.
+The database can only be opened by one process: while the service runs, use the
+GraphQL mutation backup instead.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if syscall.Getuid() != 0 {
+			return fmt.Errorf("this command requires root privileges; run with sudo")
+		}
+		excludeCaches, _ := cmd.Flags().GetBool("exclude-caches")
+		res, err := backup.Create(args[0], excludeCaches)
+		_ = db.GetDefault().Close()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("backup written to %s: %d keys, %d bytes, schema v%d\n", res.Path, res.Keys, res.Size, res.SchemaVersion)
+		return nil
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <file>",
+	Short: "Replace the PlainNAS database with a backup file",
+	Long: `Validates the backup and replaces the database with it; the current database is
+kept next to it. Stop the plainnas service first.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if syscall.Getuid() != 0 {
+			return fmt.Errorf("this command requires root privileges; run with sudo")
+		}
+		res, err := backup.Restore(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("restored %d keys (schema v%d) from %s\n", res.Keys, res.SchemaVersion, res.Path)
+		if res.Previous != "" {
+			fmt.Printf("previous database kept at %s\n", res.Previous)
+		}
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.Flags().Bool("exclude-caches", false, "Leave out data PlainNAS regenerates (extracted text, search doc ids, temp values, unfinished uploads)")
+}
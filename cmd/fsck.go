@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"syscall"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/fsck"
+	"ismartcoding/plainnas/internal/migrations"
+
+	"github.com/spf13/cobra"
+)
+
+var fsckCmd = &cobra.Command{
+	Use:   "fsck",
+	Short: "Check the catalog database against itself and the disks, and repair it",
+	Long: `Cross-checks media records, tag relations and counts, file metadata and trash items.
+Without --apply it only reports what it would repair. Stop the plainnas service first:
+the database can only be opened by one process.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if syscall.Getuid() != 0 {
+			return fmt.Errorf("this command requires root privileges; run with sudo")
+		}
+		apply, _ := cmd.Flags().GetBool("apply")
+		jsonOut, _ := cmd.Flags().GetBool("json")
+		verbose, _ := cmd.Flags().GetBool("verbose")
+
+		if err := migrations.Check(); err != nil {
+			return err
+		}
+		r, err := fsck.Run(apply)
+		if err != nil {
+			return err
+		}
+		_ = db.GetDefault().Close()
+
+		if jsonOut {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(r)
+		}
+		printFsckHuman(r, verbose)
+		return nil
+	},
+}
+
+func init() {
+	fsckCmd.Flags().Bool("apply", false, "Repair the issues found (default is a dry run)")
+	fsckCmd.Flags().Bool("json", false, "Output the report as JSON")
+	fsckCmd.Flags().BoolP("verbose", "V", false, "List each issue, not only the summary")
+}
+
+func printFsckHuman(r *db.FsckReport, verbose bool) {
+	mode := "dry run"
+	if r.Apply {
+		mode = "apply"
+	}
+	fmt.Printf("fsck (%s): examined %d entries, found %d issues\n", mode, r.Entries, r.IssueCount())
+	for _, c := range r.Counts {
+		if r.Apply {
+			fmt.Printf("  [%s] %s: %d (repaired %d)\n", c.Check, c.Problem, c.Count, c.Repaired)
+		} else {
+			fmt.Printf("  [%s] %s: %d\n", c.Check, c.Problem, c.Count)
+		}
+	}
+	if verbose {
+		for _, it := range r.Issues {
+			fmt.Printf("  %s %s: %s\n", it.Check, it.Key, it.Problem)
+		}
+		if r.Truncated {
+			fmt.Println("  ...")
+		}
+	}
+	if !r.Apply && r.IssueCount() > 0 {
+		fmt.Println("Run with --apply to repair.")
+	}
+}
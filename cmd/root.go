@@ -26,6 +26,9 @@ func Execute() {
 	rootCmd.AddCommand(resetPwdCmd)
 	rootCmd.AddCommand(updateCmd)
 	rootCmd.AddCommand(benchCmd)
+	rootCmd.AddCommand(fsckCmd)
+	rootCmd.AddCommand(backupCmd)
+	rootCmd.AddCommand(restoreCmd)
 	rootCmd.Flags().BoolP("version", "v", false, "version")
 
 	rootCmd.Execute()
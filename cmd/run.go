@@ -7,7 +7,7 @@ import (
 	"ismartcoding/plainnas/internal/config"
 	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/db"
-	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/migrations"
 	"ismartcoding/plainnas/internal/pkg/log"
 	"ismartcoding/plainnas/internal/storage"
 	"os"
@@ -43,14 +43,10 @@ var runCmd = &cobra.Command{
 		// Ensure a global URL token exists at startup
 		db.EnsureURLToken()
 
-		// Ensure tag relation secondary index exists for fast tag loading.
-		if err := db.EnsureTagRelationKeyIndex(); err != nil {
-			log.Errorf("tag relation index ensure failed: %v", err)
-		}
-
-		// Ensure media secondary indexes exist (type/trash/date) for fast listing/counting.
-		if err := media.EnsureTypeIndexes(); err != nil {
-			log.Errorf("media index ensure failed: %v", err)
+		// Bring the database schema up to date; never run against a newer one.
+		if err := migrations.Run(); err != nil {
+			log.Errorf("schema migration failed: %v", err)
+			os.Exit(1)
 		}
 
 		// Scan and mount all discovered filesystems to /mnt/usbX based on
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -8,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"ismartcoding/plainnas/internal/archive"
 	"ismartcoding/plainnas/internal/db"
 	"ismartcoding/plainnas/internal/fs"
 	"ismartcoding/plainnas/internal/media"
@@ -16,30 +18,32 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func resolveFSFile(c *gin.Context) (string, os.FileInfo, bool) {
+func resolveFSPath(c *gin.Context) (string, bool) {
 	id := c.Query("id")
 	if id == "" {
 		c.Status(http.StatusBadRequest)
-		return "", nil, false
+		return "", false
 	}
 
 	path, err := fs.PathFromFileID(id)
 	if err != nil {
 		c.String(http.StatusForbidden, "File is expired or does not exist.")
-		return "", nil, false
+		return "", false
 	}
+	return path, true
+}
 
+func statFSFile(c *gin.Context, path string) (os.FileInfo, bool) {
 	fi, err := os.Stat(path)
 	if err != nil {
 		c.Status(http.StatusNotFound)
-		return "", nil, false
+		return nil, false
 	}
 	if fi.IsDir() {
 		c.Status(http.StatusBadRequest)
-		return "", nil, false
+		return nil, false
 	}
-
-	return path, fi, true
+	return fi, true
 }
 
 func normalizePreviewMode(v string) string {
@@ -103,7 +107,74 @@ func clampInt(v, min, max int) int {
 	return v
 }
 
-func serveOriginalOrThumbnail(c *gin.Context, path string, fi os.FileInfo) {
+// fsSource is content served by /fs: a regular file, or an entry inside an archive.
+type fsSource interface {
+	// Path identifies the content for thumbnail cache keys and format detection.
+	Path() string
+	// CacheRef returns the mtime/size that invalidate cached thumbnails.
+	CacheRef() (modUnix int64, size int64)
+	ServeOriginal(c *gin.Context)
+	Thumbnail(w, h, q int, cc bool) (data []byte, outFmt string, err error)
+	MarkRecent()
+}
+
+type diskSource struct {
+	path string
+	fi   os.FileInfo
+}
+
+func (s diskSource) Path() string { return s.path }
+
+func (s diskSource) CacheRef() (int64, int64) {
+	// Cache invalidation source: sidecar cover (if present) should invalidate thumbnails.
+	return media.ThumbnailCacheRef(s.path, s.fi)
+}
+
+func (s diskSource) ServeOriginal(c *gin.Context) { c.File(s.path) }
+
+func (s diskSource) Thumbnail(w, h, q int, cc bool) ([]byte, string, error) {
+	return media.GenerateThumbnail(s.path, w, h, q, cc)
+}
+
+func (s diskSource) MarkRecent() { db.AddRecentFile(s.path) }
+
+// offlineSource is media on a volume that is not mounted: cached thumbnails are still served,
+// keyed by the ref diskSource used while it was online, but the original is unavailable.
+type offlineSource struct{ mf *media.MediaFile }
+
+// offlineMediaSource returns the offline catalog entry for a path that no longer exists.
+func offlineMediaSource(path string) (offlineSource, bool) {
+	if _, err := os.Stat(path); err == nil {
+		return offlineSource{}, false
+	}
+	uuid, err := media.FindByPath(path)
+	if err != nil || uuid == "" {
+		return offlineSource{}, false
+	}
+	mf, err := media.GetFile(uuid)
+	if err != nil || mf == nil || media.VolumeOnline(mf.FSUUID) {
+		return offlineSource{}, false
+	}
+	return offlineSource{mf: mf}, true
+}
+
+func (s offlineSource) Path() string { return s.mf.Path }
+
+func (s offlineSource) CacheRef() (int64, int64) { return media.OfflineThumbnailCacheRef(s.mf) }
+
+func (s offlineSource) ServeOriginal(c *gin.Context) {
+	c.String(http.StatusNotFound, "The disk holding this file is not connected.")
+}
+
+func (s offlineSource) Thumbnail(w, h, q int, cc bool) ([]byte, string, error) {
+	return nil, "", errVolumeOffline
+}
+
+func (s offlineSource) MarkRecent() {}
+
+var errVolumeOffline = errors.New("volume offline")
+
+func serveOriginalOrThumbnail(c *gin.Context, src fsSource) {
 	// Parse thumbnail params
 	w := strutils.ParseInt(c.Query("w"))
 	h := strutils.ParseInt(c.Query("h"))
@@ -114,30 +185,22 @@ func serveOriginalOrThumbnail(c *gin.Context, path string, fi os.FileInfo) {
 
 	// If no thumbnail params provided, serve original file
 	if w <= 0 && h <= 0 && !cc {
-		db.AddRecentFile(path)
-		c.File(path)
+		src.MarkRecent()
+		src.ServeOriginal(c)
 		return
 	}
 
 	if w > 200 {
-		db.AddRecentFile(path)
+		src.MarkRecent()
 	}
 
 	// Thumbnails are WEBP by default, except small GIFs which are served as original GIF previews.
-	outFmt := media.ThumbnailOutputFormat(path)
+	outFmt := media.ThumbnailOutputFormat(src.Path())
 	// Compute target size for cache key only (helpers recompute internally)
 	tw, th := w, h
 
-	// Cache invalidation source: sidecar cover (if present) should invalidate thumbnails.
-	refPath := media.ThumbnailCacheRefPath(path)
-	refFi := fi
-	if refPath != path {
-		if sfi, err := os.Stat(refPath); err == nil && !sfi.IsDir() {
-			refFi = sfi
-		}
-	}
-
-	keyBase := media.CacheKeyForThumbnail(path, refFi.ModTime().Unix(), refFi.Size(), tw, th, q, outFmt)
+	modUnix, size := src.CacheRef()
+	keyBase := media.CacheKeyForThumbnail(src.Path(), modUnix, size, tw, th, q, outFmt)
 	cacheKey := "thumb:" + keyBase
 	failKey := "thumbfail:" + keyBase
 
@@ -152,14 +215,14 @@ func serveOriginalOrThumbnail(c *gin.Context, path string, fi os.FileInfo) {
 		return
 	}
 
-	data, fmtUsed, err := media.GenerateThumbnail(path, w, h, q, cc)
+	data, fmtUsed, err := src.Thumbnail(w, h, q, cc)
 	if err == media.ErrNoCover {
 		_ = db.GetDefault().Set([]byte(failKey), []byte{1}, nil)
 		c.Status(http.StatusNoContent)
 		return
 	}
 	if err != nil || len(data) == 0 {
-		c.File(path)
+		src.ServeOriginal(c)
 		return
 	}
 
@@ -171,9 +234,24 @@ func serveOriginalOrThumbnail(c *gin.Context, path string, fi os.FileInfo) {
 // fsHandler serves a file given an encrypted id.
 // Supports thumbnail parameters: w (width), h (height), q (webp quality 1-100).
 // Thumbnails are always generated and cached as WEBP to reduce space.
+// Virtual paths of the form "<archive>!/<entry>" stream a single entry from inside an archive.
 func fsHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		path, fi, ok := resolveFSFile(c)
+		path, ok := resolveFSPath(c)
+		if !ok {
+			return
+		}
+
+		if archivePath, entry, isEntry := archive.SplitVirtual(path); isEntry {
+			serveArchiveEntry(c, path, archivePath, entry)
+			return
+		}
+
+		if src, ok := offlineMediaSource(path); ok {
+			serveOriginalOrThumbnail(c, src)
+			return
+		}
+		fi, ok := statFSFile(c, path)
 		if !ok {
 			return
 		}
@@ -187,7 +265,7 @@ func fsHandler() gin.HandlerFunc {
 			return
 		}
 
-		serveOriginalOrThumbnail(c, path, fi)
+		serveOriginalOrThumbnail(c, diskSource{path: path, fi: fi})
 	}
 }
 
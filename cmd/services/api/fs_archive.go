@@ -0,0 +1,121 @@
+package api
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+
+	"ismartcoding/plainnas/internal/archive"
+	"ismartcoding/plainnas/internal/media"
+
+	"github.com/gin-gonic/gin"
+)
+
+// archiveThumbMaxBytes bounds how much of an entry is extracted to generate a thumbnail.
+// Larger entries (typically videos) report "no cover" instead of being extracted.
+const archiveThumbMaxBytes = 64 * 1024 * 1024
+
+// archiveEntrySource serves a single entry from inside an archive.
+type archiveEntrySource struct {
+	virtualPath string
+	ix          *archive.Index
+	entry       archive.Entry
+}
+
+func (s archiveEntrySource) Path() string { return s.virtualPath }
+
+func (s archiveEntrySource) CacheRef() (int64, int64) {
+	return s.entry.ModTime.Unix(), s.entry.Size
+}
+
+func (s archiveEntrySource) ServeOriginal(c *gin.Context) {
+	r, err := s.ix.Open(s.entry.Name)
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	defer r.Close()
+
+	name := path.Base(s.entry.Name)
+	// Stored zip entries and plain tar members are seekable: serve ranges directly.
+	if rs, ok := r.(io.ReadSeeker); ok {
+		http.ServeContent(c.Writer, c.Request, name, s.entry.ModTime, rs)
+		return
+	}
+
+	ct := mime.TypeByExtension(filepath.Ext(name))
+	if ct == "" {
+		ct = "application/octet-stream"
+	}
+	c.Header("Content-Type", ct)
+	c.Header("Content-Length", strconv.FormatInt(s.entry.Size, 10))
+	c.Status(http.StatusOK)
+	if c.Request.Method == http.MethodHead {
+		return
+	}
+	_, _ = io.Copy(c.Writer, r)
+}
+
+// Thumbnail extracts the entry into a private temp dir (keeping its extension so
+// format detection works) and runs the regular thumbnail pipeline on it.
+func (s archiveEntrySource) Thumbnail(w, h, q int, cc bool) ([]byte, string, error) {
+	if s.entry.Size > archiveThumbMaxBytes {
+		return nil, "", media.ErrNoCover
+	}
+	r, err := s.ix.Open(s.entry.Name)
+	if err != nil {
+		return nil, "", err
+	}
+	defer r.Close()
+
+	tmpDir, err := os.MkdirTemp("", "plainnas-archive-*")
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpPath := filepath.Join(tmpDir, "entry"+filepath.Ext(s.entry.Name))
+	out, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, "", err
+	}
+	_, err = io.Copy(out, io.LimitReader(r, archiveThumbMaxBytes))
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return media.GenerateThumbnail(tmpPath, w, h, q, cc)
+}
+
+// MarkRecent is a no-op: recent files only track real paths.
+func (s archiveEntrySource) MarkRecent() {}
+
+func serveArchiveEntry(c *gin.Context, virtualPath string, archivePath string, entryName string) {
+	ix, err := archive.OpenIndex(archivePath)
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	entry, ok := ix.Lookup(entryName)
+	if !ok {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	if entry.IsDir {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	fileName := c.Query("name")
+	if fileName == "" {
+		fileName = path.Base(entry.Name)
+	}
+	setContentDispositionHeaders(c, fileName)
+	serveOriginalOrThumbnail(c, archiveEntrySource{virtualPath: virtualPath, ix: ix, entry: entry})
+}
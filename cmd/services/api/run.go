@@ -8,8 +8,10 @@ import (
 	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/pkg/log"
 	"ismartcoding/plainnas/internal/pkg/tls"
+	"ismartcoding/plainnas/internal/upload"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -48,9 +50,13 @@ func Run(ctx context.Context) {
 	r.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Credentials", "true")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, c-id")
+		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, c-id, "+tusRequestHeaders)
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS")
 		if c.Request.Method == http.MethodOptions {
+			// tus clients discover server capabilities with an unauthenticated OPTIONS request.
+			if strings.HasPrefix(c.Request.URL.Path, strings.TrimSuffix(tusBasePath, "/")) {
+				setTusDiscoveryHeaders(c)
+			}
 			c.AbortWithStatus(http.StatusNoContent)
 			return
 		}
@@ -63,6 +69,8 @@ func Run(ctx context.Context) {
 	r.POST("/graphql", requireAuth(), graphqlHandler())
 	r.POST("/upload", uploadHandler())
 	r.POST("/upload_chunk", uploadChunkHandler())
+	registerTusRoutes(r)
+	go upload.RunJanitor(ctx)
 	r.GET("/ws", wsHandler())
 	r.GET("/media/:name", mediaHandler())
 	r.GET("/fs", fsHandler())
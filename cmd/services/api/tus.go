@@ -0,0 +1,233 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/log"
+	"ismartcoding/plainnas/internal/upload"
+
+	"github.com/gin-gonic/gin"
+)
+
+// tusBasePath is where the tus 1.0 endpoint is mounted. Upload URLs are tusBasePath + id.
+const tusBasePath = "/tus/"
+
+const tusClientIDKey = "tus_client_id"
+
+// tusRequestHeaders are the request headers tus clients send; they must be allowed by CORS.
+const tusRequestHeaders = "Tus-Resumable, Upload-Length, Upload-Metadata, Upload-Offset, Upload-Checksum, X-HTTP-Method-Override"
+
+// tusExposeHeaders are the response headers browser-based tus clients need to read.
+const tusExposeHeaders = "Location, Tus-Resumable, Tus-Version, Tus-Extension, Tus-Checksum-Algorithm, Upload-Offset, Upload-Length, Upload-Metadata, Upload-Expires"
+
+func setTusDiscoveryHeaders(c *gin.Context) {
+	c.Header("Tus-Resumable", upload.TusVersion)
+	c.Header("Tus-Version", upload.TusVersion)
+	c.Header("Tus-Extension", upload.TusExtensions)
+	c.Header("Tus-Checksum-Algorithm", upload.TusChecksumAlgorithms)
+}
+
+// tusAuth authenticates tus requests without the encrypted envelope used by /graphql,
+// so standard tus clients can be used. Clients send their session via
+// `c-id: <clientId>` and `Authorization: Bearer <session token>`; developer tools may
+// instead send the configured `auth.dev_token` as bearer token.
+func tusAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Tus-Resumable", upload.TusVersion)
+		c.Header("Access-Control-Expose-Headers", tusExposeHeaders)
+		if v := c.GetHeader("Tus-Resumable"); v != upload.TusVersion {
+			c.Header("Tus-Version", upload.TusVersion)
+			c.AbortWithStatus(http.StatusPreconditionFailed)
+			return
+		}
+
+		token := ""
+		if pairs := strings.SplitN(c.GetHeader("Authorization"), " ", 2); len(pairs) == 2 && strings.EqualFold(pairs[0], "Bearer") {
+			token = strings.TrimSpace(pairs[1])
+		}
+		if token == "" {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		clientID := c.GetHeader("c-id")
+		if clientID != "" {
+			session := db.GetSession(clientID)
+			if session == nil || subtle.ConstantTimeCompare([]byte(session.Token), []byte(token)) != 1 {
+				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+			_ = db.TouchSessionLastActive(session)
+		} else {
+			devToken := config.GetDefault().GetString("auth.dev_token")
+			if devToken == "" || subtle.ConstantTimeCompare([]byte(devToken), []byte(token)) != 1 {
+				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+		}
+		c.Set(tusClientIDKey, clientID)
+		c.Next()
+	}
+}
+
+func tusStatusFromError(err error) int {
+	switch {
+	case errors.Is(err, upload.ErrTusNotFound), errors.Is(err, upload.ErrTusForbidden):
+		return http.StatusNotFound
+	case errors.Is(err, upload.ErrTusExpired):
+		return http.StatusGone
+	case errors.Is(err, upload.ErrTusOffsetMismatch):
+		return http.StatusConflict
+	case errors.Is(err, upload.ErrTusChecksumMismatch):
+		// 460 is the status code defined by the tus checksum extension.
+		return 460
+	case errors.Is(err, upload.ErrTusBadChecksum):
+		return http.StatusBadRequest
+	case errors.Is(err, upload.ErrTusTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, upload.ErrTusLocked):
+		return http.StatusLocked
+	}
+	return http.StatusInternalServerError
+}
+
+func setTusExpires(c *gin.Context, u *upload.TusUpload) {
+	if u != nil && u.FinalPath == "" {
+		c.Header("Upload-Expires", u.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+func tusCreateHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		length, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+		if err != nil || length < 0 {
+			c.String(http.StatusBadRequest, "invalid Upload-Length")
+			return
+		}
+		meta, err := upload.ParseTusMetadata(c.GetHeader("Upload-Metadata"))
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		u, err := upload.CreateTus(c.GetString(tusClientIDKey), length, meta)
+		if err != nil {
+			log.Errorf("[tus] create failed: %v", err)
+			status := http.StatusBadRequest
+			if errors.Is(err, upload.ErrInsufficientSpace) {
+				status = http.StatusInsufficientStorage
+			}
+			c.String(status, err.Error())
+			return
+		}
+		c.Header("Location", tusBasePath+u.ID)
+		setTusExpires(c, u)
+		c.Status(http.StatusCreated)
+	}
+}
+
+func tusHeadHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		u, err := upload.GetTus(c.GetString(tusClientIDKey), c.Param("id"))
+		if err != nil {
+			c.Status(tusStatusFromError(err))
+			return
+		}
+		c.Header("Cache-Control", "no-store")
+		c.Header("Upload-Offset", strconv.FormatInt(u.Offset, 10))
+		c.Header("Upload-Length", strconv.FormatInt(u.Length, 10))
+		if raw := encodeTusMetadata(u.Metadata); raw != "" {
+			c.Header("Upload-Metadata", raw)
+		}
+		setTusExpires(c, u)
+		c.Status(http.StatusOK)
+	}
+}
+
+func tusPatchHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.ContentType() != "application/offset+octet-stream" {
+			c.Status(http.StatusUnsupportedMediaType)
+			return
+		}
+		offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+		if err != nil || offset < 0 {
+			c.String(http.StatusBadRequest, "invalid Upload-Offset")
+			return
+		}
+		var sum *upload.TusChecksum
+		if raw := c.GetHeader("Upload-Checksum"); raw != "" {
+			if sum, err = upload.ParseTusChecksum(raw); err != nil {
+				c.Status(tusStatusFromError(err))
+				return
+			}
+		}
+
+		u, err := upload.WriteTus(c.GetString(tusClientIDKey), c.Param("id"), offset, c.Request.Body, sum)
+		if err != nil {
+			log.Debugf("[tus] patch %s failed: %v", c.Param("id"), err)
+			if u != nil && errors.Is(err, upload.ErrTusOffsetMismatch) {
+				c.Header("Upload-Offset", strconv.FormatInt(u.Offset, 10))
+			}
+			c.Status(tusStatusFromError(err))
+			return
+		}
+		c.Header("Upload-Offset", strconv.FormatInt(u.Offset, 10))
+		setTusExpires(c, u)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func tusDeleteHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := upload.TerminateTus(c.GetString(tusClientIDKey), c.Param("id")); err != nil {
+			c.Status(tusStatusFromError(err))
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// tusMethodOverride lets clients behind proxies that only pass GET/POST tunnel
+// PATCH/DELETE/HEAD through POST using X-HTTP-Method-Override, as the tus spec allows.
+func tusMethodOverride() gin.HandlerFunc {
+	patch := tusPatchHandler()
+	del := tusDeleteHandler()
+	head := tusHeadHandler()
+	return func(c *gin.Context) {
+		switch strings.ToUpper(c.GetHeader("X-HTTP-Method-Override")) {
+		case http.MethodPatch:
+			patch(c)
+		case http.MethodDelete:
+			del(c)
+		case http.MethodHead:
+			head(c)
+		default:
+			c.Status(http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+func encodeTusMetadata(meta map[string]string) string {
+	parts := make([]string, 0, len(meta))
+	for k, v := range meta {
+		parts = append(parts, k+" "+base64.StdEncoding.EncodeToString([]byte(v)))
+	}
+	return strings.Join(parts, ",")
+}
+
+func registerTusRoutes(r *gin.Engine) {
+	g := r.Group(tusBasePath, tusAuth())
+	g.POST("", tusCreateHandler())
+	g.HEAD(":id", tusHeadHandler())
+	g.PATCH(":id", tusPatchHandler())
+	g.DELETE(":id", tusDeleteHandler())
+	g.POST(":id", tusMethodOverride())
+}
@@ -1,51 +1,39 @@
 package api
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
-	"strings"
 
-	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/db"
-	"ismartcoding/plainnas/internal/media"
 	"ismartcoding/plainnas/internal/pkg/log"
 	"ismartcoding/plainnas/internal/strutils"
+	"ismartcoding/plainnas/internal/upload"
 
 	"github.com/gin-gonic/gin"
 )
 
 // uploadHandler handles direct form-data uploads with an encrypted "info" part
-// and a "file" part, similar to PlainApp behavior.
+// and a "file" part, similar to PlainApp behavior. An "info" part applies to the file
+// parts that follow it, so a request may carry several files with their own settings.
 func uploadHandler() gin.HandlerFunc {
 	type uploadInfo struct {
 		Dir     string `json:"dir"`
 		Replace bool   `json:"replace"`
-	}
-
-	// generate unique filename if file exists and replace is false
-	makeUniquePath := func(path string) string {
-		if _, err := os.Stat(path); err != nil {
-			return path
-		}
-		dir := filepath.Dir(path)
-		base := filepath.Base(path)
-		name := base
-		ext := ""
-		if i := strings.LastIndex(base, "."); i > 0 {
-			name = base[:i]
-			ext = base[i:]
-		}
-		for i := 1; ; i++ {
-			candidate := filepath.Join(dir, name+" ("+strconv.Itoa(i)+")"+ext)
-			if _, err := os.Stat(candidate); os.IsNotExist(err) {
-				return candidate
-			}
-		}
+		// Path is the file's path relative to Dir when uploading a folder tree
+		// ("Trip/day 1/a.jpg"); missing directories are created.
+		Path string `json:"path"`
+		// BatchID groups the files of one folder upload; BatchTotal is its file count.
+		// A summary event is sent once all files of the batch were received.
+		BatchID    string `json:"batchId"`
+		BatchTotal int    `json:"batchTotal"`
 	}
 
 	return func(c *gin.Context) {
@@ -73,7 +61,6 @@ func uploadHandler() gin.HandlerFunc {
 		var info uploadInfo
 		haveInfo := false
 		savedFileName := ""
-		lastDestPath := ""
 
 		for {
 			part, err := mr.NextPart()
@@ -113,47 +100,51 @@ func uploadHandler() gin.HandlerFunc {
 					return
 				}
 				fileName := part.FileName()
-				if info.Dir == "" || fileName == "" {
-					log.Errorf("[/upload] dir or filename missing dir=%q filename=%q", info.Dir, fileName)
-					c.String(http.StatusBadRequest, "dir or filename missing")
+				// The batch tracks each file by the path the client asked for, so a retry of a
+				// renamed or failed file counts once.
+				rel := info.Path
+				if rel == "" {
+					rel = fileName
+				}
+				failed := func(status int, msg string, err error) {
+					upload.RecordBatchFile(clientID, info.BatchID, info.BatchTotal, info.Dir, upload.BatchFile{Path: rel, Err: err})
+					c.String(status, msg)
 					part.Close()
-					return
 				}
-				destPath := filepath.Clean(filepath.Join(info.Dir, fileName))
-				log.Debugf("[/upload] incoming file name=%q dest=%q", fileName, destPath)
-				if fi, err := os.Stat(destPath); err == nil && !fi.IsDir() {
-					if info.Replace {
-						log.Debugf("[/upload] replacing existing file: %s", destPath)
-						_ = os.Remove(destPath)
-					} else {
-						destPath = makeUniquePath(destPath)
-						fileName = filepath.Base(destPath)
-						log.Debugf("[/upload] target exists, using unique path: %s", destPath)
-					}
+				if info.Dir == "" || (fileName == "" && info.Path == "") {
+					log.Errorf("[/upload] dir or filename missing dir=%q filename=%q", info.Dir, fileName)
+					failed(http.StatusBadRequest, "dir or filename missing", errors.New("dir or filename missing"))
+					return
 				}
-				if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
-					log.Errorf("[/upload] cannot create dir %q: %v", filepath.Dir(destPath), err)
-					c.String(http.StatusBadRequest, "cannot create dir")
-					part.Close()
+				destPath, replaced, renamed, err := upload.PrepareDest(info.Dir, info.Path, fileName, info.Replace)
+				if err != nil {
+					log.Errorf("[/upload] invalid destination dir=%q path=%q filename=%q: %v", info.Dir, info.Path, fileName, err)
+					failed(http.StatusBadRequest, "invalid path", err)
 					return
 				}
+				fileName = filepath.Base(destPath)
+				log.Debugf("[/upload] incoming file dest=%q replaced=%v renamed=%v", destPath, replaced, renamed)
 				f, err := os.Create(destPath)
 				if err != nil {
 					log.Errorf("[/upload] cannot create file %q: %v", destPath, err)
-					c.String(http.StatusBadRequest, "cannot create file")
-					part.Close()
+					failed(http.StatusBadRequest, "cannot create file", err)
 					return
 				}
-				if _, err := io.Copy(f, part); err != nil {
+				h := sha256.New()
+				n, err := io.Copy(io.MultiWriter(f, h), part)
+				if err != nil {
 					f.Close()
+					_ = os.Remove(destPath)
 					log.Errorf("[/upload] write file error for %q: %v", destPath, err)
-					c.String(http.StatusBadRequest, "write file error")
-					part.Close()
+					failed(http.StatusBadRequest, "write file error", err)
 					return
 				}
 				f.Close()
 				savedFileName = fileName
-				lastDestPath = destPath
+				log.Infof("[/upload] saved file=%q path=%q", fileName, destPath)
+				// Index the uploaded file immediately
+				upload.RecordUpload(destPath, hex.EncodeToString(h.Sum(nil)))
+				upload.RecordBatchFile(clientID, info.BatchID, info.BatchTotal, info.Dir, upload.BatchFile{Path: rel, Size: n, Replaced: replaced, Renamed: renamed})
 			default:
 				// ignore unknown parts
 			}
@@ -165,12 +156,6 @@ func uploadHandler() gin.HandlerFunc {
 			c.String(http.StatusBadRequest, "no file uploaded")
 			return
 		}
-
-		log.Infof("[/upload] saved file=%q path=%q", savedFileName, lastDestPath)
-		// Index the uploaded file immediately
-		if err := media.ScanFile(lastDestPath); err != nil {
-			log.Errorf("[/upload] index file error for %q: %v", lastDestPath, err)
-		}
 		c.String(http.StatusCreated, savedFileName)
 	}
 }
@@ -180,6 +165,11 @@ func uploadChunkHandler() gin.HandlerFunc {
 	type uploadChunkInfo struct {
 		FileID string `json:"fileId"`
 		Index  int    `json:"index"`
+		// Optional: hex sha256 of the chunk, and the destination path and total size
+		// of the file, used to verify the chunk and check free space up front.
+		Hash string `json:"hash"`
+		Path string `json:"path"`
+		Size int64  `json:"size"`
 	}
 
 	return func(c *gin.Context) {
@@ -244,32 +234,18 @@ func uploadChunkHandler() gin.HandlerFunc {
 					part.Close()
 					return
 				}
-				// Create chunk dir under data dir
-				base := consts.DATA_DIR
-				chunkDir := filepath.Join(base, "upload_tmp", info.FileID)
-				if err := os.MkdirAll(chunkDir, 0o755); err != nil {
-					log.Errorf("[/upload_chunk] cannot create chunk dir %q: %v", chunkDir, err)
-					c.String(http.StatusBadRequest, "cannot create chunk dir")
-					part.Close()
-					return
-				}
-				chunkPath := filepath.Join(chunkDir, "chunk_"+strconv.Itoa(info.Index))
-				log.Debugf("[/upload_chunk] saving chunk to %q", chunkPath)
-				f, err := os.Create(chunkPath)
-				if err != nil {
-					log.Errorf("[/upload_chunk] cannot create chunk file %q: %v", chunkPath, err)
-					c.String(http.StatusBadRequest, "cannot create chunk file")
-					part.Close()
-					return
-				}
-				if _, err := io.Copy(f, part); err != nil {
-					f.Close()
-					log.Errorf("[/upload_chunk] write chunk error for %q: %v", chunkPath, err)
-					c.String(http.StatusBadRequest, "write chunk error")
+				chunkInfo := upload.ChunkInfo{FileID: info.FileID, Index: info.Index, Hash: info.Hash, Path: info.Path, Size: info.Size}
+				if _, err := upload.SaveChunk(chunkInfo, part); err != nil {
+					log.Errorf("[/upload_chunk] save chunk %d of %q failed: %v", info.Index, info.FileID, err)
+					status := http.StatusBadRequest
+					if errors.Is(err, upload.ErrInsufficientSpace) {
+						status = http.StatusInsufficientStorage
+					}
+					c.String(status, err.Error())
 					part.Close()
 					return
 				}
-				f.Close()
+				chunkPath := filepath.Join(upload.ChunkDir(info.FileID), "chunk_"+strconv.Itoa(info.Index))
 				chunkSaved = true
 				chunkPathSaved = chunkPath
 			default:
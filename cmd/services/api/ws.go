@@ -81,6 +81,15 @@ func wsHandler() gin.HandlerFunc {
 			_ = eventbus.GetDefault().Subscribe(consts.EVENT_MEDIA_SCAN_PROGRESS, scanHandler)
 			defer func() { _ = eventbus.GetDefault().Unsubscribe(consts.EVENT_MEDIA_SCAN_PROGRESS, scanHandler) }()
 
+			searchIndexHandler := func(payload map[string]any) {
+				b, _ := json.Marshal(payload)
+				if enc := strutils.ChaCha20Encrypt(key, b); enc != nil {
+					_ = cconn.WriteMessage(websocket.BinaryMessage, append(int32ToBytes(10), enc...))
+				}
+			}
+			_ = eventbus.GetDefault().Subscribe(consts.EVENT_SEARCH_INDEX_PROGRESS, searchIndexHandler)
+			defer func() { _ = eventbus.GetDefault().Unsubscribe(consts.EVENT_SEARCH_INDEX_PROGRESS, searchIndexHandler) }()
+
 			fileTaskHandler := func(eventCID string, payload map[string]any) {
 				if eventCID != id {
 					return
@@ -93,6 +102,18 @@ func wsHandler() gin.HandlerFunc {
 			_ = eventbus.GetDefault().Subscribe(consts.EVENT_FILE_TASK_PROGRESS, fileTaskHandler)
 			defer func() { _ = eventbus.GetDefault().Unsubscribe(consts.EVENT_FILE_TASK_PROGRESS, fileTaskHandler) }()
 
+			uploadBatchHandler := func(eventCID string, payload map[string]any) {
+				if eventCID != id {
+					return
+				}
+				b, _ := json.Marshal(payload)
+				if enc := strutils.ChaCha20Encrypt(key, b); enc != nil {
+					_ = cconn.WriteMessage(websocket.BinaryMessage, append(int32ToBytes(9), enc...))
+				}
+			}
+			_ = eventbus.GetDefault().Subscribe(consts.EVENT_UPLOAD_BATCH_DONE, uploadBatchHandler)
+			defer func() { _ = eventbus.GetDefault().Unsubscribe(consts.EVENT_UPLOAD_BATCH_DONE, uploadBatchHandler) }()
+
 			dlnaFoundHandler := func(eventCID string, payload map[string]any) {
 				if eventCID != id {
 					return
@@ -116,7 +116,7 @@ func zipFilesHandler() gin.HandlerFunc {
 		var items []zipPathItem
 		switch typeStr {
 		case "AUDIO":
-			list, _ := helpers.ScanAudios(0, 10000, req.Query, model.FileSortByDateDesc)
+			list, _ := helpers.ScanAudios(0, 10000, "", req.Query, model.FileSortByDateDesc)
 			items = make([]zipPathItem, 0, len(list))
 			for _, it := range list {
 				if it == nil {
@@ -125,7 +125,7 @@ func zipFilesHandler() gin.HandlerFunc {
 				items = append(items, zipPathItem{Path: filepath.FromSlash(it.Path)})
 			}
 		case "VIDEO":
-			list, _ := helpers.ScanVideos(0, 10000, req.Query, model.FileSortByDateDesc)
+			list, _ := helpers.ScanVideos(0, 10000, "", req.Query, model.FileSortByDateDesc)
 			items = make([]zipPathItem, 0, len(list))
 			for _, it := range list {
 				if it == nil {
@@ -134,7 +134,7 @@ func zipFilesHandler() gin.HandlerFunc {
 				items = append(items, zipPathItem{Path: filepath.FromSlash(it.Path)})
 			}
 		case "IMAGE":
-			list, _ := helpers.ScanImages(0, 10000, req.Query, model.FileSortByDateDesc)
+			list, _ := helpers.ScanImages(0, 10000, "", req.Query, model.FileSortByDateDesc)
 			items = make([]zipPathItem, 0, len(list))
 			for _, it := range list {
 				if it == nil {
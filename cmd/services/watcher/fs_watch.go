@@ -0,0 +1,172 @@
+package watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/graph"
+	"ismartcoding/plainnas/internal/pkg/log"
+	pw "ismartcoding/plainnas/internal/pkg/watcher"
+)
+
+const (
+	defaultWatchInterval = 60 * time.Second
+	defaultWatchDebounce = 10 * time.Second
+)
+
+// watchDuration reads a number of seconds from config key, falling back to def.
+func watchDuration(key string, def time.Duration) time.Duration {
+	if v := strings.TrimSpace(config.GetDefault().GetString(key)); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			return time.Duration(n) * time.Second
+		}
+	}
+	return def
+}
+
+// watchRoots returns the media source dirs, or the mounted /mnt/usbN volumes when none is set.
+// "/" is never polled.
+func watchRoots() []string {
+	var roots []string
+	if dirs := db.GetMediaSourceDirs(); len(dirs) > 0 {
+		roots = dirs
+	} else if vols, err := graph.ListMounts(); err == nil {
+		for _, v := range vols {
+			if v.MountPoint != nil && isPlainNASUSBMount(*v.MountPoint) {
+				roots = append(roots, filepath.Clean(strings.TrimSpace(*v.MountPoint)))
+			}
+		}
+	}
+	out := roots[:0]
+	for _, r := range roots {
+		if fi, err := os.Stat(r); err == nil && fi.IsDir() && filepath.Clean(r) != "/" {
+			out = append(out, filepath.Clean(r))
+		}
+	}
+	return out
+}
+
+// runFileWatcher polls the watch roots for changes every media.watch_interval_sec (0 disables)
+// and queues an incremental rescan of a root once its changes settled for
+// media.watch_debounce_sec. Only directories are tracked: adding, removing or renaming a file
+// changes the mtime of its directory, which is also what incremental scans look at. The roots
+// are picked again on every signal of restart.
+func runFileWatcher(ctx context.Context, restart <-chan struct{}) {
+	interval := watchDuration("media.watch_interval_sec", defaultWatchInterval)
+	if interval <= 0 {
+		return
+	}
+	debounce := watchDuration("media.watch_debounce_sec", defaultWatchDebounce)
+	for {
+		stop := startFileWatch(watchRoots(), interval, debounce)
+		select {
+		case <-ctx.Done():
+			stop()
+			return
+		case <-restart:
+			stop()
+		}
+	}
+}
+
+// startFileWatch starts polling roots and returns a function that stops it. Pending rescans are
+// dropped on stop.
+func startFileWatch(roots []string, interval, debounce time.Duration) (stop func()) {
+	if len(roots) == 0 {
+		return func() {}
+	}
+	w := pw.New()
+	w.IgnoreHiddenFiles(true)
+	w.FilterOps(pw.Create, pw.Write, pw.Remove, pw.Rename, pw.Move)
+	w.AddFilterHook(func(info os.FileInfo, _ string) error {
+		if !info.IsDir() {
+			return pw.ErrSkip
+		}
+		return nil
+	})
+	for _, r := range roots {
+		if err := w.AddRecursive(r); err != nil {
+			log.Errorf("watch %s: %v", r, err)
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		pending = map[string]*time.Timer{}
+		stopped bool
+	)
+	changed := func(p string) {
+		root := ownerRoot(roots, p)
+		if root == "" {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return
+		}
+		if t, ok := pending[root]; ok {
+			t.Reset(debounce)
+			return
+		}
+		pending[root] = time.AfterFunc(debounce, func() {
+			mu.Lock()
+			delete(pending, root)
+			mu.Unlock()
+			rescan(root)
+		})
+	}
+	go func() {
+		for {
+			select {
+			case e := <-w.Event:
+				changed(e.Path)
+				if e.OldPath != "" && e.OldPath != e.Path {
+					changed(e.OldPath)
+				}
+			case err := <-w.Error:
+				log.Errorf("watch media dirs: %v", err)
+			case <-w.Closed:
+				return
+			}
+		}
+	}()
+	go func() {
+		if err := w.Start(interval); err != nil {
+			log.Errorf("watch media dirs: %v", err)
+		}
+	}()
+
+	return func() {
+		mu.Lock()
+		stopped = true
+		for root, t := range pending {
+			t.Stop()
+			delete(pending, root)
+		}
+		mu.Unlock()
+		// Close blocks until the poll loop wakes up from its sleep.
+		go func() {
+			w.Wait()
+			w.Close()
+		}()
+	}
+}
+
+// ownerRoot returns the innermost root that holds p, or "".
+func ownerRoot(roots []string, p string) string {
+	best := ""
+	for _, r := range roots {
+		if (p == r || strings.HasPrefix(p, r+string(filepath.Separator))) && len(r) > len(best) {
+			best = r
+		}
+	}
+	return best
+}
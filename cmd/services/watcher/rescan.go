@@ -0,0 +1,73 @@
+package watcher
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/pkg/cron"
+	"ismartcoding/plainnas/internal/pkg/log"
+)
+
+// runRescanScheduler queues an incremental media scan of every media source dir (or of "/"
+// when none is set) at the times of the media.rescan_schedule cron expression.
+func runRescanScheduler(ctx context.Context) {
+	expr := strings.TrimSpace(config.GetDefault().GetString("media.rescan_schedule"))
+	if expr == "" {
+		return
+	}
+	sched, err := cron.Parse(expr)
+	if err != nil {
+		log.Errorf("media rescan schedule: %v", err)
+		return
+	}
+	for {
+		next := sched.Next(time.Now())
+		if next.IsZero() {
+			log.Errorf("media rescan schedule %q never fires", expr)
+			return
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		roots := db.GetMediaSourceDirs()
+		if len(roots) == 0 {
+			roots = []string{"/"}
+		}
+		for _, root := range roots {
+			rescan(root)
+		}
+	}
+}
+
+// rescan queues an incremental scan of root and, when it found new or changed files, rebuilds
+// the media search segments of the volume so they are found by name.
+func rescan(root string) {
+	j := media.EnqueueIncrementalScan(root)
+	go func() {
+		if err := j.Wait(); err != nil {
+			log.Errorf("media rescan %s: %v", root, err)
+			return
+		}
+		info := j.Info()
+		if info.State != media.ScanJobDone || info.Indexed == 0 {
+			return
+		}
+		if root == "/" {
+			if err := media.BuildMediaIndex(); err != nil {
+				log.Errorf("index media after rescan: %v", err)
+			}
+			return
+		}
+		if err := media.BuildMediaVolumeIndex(media.VolumeIDForPath(root)); err != nil {
+			log.Errorf("index media volume %s after rescan: %v", root, err)
+		}
+	}()
+}
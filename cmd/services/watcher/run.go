@@ -2,12 +2,18 @@ package watcher
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 
+	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/graph"
+	"ismartcoding/plainnas/internal/graph/helpers"
 	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
+	"ismartcoding/plainnas/internal/pkg/log"
 	"ismartcoding/plainnas/internal/search"
 )
 
@@ -29,37 +35,113 @@ func isPlainNASUSBMount(mp string) bool {
 }
 
 func Run(ctx context.Context) {
-	// Build file index at startup from storage volumes in background
+	// Build missing volume indexes at startup from storage volumes in background,
+	// then keep the document content index up to date.
 	go func() {
-		// Build missing indexes at startup. If either index is missing, (re)build it.
-		fileIdxExists := search.IndexExists()
-		mediaIdxExists := media.MediaIndexExists()
-		if fileIdxExists && mediaIdxExists {
-			return
+		reconcileVolumes()
+		search.RunContentIndexer(ctx)
+	}()
+	go runRescanScheduler(ctx)
+	go media.RunHasher(ctx)
+
+	// Re-activate volumes and index newly seen ones whenever USB volumes are (un)mounted.
+	mountsChanged := make(chan struct{}, 1)
+	_ = eventbus.GetDefault().Subscribe(consts.EVENT_STORAGE_MOUNTS_CHANGED, func() {
+		select {
+		case mountsChanged <- struct{}{}:
+		default:
 		}
-		if vols, err := graph.ListMounts(); err == nil {
-			roots := make([]string, 0, len(vols))
-			for _, v := range vols {
-				if v.MountPoint == nil {
-					continue
-				}
-				mp := strings.TrimSpace(*v.MountPoint)
-				if mp != "" && isPlainNASUSBMount(mp) {
-					roots = append(roots, mp)
-				}
-			}
-			if len(roots) == 0 {
+	})
+	// The file watcher picks its roots again when mounts or the media source dirs change.
+	watchRestart := make(chan struct{}, 1)
+	restartWatch := func() {
+		select {
+		case watchRestart <- struct{}{}:
+		default:
+		}
+	}
+	_ = eventbus.GetDefault().Subscribe(consts.EVENT_MEDIA_SOURCE_DIRS_CHANGED, restartWatch)
+	go runFileWatcher(ctx, watchRestart)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
 				return
+			case <-mountsChanged:
+				reconcileVolumes()
+				restartWatch()
 			}
-			if !fileIdxExists {
-				_ = search.IndexPaths(roots, false)
+		}
+	}()
+
+	// Media recognized on another disk replaces its source in the audio playlist when the source
+	// can no longer be played (moved away or on an unplugged disk).
+	_ = eventbus.GetDefault().Subscribe(consts.EVENT_MEDIA_FINGERPRINT_MATCHED, func(payload map[string]any) {
+		src, _ := payload["sourcePath"].(string)
+		dst, _ := payload["path"].(string)
+		if src == "" || dst == "" {
+			return
+		}
+		if _, err := os.Stat(src); err == nil {
+			return
+		}
+		_ = helpers.RetargetAudioPlaylist(src, dst)
+	})
+}
+
+var (
+	reconcileMu sync.Mutex
+	// seenMounts holds the /mnt/usbN volumes (by FSUUID) mounted at the last reconcile. It starts
+	// empty on purpose: the file watcher only sees changes made while the service runs, so every
+	// volume gets an incremental scan at startup for files added while it was stopped.
+	seenMounts = map[string]bool{}
+)
+
+// reconcileVolumes marks the mounted filesystems active, so results from unplugged disks are
+// reported offline, and builds the segments missing for mounted /mnt/usbN volumes.
+// Each volume is indexed on its own; segments of other volumes are left as they are. Volumes
+// indexed before get an incremental media scan when they are (re)mounted, for files added
+// while they were elsewhere.
+func reconcileVolumes() {
+	reconcileMu.Lock()
+	defer reconcileMu.Unlock()
+
+	ids := media.MountedVolumeIDs()
+	search.SetActiveVolumes(ids)
+	media.SetActiveVolumes(ids)
+	// Media already in Pebble (e.g. indexed before per-volume segments) only needs its segments.
+	if !media.MediaIndexExists() {
+		_ = media.BuildMediaIndex()
+	}
+
+	vols, err := graph.ListMounts()
+	if err != nil {
+		return
+	}
+	mounted := map[string]bool{}
+	defer func() { seenMounts = mounted }()
+	for _, v := range vols {
+		if v.MountPoint == nil {
+			continue
+		}
+		mp := strings.TrimSpace(*v.MountPoint)
+		if mp == "" || !isPlainNASUSBMount(mp) {
+			continue
+		}
+		id := media.VolumeIDForPath(mp)
+		mounted[id] = true
+		if !search.VolumeIndexExists(id) {
+			if err := search.IndexVolume(search.Volume{FSUUID: id, Root: mp}, false); err != nil {
+				log.Errorf("index volume %s: %v", mp, err)
 			}
-			if !mediaIdxExists {
-				for _, r := range roots {
-					_ = media.ScanAndSync(r)
-				}
-				_ = media.BuildMediaIndex()
+		}
+		if !media.MediaVolumeIndexExists(id) {
+			_ = media.ScanAndSync(mp)
+			if err := media.BuildMediaVolumeIndex(id); err != nil {
+				log.Errorf("index media volume %s: %v", mp, err)
 			}
+		} else if !seenMounts[id] {
+			rescan(mp)
 		}
-	}()
+	}
 }
@@ -0,0 +1,116 @@
+package archive
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// indexCacheSize bounds how many parsed archive indexes are kept in memory.
+// Each cached zip index also holds one open file descriptor.
+const indexCacheSize = 16
+
+type indexCache struct {
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+var defaultIndexCache = &indexCache{ll: list.New(), items: map[string]*list.Element{}}
+
+// OpenIndex returns the parsed index of the archive at p.
+// Indexes are cached and reused until the archive's mtime or size changes, so browsing
+// and streaming entries does not re-read the table of contents on every request.
+func OpenIndex(p string) (*Index, error) {
+	p = filepath.Clean(p)
+	format := DetectFormat(p)
+	if format == "" {
+		return nil, fmt.Errorf("unsupported archive format")
+	}
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return nil, fmt.Errorf("not an archive")
+	}
+	if ix := defaultIndexCache.get(p, fi); ix != nil {
+		return ix, nil
+	}
+	ix, err := buildIndex(p, format, fi)
+	if err != nil {
+		return nil, err
+	}
+	return defaultIndexCache.put(ix), nil
+}
+
+func (c *indexCache) get(p string, fi os.FileInfo) *Index {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[p]
+	if !ok {
+		return nil
+	}
+	ix := el.Value.(*Index)
+	if !ix.modTime.Equal(fi.ModTime()) || ix.size != fi.Size() {
+		c.remove(el)
+		return nil
+	}
+	c.ll.MoveToFront(el)
+	return ix
+}
+
+func (c *indexCache) put(ix *Index) *Index {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[ix.Path]; ok {
+		// Another request indexed the same archive concurrently; keep the newer one.
+		c.remove(el)
+	}
+	c.items[ix.Path] = c.ll.PushFront(ix)
+	for c.ll.Len() > indexCacheSize {
+		c.remove(c.ll.Back())
+	}
+	return ix
+}
+
+func (c *indexCache) remove(el *list.Element) {
+	ix := el.Value.(*Index)
+	c.ll.Remove(el)
+	delete(c.items, ix.Path)
+	ix.release()
+}
+
+// VirtualSep separates an archive path from an entry name in virtual paths,
+// e.g. "/mnt/usb1/photos.zip!/2024/img.jpg".
+const VirtualSep = "!/"
+
+// JoinVirtual builds the virtual path of an entry inside the archive at archivePath.
+func JoinVirtual(archivePath string, entry string) string {
+	return archivePath + VirtualSep + CleanEntryName(entry)
+}
+
+// SplitVirtual splits a virtual path into the archive path and the entry name.
+// It only succeeds when the archive part names an existing regular file in a supported
+// format, so real paths that happen to contain "!/" are left alone.
+func SplitVirtual(p string) (archivePath string, entry string, ok bool) {
+	rest := p
+	offset := 0
+	for {
+		i := strings.Index(rest, VirtualSep)
+		if i < 0 {
+			return "", "", false
+		}
+		candidate := p[:offset+i]
+		if DetectFormat(candidate) != "" {
+			if fi, err := os.Stat(candidate); err == nil && fi.Mode().IsRegular() {
+				return candidate, CleanEntryName(p[offset+i+len(VirtualSep):]), true
+			}
+		}
+		offset += i + len(VirtualSep)
+		rest = p[offset:]
+	}
+}
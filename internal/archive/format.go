@@ -0,0 +1,99 @@
+package archive
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Format identifies an archive container (and its outer compression, for tar).
+type Format string
+
+const (
+	FormatZip    Format = "ZIP"
+	FormatTar    Format = "TAR"
+	FormatTarGz  Format = "TAR_GZ"
+	FormatTarZst Format = "TAR_ZST"
+)
+
+// Ext returns the canonical file extension for the format, including the leading dot.
+func (f Format) Ext() string {
+	switch f {
+	case FormatZip:
+		return ".zip"
+	case FormatTar:
+		return ".tar"
+	case FormatTarGz:
+		return ".tar.gz"
+	case FormatTarZst:
+		return ".tar.zst"
+	}
+	return ""
+}
+
+// Valid reports whether f is one of the supported formats.
+func (f Format) Valid() bool {
+	return f.Ext() != ""
+}
+
+// DetectFormat guesses the archive format from a file name.
+// Returns "" when the extension is not a supported archive.
+func DetectFormat(name string) Format {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return FormatZip
+	case strings.HasSuffix(lower, ".tar"):
+		return FormatTar
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return FormatTarGz
+	case strings.HasSuffix(lower, ".tar.zst"), strings.HasSuffix(lower, ".tzst"):
+		return FormatTarZst
+	}
+	return ""
+}
+
+// WithExt appends the format extension to name unless it already has it.
+func WithExt(name string, f Format) string {
+	ext := f.Ext()
+	if ext == "" || strings.HasSuffix(strings.ToLower(name), ext) {
+		return name
+	}
+	return name + ext
+}
+
+// CleanEntryName normalizes an archive entry name to a relative slash path.
+// Returns "" for names that would escape the archive root.
+func CleanEntryName(name string) string {
+	name = strings.TrimSpace(name)
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = path.Clean(name)
+	name = strings.TrimPrefix(name, "/")
+	if name == "." || name == ".." || strings.HasPrefix(name, "../") {
+		return ""
+	}
+	return name
+}
+
+// checkLevel validates a compression level for the format.
+// -1 always means "library default".
+func checkLevel(f Format, level int) error {
+	if level == -1 {
+		return nil
+	}
+	switch f {
+	case FormatZip, FormatTarGz:
+		if level < 0 || level > 9 {
+			return fmt.Errorf("invalid compression level %d (expected 0-9)", level)
+		}
+	case FormatTarZst:
+		if level < 1 || level > 22 {
+			return fmt.Errorf("invalid compression level %d (expected 1-22)", level)
+		}
+	case FormatTar:
+		// Plain tar is never compressed; any level is ignored.
+	default:
+		return fmt.Errorf("unsupported archive format %q", f)
+	}
+	return nil
+}
@@ -0,0 +1,435 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/flate"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	ErrEntryNotFound     = errors.New("archive: entry not found")
+	ErrUnsupportedMethod = errors.New("archive: unsupported compression method")
+)
+
+// Entry describes a file or directory inside an archive.
+type Entry struct {
+	// Name is the cleaned slash path inside the archive, without a trailing slash.
+	Name    string
+	IsDir   bool
+	Size    int64
+	ModTime time.Time
+	Mode    fs.FileMode
+
+	// Location data used by Open; meaning depends on the archive format.
+	zipFile    *zip.File
+	dataOffset int64
+}
+
+// Index is the parsed table of contents of an archive.
+// It is immutable once built and safe for concurrent use.
+type Index struct {
+	Path   string
+	Format Format
+
+	entries []Entry
+	byName  map[string]int
+	// children maps a directory name ("" for the root) to the sorted names of its direct children,
+	// including directories that only exist implicitly through deeper entries.
+	children map[string][]string
+
+	// zf keeps a zip archive open so entries can be opened from the parsed
+	// central directory without re-reading it. It is shared by concurrent readers.
+	zf *sharedFile
+
+	modTime time.Time
+	size    int64
+}
+
+// Entries returns all entries in archive order.
+func (ix *Index) Entries() []Entry {
+	return ix.entries
+}
+
+// Lookup returns the entry for name, including implicit directories.
+func (ix *Index) Lookup(name string) (Entry, bool) {
+	name = CleanEntryName(name)
+	if i, ok := ix.byName[name]; ok {
+		return ix.entries[i], true
+	}
+	if _, ok := ix.children[name]; ok && name != "" {
+		return Entry{Name: name, IsDir: true, Mode: fs.ModeDir | 0o755, ModTime: ix.modTime}, true
+	}
+	return Entry{}, false
+}
+
+// List returns the direct children of the directory prefix ("" for the archive root).
+func (ix *Index) List(prefix string) []Entry {
+	prefix = CleanEntryName(prefix)
+	names := ix.children[prefix]
+	out := make([]Entry, 0, len(names))
+	for _, n := range names {
+		if e, ok := ix.Lookup(n); ok {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// ChildCount returns the number of direct children of a directory entry.
+func (ix *Index) ChildCount(name string) int {
+	return len(ix.children[CleanEntryName(name)])
+}
+
+// EntryReader streams the content of a single entry.
+// When the underlying storage allows it (stored zip entries, plain tar), the reader also
+// implements io.Seeker so HTTP range requests can be served without decompressing.
+type EntryReader interface {
+	io.ReadCloser
+}
+
+// Open returns a reader for the regular file entry name.
+func (ix *Index) Open(name string) (EntryReader, error) {
+	name = CleanEntryName(name)
+	i, ok := ix.byName[name]
+	if !ok || ix.entries[i].IsDir {
+		return nil, ErrEntryNotFound
+	}
+	e := ix.entries[i]
+
+	switch ix.Format {
+	case FormatZip:
+		return ix.openZipEntry(e)
+	case FormatTar:
+		f, err := os.Open(ix.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &sectionReadCloser{SectionReader: io.NewSectionReader(f, e.dataOffset, e.Size), c: f}, nil
+	case FormatTarGz, FormatTarZst:
+		return ix.openCompressedTarEntry(e)
+	}
+	return nil, fmt.Errorf("unsupported archive format %q", ix.Format)
+}
+
+func (ix *Index) openZipEntry(e Entry) (EntryReader, error) {
+	zf := e.zipFile
+	if !ix.zf.acquire() {
+		// The index was evicted meanwhile; the caller may simply retry via OpenIndex.
+		return nil, os.ErrClosed
+	}
+	off, err := zf.DataOffset()
+	if err != nil {
+		_ = ix.zf.Close()
+		return nil, err
+	}
+	section := io.NewSectionReader(ix.zf, off, int64(zf.CompressedSize64))
+	switch zf.Method {
+	case zip.Store:
+		return &sectionReadCloser{SectionReader: section, c: ix.zf}, nil
+	case zip.Deflate:
+		return &streamReadCloser{r: io.LimitReader(flate.NewReader(section), int64(zf.UncompressedSize64)), closers: []io.Closer{ix.zf}}, nil
+	}
+	_ = ix.zf.Close()
+	return nil, ErrUnsupportedMethod
+}
+
+// openCompressedTarEntry decompresses the archive up to the requested entry only.
+// Compressed tar streams are not seekable, so this is the best possible without
+// storing decompression checkpoints.
+func (ix *Index) openCompressedTarEntry(e Entry) (EntryReader, error) {
+	f, err := os.Open(ix.Path)
+	if err != nil {
+		return nil, err
+	}
+	r, dc, err := decompressor(f, ix.Format)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	closeAll := func() {
+		if dc != nil {
+			_ = dc.Close()
+		}
+		_ = f.Close()
+	}
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			closeAll()
+			return nil, ErrEntryNotFound
+		}
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		if CleanEntryName(h.Name) == e.Name && h.Typeflag != tar.TypeDir {
+			closers := []io.Closer{f}
+			if dc != nil {
+				closers = append([]io.Closer{dc}, closers...)
+			}
+			return &streamReadCloser{r: tr, closers: closers}, nil
+		}
+	}
+}
+
+type sectionReadCloser struct {
+	*io.SectionReader
+	c io.Closer
+}
+
+func (s *sectionReadCloser) Close() error {
+	return s.c.Close()
+}
+
+type streamReadCloser struct {
+	r       io.Reader
+	closers []io.Closer
+}
+
+func (s *streamReadCloser) Read(p []byte) (int, error) {
+	return s.r.Read(p)
+}
+
+func (s *streamReadCloser) Close() error {
+	var err error
+	for _, c := range s.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+type zstdReadCloser struct {
+	*zstd.Decoder
+}
+
+func (z zstdReadCloser) Close() error {
+	z.Decoder.Close()
+	return nil
+}
+
+func decompressor(r io.Reader, f Format) (io.Reader, io.Closer, error) {
+	switch f {
+	case FormatTarGz:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		return gz, gz, nil
+	case FormatTarZst:
+		zd, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, nil, err
+		}
+		return zd, zstdReadCloser{zd}, nil
+	}
+	return r, nil, nil
+}
+
+// sharedFile is an *os.File closed once the last holder releases it.
+// The index cache holds one reference; each open zip entry holds another.
+type sharedFile struct {
+	*os.File
+	mu   sync.Mutex
+	refs int
+}
+
+func (s *sharedFile) acquire() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.refs <= 0 {
+		return false
+	}
+	s.refs++
+	return true
+}
+
+func (s *sharedFile) Close() error {
+	s.mu.Lock()
+	s.refs--
+	last := s.refs == 0
+	s.mu.Unlock()
+	if last {
+		return s.File.Close()
+	}
+	return nil
+}
+
+func (ix *Index) release() {
+	if ix.zf != nil {
+		_ = ix.zf.Close()
+	}
+}
+
+// buildIndex reads the table of contents of the archive at p.
+func buildIndex(p string, format Format, fi os.FileInfo) (*Index, error) {
+	ix := &Index{
+		Path:     p,
+		Format:   format,
+		byName:   map[string]int{},
+		children: map[string][]string{},
+		modTime:  fi.ModTime(),
+		size:     fi.Size(),
+	}
+
+	switch format {
+	case FormatZip:
+		if err := ix.readZip(); err != nil {
+			return nil, err
+		}
+	case FormatTar, FormatTarGz, FormatTarZst:
+		if err := ix.readTar(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported archive format %q", format)
+	}
+	ix.linkChildren()
+	return ix, nil
+}
+
+func (ix *Index) add(e Entry) {
+	if e.Name == "" {
+		return
+	}
+	if i, ok := ix.byName[e.Name]; ok {
+		// Later entries win, matching extraction semantics of tar.
+		ix.entries[i] = e
+		return
+	}
+	ix.byName[e.Name] = len(ix.entries)
+	ix.entries = append(ix.entries, e)
+}
+
+func (ix *Index) readZip() error {
+	f, err := os.Open(ix.Path)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	ix.zf = &sharedFile{File: f, refs: 1}
+	zr, err := zip.NewReader(ix.zf, fi.Size())
+	if err != nil {
+		f.Close()
+		return err
+	}
+	for _, zf := range zr.File {
+		isDir := strings.HasSuffix(zf.Name, "/")
+		ix.add(Entry{
+			Name:    CleanEntryName(zf.Name),
+			IsDir:   isDir,
+			Size:    int64(zf.UncompressedSize64),
+			ModTime: zf.Modified,
+			Mode:    zf.Mode(),
+			zipFile: zf,
+		})
+	}
+	return nil
+}
+
+func (ix *Index) readTar() error {
+	f, err := os.Open(ix.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, dc, err := decompressor(f, ix.Format)
+	if err != nil {
+		return err
+	}
+	if dc != nil {
+		defer dc.Close()
+	}
+	// For plain tar, passing the *os.File lets tar.Reader seek over file bodies instead of
+	// reading them, and the current file offset after Next() is where the entry data starts.
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		e := Entry{
+			Name:    CleanEntryName(h.Name),
+			Size:    h.Size,
+			ModTime: h.ModTime,
+			Mode:    h.FileInfo().Mode(),
+		}
+		switch h.Typeflag {
+		case tar.TypeDir:
+			e.IsDir = true
+			e.Size = 0
+		case tar.TypeReg:
+		default:
+			// Links, devices and sparse files have no content we can serve directly.
+			continue
+		}
+		if ix.Format == FormatTar && !e.IsDir {
+			off, err := f.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return err
+			}
+			e.dataOffset = off
+		}
+		ix.add(e)
+	}
+}
+
+// linkChildren builds the directory tree, synthesizing parent directories
+// that have no explicit entry (common in zip files).
+func (ix *Index) linkChildren() {
+	seen := map[string]struct{}{}
+	addChild := func(parent, child string) {
+		key := parent + "\x00" + child
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		ix.children[parent] = append(ix.children[parent], child)
+	}
+	for _, e := range ix.entries {
+		name := e.Name
+		if e.IsDir {
+			if _, ok := ix.children[name]; !ok {
+				ix.children[name] = nil
+			}
+		}
+		for name != "" && name != "." {
+			parent := path.Dir(name)
+			if parent == "." {
+				parent = ""
+			}
+			addChild(parent, name)
+			if _, ok := ix.children[parent]; !ok {
+				ix.children[parent] = nil
+			}
+			name = parent
+		}
+	}
+	for k := range ix.children {
+		names := ix.children[k]
+		sort.Strings(names)
+	}
+}
@@ -0,0 +1,92 @@
+package archive
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeArchive(t *testing.T, f Format, level int) string {
+	t.Helper()
+	root := writeTree(t)
+	dst := filepath.Join(t.TempDir(), "out"+f.Ext())
+	out, err := os.Create(dst)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := Write(context.Background(), out, f, []Item{{Path: root}}, Options{Level: level}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := out.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return dst
+}
+
+func TestOpenIndex_ListAndOpen(t *testing.T) {
+	cases := []struct {
+		format   Format
+		level    int
+		seekable bool
+	}{
+		{FormatZip, 0, true},
+		{FormatZip, -1, false},
+		{FormatTar, -1, true},
+		{FormatTarGz, -1, false},
+		{FormatTarZst, -1, false},
+	}
+	for _, tc := range cases {
+		t.Run(string(tc.format), func(t *testing.T) {
+			p := writeArchive(t, tc.format, tc.level)
+			ix, err := OpenIndex(p)
+			if err != nil {
+				t.Fatalf("OpenIndex: %v", err)
+			}
+
+			root := ix.List("")
+			if len(root) != 1 || root[0].Name != "photos" || !root[0].IsDir {
+				t.Fatalf("root = %+v", root)
+			}
+			if n := ix.ChildCount("photos"); n != 2 {
+				t.Fatalf("ChildCount(photos) = %d, want 2", n)
+			}
+
+			r, err := ix.Open("photos/2024/b.txt")
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			defer r.Close()
+			if _, ok := r.(io.Seeker); ok != tc.seekable {
+				t.Fatalf("seekable = %v, want %v", ok, tc.seekable)
+			}
+			b, err := io.ReadAll(r)
+			if err != nil || string(b) != "world!" {
+				t.Fatalf("content = %q, %v", b, err)
+			}
+
+			if _, err := ix.Open("photos/missing.txt"); err != ErrEntryNotFound {
+				t.Fatalf("err = %v, want ErrEntryNotFound", err)
+			}
+			if again, _ := OpenIndex(p); again != ix {
+				t.Fatalf("expected cached index to be reused")
+			}
+		})
+	}
+}
+
+func TestSplitVirtual(t *testing.T) {
+	p := writeArchive(t, FormatZip, -1)
+
+	archivePath, entry, ok := SplitVirtual(JoinVirtual(p, "photos/a.txt"))
+	if !ok || archivePath != p || entry != "photos/a.txt" {
+		t.Fatalf("SplitVirtual = %q, %q, %v", archivePath, entry, ok)
+	}
+	if _, _, ok := SplitVirtual(filepath.Join(filepath.Dir(p), "missing.zip") + VirtualSep + "a"); ok {
+		t.Fatalf("expected missing archive not to split")
+	}
+	if _, _, ok := SplitVirtual(p); ok {
+		t.Fatalf("expected plain path not to split")
+	}
+}
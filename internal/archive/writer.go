@@ -0,0 +1,308 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Item is a filesystem path to add to an archive.
+// Name is the entry name inside the archive; it defaults to the base name of Path.
+type Item struct {
+	Path string
+	Name string
+}
+
+// Options tune archive creation.
+type Options struct {
+	// Level is the compression level; -1 selects the library default.
+	Level int
+	// Exclude lists absolute paths that are never added (e.g. the archive being written).
+	Exclude []string
+	// OnBytes is called with the number of source bytes consumed.
+	OnBytes func(n int64)
+	// OnItem is called after each non-directory entry has been processed.
+	OnItem func()
+}
+
+type entryWriter interface {
+	dir(name string, fi fs.FileInfo) error
+	file(name string, fi fs.FileInfo, r io.Reader) error
+	symlink(name string, fi fs.FileInfo, target string) error
+	Close() error
+}
+
+// Write streams items into w using the given format.
+// Directories are added recursively. The context is checked between entries and on
+// every read, so cancellation stops the archive promptly; the output is then incomplete.
+func Write(ctx context.Context, w io.Writer, f Format, items []Item, opts Options) error {
+	if err := checkLevel(f, opts.Level); err != nil {
+		return err
+	}
+	ew, err := newEntryWriter(w, f, opts.Level)
+	if err != nil {
+		return err
+	}
+
+	exclude := make(map[string]struct{}, len(opts.Exclude))
+	for _, p := range opts.Exclude {
+		exclude[filepath.Clean(p)] = struct{}{}
+	}
+
+	for _, it := range items {
+		if err := ctx.Err(); err != nil {
+			_ = ew.Close()
+			return err
+		}
+		src := filepath.Clean(it.Path)
+		name := CleanEntryName(it.Name)
+		if name == "" {
+			name = CleanEntryName(filepath.Base(src))
+		}
+		if name == "" {
+			continue
+		}
+		if err := addPath(ctx, ew, src, name, exclude, opts); err != nil {
+			_ = ew.Close()
+			return err
+		}
+	}
+	return ew.Close()
+}
+
+func addPath(ctx context.Context, ew entryWriter, src string, name string, exclude map[string]struct{}, opts Options) error {
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, skip := exclude[p]; skip {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		entry := name
+		if p != src {
+			rel, err := filepath.Rel(src, p)
+			if err != nil {
+				return err
+			}
+			entry = CleanEntryName(name + "/" + filepath.ToSlash(rel))
+			if entry == "" {
+				return nil
+			}
+		}
+
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return ew.dir(entry+"/", fi)
+		}
+		switch {
+		case fi.Mode()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			if err := ew.symlink(entry, fi, target); err != nil {
+				return err
+			}
+		case fi.Mode().IsRegular():
+			if err := addFile(ctx, ew, p, entry, fi, opts.OnBytes); err != nil {
+				return err
+			}
+		default:
+			// Devices, sockets and pipes have no meaningful archived content,
+			// but still count towards item progress.
+		}
+		if opts.OnItem != nil {
+			opts.OnItem()
+		}
+		return nil
+	})
+}
+
+func addFile(ctx context.Context, ew entryWriter, p string, entry string, fi fs.FileInfo, onBytes func(int64)) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return ew.file(entry, fi, &ctxReader{ctx: ctx, r: f, onRead: onBytes})
+}
+
+type ctxReader struct {
+	ctx    context.Context
+	r      io.Reader
+	onRead func(n int64)
+}
+
+func (cr *ctxReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := cr.r.Read(p)
+	if n > 0 && cr.onRead != nil {
+		cr.onRead(int64(n))
+	}
+	return n, err
+}
+
+func newEntryWriter(w io.Writer, f Format, level int) (entryWriter, error) {
+	switch f {
+	case FormatZip:
+		return newZipEntryWriter(w, level), nil
+	case FormatTar:
+		return &tarEntryWriter{tw: tar.NewWriter(w)}, nil
+	case FormatTarGz:
+		if level == -1 {
+			level = gzip.DefaultCompression
+		}
+		gz, err := gzip.NewWriterLevel(w, level)
+		if err != nil {
+			return nil, err
+		}
+		return &tarEntryWriter{tw: tar.NewWriter(gz), outer: gz}, nil
+	case FormatTarZst:
+		zl := zstd.SpeedDefault
+		if level != -1 {
+			zl = zstd.EncoderLevelFromZstd(level)
+		}
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zl))
+		if err != nil {
+			return nil, err
+		}
+		return &tarEntryWriter{tw: tar.NewWriter(zw), outer: zw}, nil
+	}
+	return nil, fmt.Errorf("unsupported archive format %q", f)
+}
+
+type zipEntryWriter struct {
+	zw     *zip.Writer
+	method uint16
+}
+
+func newZipEntryWriter(w io.Writer, level int) *zipEntryWriter {
+	zw := zip.NewWriter(w)
+	method := zip.Deflate
+	if level == 0 {
+		method = zip.Store
+	} else if level > 0 {
+		zw.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+			return flate.NewWriter(out, level)
+		})
+	}
+	return &zipEntryWriter{zw: zw, method: method}
+}
+
+func (z *zipEntryWriter) dir(name string, fi fs.FileInfo) error {
+	h, err := zip.FileInfoHeader(fi)
+	if err != nil {
+		return err
+	}
+	h.Name = name
+	h.Method = zip.Store
+	_, err = z.zw.CreateHeader(h)
+	return err
+}
+
+func (z *zipEntryWriter) file(name string, fi fs.FileInfo, r io.Reader) error {
+	h, err := zip.FileInfoHeader(fi)
+	if err != nil {
+		return err
+	}
+	h.Name = name
+	h.Method = z.method
+	w, err := z.zw.CreateHeader(h)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (z *zipEntryWriter) symlink(name string, fi fs.FileInfo, target string) error {
+	// Info-ZIP convention: symlink mode bits in the external attributes, target as content.
+	h, err := zip.FileInfoHeader(fi)
+	if err != nil {
+		return err
+	}
+	h.Name = name
+	h.Method = zip.Store
+	w, err := z.zw.CreateHeader(h)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, target)
+	return err
+}
+
+func (z *zipEntryWriter) Close() error {
+	return z.zw.Close()
+}
+
+type tarEntryWriter struct {
+	tw    *tar.Writer
+	outer io.WriteCloser
+}
+
+func (t *tarEntryWriter) header(name string, fi fs.FileInfo, link string) error {
+	h, err := tar.FileInfoHeader(fi, link)
+	if err != nil {
+		return err
+	}
+	h.Name = name
+	// PAX keeps long names and sub-second mtimes; uid/gid names are host-specific.
+	h.Format = tar.FormatPAX
+	h.Uname = ""
+	h.Gname = ""
+	return t.tw.WriteHeader(h)
+}
+
+func (t *tarEntryWriter) dir(name string, fi fs.FileInfo) error {
+	return t.header(name, fi, "")
+}
+
+func (t *tarEntryWriter) file(name string, fi fs.FileInfo, r io.Reader) error {
+	if err := t.header(name, fi, ""); err != nil {
+		return err
+	}
+	// Copy exactly the size announced in the header, even if the file grew meanwhile.
+	n, err := io.Copy(t.tw, io.LimitReader(r, fi.Size()))
+	if err != nil {
+		return err
+	}
+	if n != fi.Size() {
+		return fmt.Errorf("%s: file shrank while archiving", name)
+	}
+	return nil
+}
+
+func (t *tarEntryWriter) symlink(name string, fi fs.FileInfo, target string) error {
+	return t.header(name, fi, target)
+}
+
+func (t *tarEntryWriter) Close() error {
+	err := t.tw.Close()
+	if t.outer != nil {
+		if cerr := t.outer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
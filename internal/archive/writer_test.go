@@ -0,0 +1,136 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func writeTree(t *testing.T) string {
+	t.Helper()
+	root := filepath.Join(t.TempDir(), "photos")
+	if err := os.MkdirAll(filepath.Join(root, "2024"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "a.txt"), []byte("hello"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "2024", "b.txt"), []byte("world!"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	return root
+}
+
+func TestWrite_ZipRoundTrip(t *testing.T) {
+	root := writeTree(t)
+	var buf bytes.Buffer
+	var gotBytes int64
+	var gotItems int
+	err := Write(context.Background(), &buf, FormatZip, []Item{{Path: root}}, Options{
+		Level:   9,
+		OnBytes: func(n int64) { gotBytes += n },
+		OnItem:  func() { gotItems++ },
+	})
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if gotBytes != 11 || gotItems != 2 {
+		t.Fatalf("progress = %d bytes, %d items; want 11, 2", gotBytes, gotItems)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	want := []string{"photos/", "photos/2024/", "photos/2024/b.txt", "photos/a.txt"}
+	if len(names) != len(want) {
+		t.Fatalf("names = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("names = %v, want %v", names, want)
+		}
+	}
+}
+
+func TestWrite_TarZstRoundTrip(t *testing.T) {
+	root := writeTree(t)
+	var buf bytes.Buffer
+	if err := Write(context.Background(), &buf, FormatTarZst, []Item{{Path: root, Name: "backup"}}, Options{Level: -1}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	zr, err := zstd.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("zstd.NewReader: %v", err)
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+	contents := map[string]string{}
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("tar.Next: %v", err)
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		b, _ := io.ReadAll(tr)
+		contents[h.Name] = string(b)
+	}
+	if contents["backup/a.txt"] != "hello" || contents["backup/2024/b.txt"] != "world!" {
+		t.Fatalf("unexpected contents: %v", contents)
+	}
+}
+
+func TestWrite_ExcludeAndCancel(t *testing.T) {
+	root := writeTree(t)
+
+	var buf bytes.Buffer
+	var items int
+	err := Write(context.Background(), &buf, FormatTar, []Item{{Path: root}}, Options{
+		Level:   -1,
+		Exclude: []string{filepath.Join(root, "2024")},
+		OnItem:  func() { items++ },
+	})
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if items != 1 {
+		t.Fatalf("items = %d, want 1 (excluded dir must be skipped)", items)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = Write(ctx, io.Discard, FormatTarGz, []Item{{Path: root}}, Options{Level: -1})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
+
+func TestWrite_InvalidLevel(t *testing.T) {
+	root := writeTree(t)
+	if err := Write(context.Background(), io.Discard, FormatTarZst, []Item{{Path: root}}, Options{Level: 0}); err == nil {
+		t.Fatalf("expected error for zstd level 0")
+	}
+	if err := Write(context.Background(), io.Discard, FormatZip, []Item{{Path: root}}, Options{Level: 10}); err == nil {
+		t.Fatalf("expected error for zip level 10")
+	}
+}
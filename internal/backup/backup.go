@@ -0,0 +1,241 @@
+// Package backup exports the PlainNAS state kept in Pebble to a file and restores it.
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/migrations"
+)
+
+// A backup is a gzip-compressed JSON lines file: a header, one line per key and an end line with
+// the number of keys, which detects truncated files.
+const (
+	formatName    = "plainnas-backup"
+	formatVersion = 1
+)
+
+// cachePrefixes are key namespaces PlainNAS regenerates by itself: thumbnails and failed
+// thumbnail markers, extracted document text, media search doc ids (rebuilt with the media
+// index), directory mtimes of incremental media scans, temp values and unfinished uploads.
+var cachePrefixes = []string{"thumb:", "thumbfail:", "thumbref:", "ct:", "media:docid:", "media:dirmtime:", "temp:", "upload:session:"}
+
+type header struct {
+	Format        string    `json:"format"`
+	FormatVersion int       `json:"formatVersion"`
+	SchemaVersion int       `json:"schemaVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Excluded      []string  `json:"excluded,omitempty"`
+}
+
+type line struct {
+	K     []byte `json:"k,omitempty"`
+	V     []byte `json:"v,omitempty"`
+	End   bool   `json:"end,omitempty"`
+	Count int    `json:"count,omitempty"`
+}
+
+// Result describes a written or restored backup.
+type Result struct {
+	Path          string
+	Keys          int
+	Size          int64
+	SchemaVersion int
+	// Previous is where restore moved the database it replaced.
+	Previous string
+}
+
+// Create writes a consistent snapshot of the database to path while PlainNAS keeps running.
+// With excludeCaches, regenerable namespaces are left out. An existing file at path is only
+// replaced when it is a PlainNAS backup.
+func Create(path string, excludeCaches bool) (*Result, error) {
+	path = filepath.Clean(path)
+	if !filepath.IsAbs(path) {
+		return nil, fmt.Errorf("backup path must be absolute")
+	}
+	schema, err := migrations.Version()
+	if err != nil {
+		return nil, err
+	}
+	h := header{Format: formatName, FormatVersion: formatVersion, SchemaVersion: schema, CreatedAt: time.Now().UTC()}
+	if excludeCaches {
+		h.Excluded = cachePrefixes
+	}
+	if fi, err := os.Lstat(path); err == nil && (!fi.Mode().IsRegular() || !isBackup(path)) {
+		return nil, fmt.Errorf("%s exists and is not a PlainNAS backup", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp)
+	defer f.Close()
+
+	zw := gzip.NewWriter(f)
+	enc := json.NewEncoder(zw)
+	if err := enc.Encode(h); err != nil {
+		return nil, err
+	}
+	count := 0
+	err = db.GetDefault().IterateSnapshot(func(key []byte, value []byte) error {
+		for _, p := range h.Excluded {
+			if strings.HasPrefix(string(key), p) {
+				return nil
+			}
+		}
+		count++
+		return enc.Encode(line{K: key, V: value})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := enc.Encode(line{End: true, Count: count}); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, err
+	}
+	return &Result{Path: path, Keys: count, Size: fi.Size(), SchemaVersion: schema}, nil
+}
+
+// isBackup reports whether the file at path starts with a PlainNAS backup header.
+func isBackup(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return false
+	}
+	var h header
+	return json.NewDecoder(zr).Decode(&h) == nil && h.Format == formatName
+}
+
+// Restore replaces the database with the backup at path. PlainNAS must not be running. The
+// backup is validated and written to a new directory first; the replaced database is kept next
+// to it (Result.Previous). A backup of an older schema is migrated at the next start; one of a
+// newer schema is refused.
+func Restore(path string) (*Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("not a PlainNAS backup: %w", err)
+	}
+	dec := json.NewDecoder(zr)
+	var h header
+	if err := dec.Decode(&h); err != nil || h.Format != formatName {
+		return nil, fmt.Errorf("not a PlainNAS backup")
+	}
+	if h.FormatVersion > formatVersion {
+		return nil, fmt.Errorf("backup format v%d is newer than this binary (v%d)", h.FormatVersion, formatVersion)
+	}
+	if h.SchemaVersion > migrations.Latest() {
+		return nil, fmt.Errorf("%w: backup is at v%d, this binary supports up to v%d", migrations.ErrNewerSchema, h.SchemaVersion, migrations.Latest())
+	}
+
+	// Opening the live database fails while the service holds it.
+	live := db.Path()
+	if _, err := os.Stat(live); err == nil {
+		cur, err := db.OpenAt(live)
+		if err != nil {
+			return nil, fmt.Errorf("open %s (stop the plainnas service first): %w", live, err)
+		}
+		_ = cur.Close()
+	}
+
+	stage := live + ".restore"
+	_ = os.RemoveAll(stage)
+	out, err := db.OpenAt(stage)
+	if err != nil {
+		return nil, err
+	}
+	count, err := restoreLines(dec, out)
+	if err == nil {
+		err = out.Flush()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.RemoveAll(stage)
+		return nil, err
+	}
+
+	res := &Result{Path: path, Keys: count, SchemaVersion: h.SchemaVersion}
+	if _, err := os.Stat(live); err == nil {
+		res.Previous = fmt.Sprintf("%s.before-restore-%d", live, time.Now().Unix())
+		if err := os.Rename(live, res.Previous); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.Rename(stage, live); err != nil {
+		return nil, err
+	}
+	// Media search doc ids belong to the restored records; the index is rebuilt at the next start.
+	_ = media.ResetMediaIndex()
+	return res, nil
+}
+
+func restoreLines(dec *json.Decoder, out *db.PebbleDB) (int, error) {
+	const batchSize = 1000
+	keys := make([][]byte, 0, batchSize)
+	values := make([][]byte, 0, batchSize)
+	count := 0
+	for {
+		var l line
+		if err := dec.Decode(&l); err != nil {
+			if errors.Is(err, io.EOF) {
+				return count, fmt.Errorf("backup is truncated")
+			}
+			return count, err
+		}
+		if l.End {
+			if l.Count != count {
+				return count, fmt.Errorf("backup lists %d keys, found %d", l.Count, count)
+			}
+			return count, out.BatchSet(keys, values)
+		}
+		if len(l.K) == 0 {
+			return count, fmt.Errorf("backup has an empty key")
+		}
+		keys = append(keys, l.K)
+		values = append(values, l.V)
+		count++
+		if len(keys) == batchSize {
+			if err := out.BatchSet(keys, values); err != nil {
+				return count, err
+			}
+			keys, values = keys[:0], values[:0]
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/db"
+)
+
+func TestMain(m *testing.M) {
+	tmp, err := os.MkdirTemp("", "plainnas-backup-test-*")
+	if err != nil {
+		panic(err)
+	}
+	consts.DATA_DIR = tmp
+	code := m.Run()
+	_ = os.RemoveAll(tmp)
+	os.Exit(code)
+}
+
+func TestBackupRestore(t *testing.T) {
+	peb := db.GetDefault()
+	_ = peb.Set([]byte("tag:t1"), []byte(`{"id":"t1","name":"trip"}`), nil)
+	_ = peb.Set([]byte("settings:samba"), []byte(`{"enabled":false}`), nil)
+	_ = peb.Set([]byte("ct:42"), []byte(`{"text":"cached"}`), nil)
+	_ = peb.Set([]byte("thumb:/mnt/a.jpg"), []byte("webp"), nil)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.backup")
+	res, err := Create(path, true)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if res.Keys != 2 {
+		t.Fatalf("backup keys = %d, want 2", res.Keys)
+	}
+	// An existing backup is replaced, any other file is not.
+	if _, err := Create(path, true); err != nil {
+		t.Fatalf("Create over a backup: %v", err)
+	}
+	other := filepath.Join(dir, "notes.txt")
+	_ = os.WriteFile(other, []byte("keep"), 0o600)
+	if _, err := Create(other, true); err == nil {
+		t.Fatalf("Create replaced a file that is not a backup")
+	}
+	if b, _ := os.ReadFile(other); string(b) != "keep" {
+		t.Fatalf("notes.txt = %q", b)
+	}
+
+	if _, err := Restore(path); err == nil || !strings.Contains(err.Error(), "stop the plainnas service") {
+		t.Fatalf("Restore while the database is open: err = %v", err)
+	}
+
+	// A truncated backup is refused and leaves the database alone.
+	raw, _ := os.ReadFile(path)
+	zr, _ := gzip.NewReader(bytes.NewReader(raw))
+	plain, _ := io.ReadAll(zr)
+	lines := bytes.SplitAfter(plain, []byte("\n"))
+	var trunc bytes.Buffer
+	zw := gzip.NewWriter(&trunc)
+	_, _ = zw.Write(bytes.Join(lines[:2], nil))
+	_ = zw.Close()
+	truncPath := filepath.Join(dir, "trunc.backup")
+	_ = os.WriteFile(truncPath, trunc.Bytes(), 0o600)
+
+	_ = peb.Set([]byte("tag:t2"), []byte(`{"id":"t2"}`), nil)
+	_ = peb.Close()
+
+	if _, err := Restore(truncPath); err == nil {
+		t.Fatalf("Restore accepted a truncated backup")
+	}
+
+	res, err = Restore(path)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if res.Keys != 2 || res.Previous == "" {
+		t.Fatalf("restore result = %+v", res)
+	}
+	got, err := db.OpenAt(db.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Close()
+	if v, _ := got.Get([]byte("tag:t1")); string(v) != `{"id":"t1","name":"trip"}` {
+		t.Fatalf("tag:t1 = %q", v)
+	}
+	for _, k := range []string{"tag:t2", "ct:42", "thumb:/mnt/a.jpg"} {
+		if v, _ := got.Get([]byte(k)); v != nil {
+			t.Fatalf("%s restored: %q", k, v)
+		}
+	}
+}
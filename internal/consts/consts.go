@@ -7,8 +7,15 @@ const (
 
 	EVENT_SERVICE_STATE_CHANGED = "service:state:changed"
 
-	EVENT_MEDIA_SCAN_PROGRESS = "media:scan:progress"
-	EVENT_FILE_TASK_PROGRESS  = "file:task:progress"
+	EVENT_MEDIA_SCAN_PROGRESS   = "media:scan:progress"
+	EVENT_SEARCH_INDEX_PROGRESS = "search:index:progress"
+	EVENT_FILE_TASK_PROGRESS    = "file:task:progress"
+	EVENT_UPLOAD_BATCH_DONE     = "upload:batch:done"
+
+	EVENT_STORAGE_MOUNTS_CHANGED = "storage:mounts:changed"
+
+	EVENT_MEDIA_FINGERPRINT_MATCHED = "media:fingerprint:matched"
+	EVENT_MEDIA_SOURCE_DIRS_CHANGED = "media:source_dirs:changed"
 
 	EVENT_DLNA_RENDERER_FOUND  = "dlna:renderer:found"
 	EVENT_DLNA_DISCOVERY_DONE  = "dlna:discovery:done"
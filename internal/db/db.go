@@ -139,6 +139,43 @@ func (p *PebbleDB) Iterate(prefix []byte, fn func(key []byte, value []byte) erro
 	return iter.Error()
 }
 
+// IterateAfter iterates over key-value pairs with a given prefix whose key sorts after the key
+// after, in lexicographic order. It is the building block for keyset pagination.
+func (p *PebbleDB) IterateAfter(prefix []byte, after []byte, fn func(key []byte, value []byte) error) error {
+	lower := prefix
+	if hasPrefix(after, prefix) {
+		// The smallest key greater than after.
+		lower = append(append(make([]byte, 0, len(after)+1), after...), 0)
+	}
+	iter, err := p.db.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: nextPrefix(prefix),
+	})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if !hasPrefix(key, prefix) {
+			break
+		}
+		value := iter.Value()
+		keyCopy := make([]byte, len(key))
+		valueCopy := make([]byte, len(value))
+		copy(keyCopy, key)
+		copy(valueCopy, value)
+		if err := fn(keyCopy, valueCopy); err != nil {
+			if errors.Is(err, ErrIterateStop) {
+				return nil
+			}
+			return err
+		}
+	}
+	return iter.Error()
+}
+
 func nextPrefix(prefix []byte) []byte {
 	if len(prefix) == 0 {
 		return nil
@@ -0,0 +1,82 @@
+package db
+
+// maxFsckIssues bounds the issues listed in a report; all of them are still counted.
+const maxFsckIssues = 1000
+
+// FsckReport collects the findings of a catalog consistency check. Each package checks the
+// indexes it maintains and reports through it; in apply mode every finding is repaired as soon
+// as it is reported, otherwise nothing is written (dry run).
+type FsckReport struct {
+	Apply bool `json:"apply"`
+	// Entries is the number of records and index keys examined.
+	Entries   int         `json:"entries"`
+	Issues    []FsckIssue `json:"issues"`
+	Counts    []FsckCount `json:"counts"`
+	Truncated bool        `json:"truncated"`
+
+	countIdx map[string]int
+}
+
+// FsckIssue is one inconsistent record or index key.
+type FsckIssue struct {
+	Check    string `json:"check"`
+	Key      string `json:"key"`
+	Problem  string `json:"problem"`
+	Repaired bool   `json:"repaired"`
+}
+
+// FsckCount sums the issues of one kind.
+type FsckCount struct {
+	Check    string `json:"check"`
+	Problem  string `json:"problem"`
+	Count    int    `json:"count"`
+	Repaired int    `json:"repaired"`
+}
+
+func NewFsckReport(apply bool) *FsckReport {
+	return &FsckReport{Apply: apply, countIdx: map[string]int{}}
+}
+
+// Entry counts one examined record or key.
+func (r *FsckReport) Entry() { r.Entries++ }
+
+// Report records an issue found by check at key. In apply mode repair is run to fix it.
+func (r *FsckReport) Report(check, key, problem string, repair func() error) {
+	repaired := false
+	if r.Apply && repair != nil {
+		repaired = repair() == nil
+	}
+	ci, ok := r.countIdx[check+"\x00"+problem]
+	if !ok {
+		ci = len(r.Counts)
+		r.countIdx[check+"\x00"+problem] = ci
+		r.Counts = append(r.Counts, FsckCount{Check: check, Problem: problem})
+	}
+	r.Counts[ci].Count++
+	if repaired {
+		r.Counts[ci].Repaired++
+	}
+	if len(r.Issues) >= maxFsckIssues {
+		r.Truncated = true
+		return
+	}
+	r.Issues = append(r.Issues, FsckIssue{Check: check, Key: key, Problem: problem, Repaired: repaired})
+}
+
+// IssueCount returns the number of issues found.
+func (r *FsckReport) IssueCount() int {
+	n := 0
+	for _, c := range r.Counts {
+		n += c.Count
+	}
+	return n
+}
+
+// RepairedCount returns the number of issues repaired.
+func (r *FsckReport) RepairedCount() int {
+	n := 0
+	for _, c := range r.Counts {
+		n += c.Repaired
+	}
+	return n
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const ignorePatternsKey = "settings:ignore_patterns"
+
+// DefaultIgnorePatterns are the global ignore patterns until they are set.
+var DefaultIgnorePatterns = []string{
+	"node_modules/",
+	"@eaDir/",
+	".thumbnails/",
+	"*.vmdk",
+	"*.vdi",
+	"*.qcow2",
+	"*.vhdx",
+}
+
+// GetIgnorePatterns returns the global ignore patterns (gitignore syntax) applied to scanning and
+// indexing on top of the .plainnasignore files.
+func GetIgnorePatterns() []string {
+	raw, err := GetDefault().Get([]byte(ignorePatternsKey))
+	if err != nil || raw == nil {
+		return append([]string(nil), DefaultIgnorePatterns...)
+	}
+	var patterns []string
+	if err := json.Unmarshal(raw, &patterns); err != nil {
+		return append([]string(nil), DefaultIgnorePatterns...)
+	}
+	return patterns
+}
+
+// SetIgnorePatterns stores the global ignore patterns; blank lines are dropped. An empty list is
+// kept as such and disables the defaults.
+func SetIgnorePatterns(patterns []string) error {
+	clean := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		if p = strings.TrimSpace(p); p != "" {
+			clean = append(clean, p)
+		}
+	}
+	b, err := json.Marshal(clean)
+	if err != nil {
+		return err
+	}
+	SetValue(ignorePatternsKey, string(b))
+	return nil
+}
@@ -0,0 +1,33 @@
+package db
+
+import "testing"
+
+func TestIterateAfterResumesPastKey(t *testing.T) {
+	peb := GetDefault()
+	for _, k := range []string{"it:a:1", "it:a:2", "it:a:3", "it:b:1"} {
+		if err := peb.Set([]byte(k), []byte{}, nil); err != nil {
+			t.Fatalf("set %s: %v", k, err)
+		}
+	}
+	collect := func(after string) []string {
+		var got []string
+		err := peb.IterateAfter([]byte("it:a:"), []byte(after), func(k, _ []byte) error {
+			got = append(got, string(k))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("iterate after %q: %v", after, err)
+		}
+		return got
+	}
+	if got := collect("it:a:1"); len(got) != 2 || got[0] != "it:a:2" || got[1] != "it:a:3" {
+		t.Fatalf("after it:a:1: got %v", got)
+	}
+	if got := collect("it:a:3"); len(got) != 0 {
+		t.Fatalf("after last key: got %v", got)
+	}
+	// A key outside the prefix starts from the beginning.
+	if got := collect(""); len(got) != 3 {
+		t.Fatalf("no after: got %v", got)
+	}
+}
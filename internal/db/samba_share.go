@@ -6,16 +6,6 @@ import (
 	"strings"
 )
 
-type SambaShareAccess string
-
-const (
-	SambaShareAccessAnyoneWrite SambaShareAccess = "ANYONE_WRITE" // guest + read-write
-	SambaShareAccessAnyoneRead  SambaShareAccess = "ANYONE_READ"  // guest + read-only
-	SambaShareAccessPassword    SambaShareAccess = "PASSWORD"     // password + read-write (legacy)
-)
-
-// New model (multi shares)
-
 type SambaShareAuth string
 
 const (
@@ -111,12 +101,3 @@ func normalizeAbsPath(p string) string {
 	}
 	return p
 }
-
-func normalizeSambaShareAccess(a SambaShareAccess) SambaShareAccess {
-	switch a {
-	case SambaShareAccessAnyoneWrite, SambaShareAccessAnyoneRead, SambaShareAccessPassword:
-		return a
-	default:
-		return SambaShareAccessAnyoneRead
-	}
-}
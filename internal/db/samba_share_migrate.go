@@ -0,0 +1,51 @@
+package db
+
+import (
+	"encoding/json"
+	"path/filepath"
+)
+
+// SambaShareAccess is the access mode of the single share stored before multi-share settings.
+type SambaShareAccess string
+
+const (
+	SambaShareAccessAnyoneWrite SambaShareAccess = "ANYONE_WRITE" // guest + read-write
+	SambaShareAccessAnyoneRead  SambaShareAccess = "ANYONE_READ"  // guest + read-only
+	SambaShareAccessPassword    SambaShareAccess = "PASSWORD"     // password + read-write
+)
+
+// legacySambaSettings is the single-share layout of settings:samba.
+type legacySambaSettings struct {
+	SharePath string           `json:"share_path"`
+	Access    SambaShareAccess `json:"access"`
+}
+
+// MigrateLegacySambaSettings converts single-share Samba settings to one entry of Shares,
+// keeping its access mode, and stores the settings normalized. Settings without a legacy share
+// are only normalized.
+func MigrateLegacySambaSettings() error {
+	b, err := GetDefault().Get([]byte(sambaSettingsKey()))
+	if err != nil || b == nil {
+		return err
+	}
+	var s SambaSettings
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	var legacy legacySambaSettings
+	_ = json.Unmarshal(b, &legacy)
+	if len(s.Shares) == 0 && legacy.SharePath != "" {
+		sh := SambaShare{Name: filepath.Base(legacy.SharePath), SharePath: legacy.SharePath, Auth: SambaShareAuthGuest}
+		switch legacy.Access {
+		case SambaShareAccessPassword:
+			sh.Auth = SambaShareAuthPassword
+		case SambaShareAccessAnyoneWrite:
+			// guest, read-write
+		default:
+			// Unknown modes fall back to read-only guest access, as before.
+			sh.ReadOnly = true
+		}
+		s.Shares = []SambaShare{sh}
+	}
+	return StoreSambaSettings(s)
+}
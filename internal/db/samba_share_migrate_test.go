@@ -0,0 +1,21 @@
+package db
+
+import "testing"
+
+func TestMigrateLegacySambaSettings(t *testing.T) {
+	raw := `{"enabled":true,"share_path":"/mnt/usb1/share","access":"PASSWORD","has_password":true}`
+	if err := GetDefault().Set([]byte(sambaSettingsKey()), []byte(raw), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MigrateLegacySambaSettings(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	s := GetSambaSettings()
+	if !s.Enabled || len(s.Shares) != 1 {
+		t.Fatalf("settings = %+v", s)
+	}
+	sh := s.Shares[0]
+	if sh.Name != "share" || sh.SharePath != "/mnt/usb1/share" || sh.Auth != SambaShareAuthPassword || sh.ReadOnly {
+		t.Fatalf("share = %+v", sh)
+	}
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"github.com/cockroachdb/pebble"
+)
+
+// Path returns the directory of the default database.
+func Path() string { return dbPath() }
+
+// OpenAt opens a database in dir, separate from the default one; restores are written to a new
+// directory this way. It fails while another process holds the database in dir.
+func OpenAt(dir string) (*PebbleDB, error) {
+	db, err := pebble.Open(dir, &pebble.Options{Logger: nil})
+	if err != nil {
+		return nil, err
+	}
+	return &PebbleDB{db: db}, nil
+}
+
+// IterateSnapshot calls fn for every key of a point-in-time view of the database, in key order.
+// Writes made while it runs are not seen, so the keys are consistent with each other.
+func (p *PebbleDB) IterateSnapshot(fn func(key []byte, value []byte) error) error {
+	snap := p.db.NewSnapshot()
+	defer snap.Close()
+	iter, err := snap.NewIter(nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := append([]byte(nil), iter.Key()...)
+		value := append([]byte(nil), iter.Value()...)
+		if err := fn(key, value); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// BatchSet writes key-value pairs in one batch.
+func (p *PebbleDB) BatchSet(keys [][]byte, values [][]byte) error {
+	b := p.db.NewBatch()
+	for i := range keys {
+		if err := b.Set(keys[i], values[i], nil); err != nil {
+			_ = b.Close()
+			return err
+		}
+	}
+	if err := b.Commit(&pebble.WriteOptions{Sync: false}); err != nil {
+		_ = b.Close()
+		return err
+	}
+	return b.Close()
+}
+
+// Flush writes the memtable to disk.
+func (p *PebbleDB) Flush() error { return p.db.Flush() }
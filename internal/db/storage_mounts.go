@@ -22,3 +22,48 @@ func StoreFSUUIDSlotMap(m map[string]int) error {
 	}
 	return GetDefault().StoreJSON(storageFSUUIDSlotKey(), m)
 }
+
+func storageKnownVolumesKey() string {
+	return "storage:known_volumes"
+}
+
+// KnownVolume is a filesystem that has been mounted under /mnt/usbX. Its entry is kept after the
+// device is unplugged, so the UI can tell which disk holds an offline file.
+type KnownVolume struct {
+	FSType    string `json:"fsType"`
+	SizeBytes uint64 `json:"sizeBytes"`
+	Slot      int    `json:"slot"`
+	// Online is true while the volume is mounted; LastSeen is when it was last seen mounted
+	// (unix seconds).
+	Online   bool  `json:"online"`
+	LastSeen int64 `json:"lastSeen"`
+}
+
+// GetKnownVolumes loads the known volumes keyed by FSUUID.
+// Missing key returns an empty map.
+func GetKnownVolumes() map[string]KnownVolume {
+	m := map[string]KnownVolume{}
+	_ = GetDefault().LoadJSON(storageKnownVolumesKey(), &m)
+	return m
+}
+
+// UpdateKnownVolumes records the currently mounted volumes as seen at now. Known volumes that
+// are no longer mounted are marked offline, keeping now as their last-seen time when they were
+// online until this update.
+func UpdateKnownVolumes(mounted map[string]KnownVolume, now int64) error {
+	m := GetKnownVolumes()
+	for id, v := range m {
+		if _, ok := mounted[id]; ok || !v.Online {
+			continue
+		}
+		v.Online = false
+		v.LastSeen = now
+		m[id] = v
+	}
+	for id, v := range mounted {
+		v.Online = true
+		v.LastSeen = now
+		m[id] = v
+	}
+	return GetDefault().StoreJSON(storageKnownVolumesKey(), m)
+}
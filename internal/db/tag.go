@@ -47,20 +47,10 @@ func tagRelationPrefixForTagID(tagID string) string {
 	return tagRelationPrefix + tagID + ":"
 }
 
-// EnsureTagRelationKeyIndex ensures the tag_relation_key: secondary index exists.
-// For older DBs that only have tag_relation: keys, it backfills the new index.
-func EnsureTagRelationKeyIndex() error {
+// BackfillTagRelationKeyIndex writes the tag_relation_key: secondary index for every
+// tag_relation: key. Older DBs only have the primary keys.
+func BackfillTagRelationKeyIndex() error {
 	db := GetDefault()
-	found := false
-	_ = db.Iterate([]byte(tagRelationByKeyPrefix), func(_ []byte, _ []byte) error {
-		found = true
-		return ErrIterateStop
-	})
-	if found {
-		return nil
-	}
-
-	// Backfill: read primary keys and populate the by-key index.
 	return db.Iterate([]byte(tagRelationPrefix), func(dbKey []byte, _ []byte) error {
 		parts := strings.Split(string(dbKey), ":")
 		if len(parts) < 3 {
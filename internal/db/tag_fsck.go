@@ -0,0 +1,95 @@
+package db
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// CheckTagRelations cross-checks tags, the tag_relation: keys, their tag_relation_key: index and
+// Tag.Count. keyExists reports whether a relation key (a media UUID) is still cataloged;
+// relations of unknown keys or tags are orphans.
+func CheckTagRelations(r *FsckReport, keyExists func(key string) bool) error {
+	const check = "tags"
+	db := GetDefault()
+
+	tags := map[string]*Tag{}
+	if err := db.Iterate([]byte(tagPrefix), func(key []byte, value []byte) error {
+		r.Entry()
+		var tag Tag
+		if err := json.Unmarshal(value, &tag); err != nil || tag.ID == "" {
+			k := string(key)
+			r.Report(check, k, "corrupt tag", func() error { return db.Delete([]byte(k)) })
+			return nil
+		}
+		tags[tag.ID] = &tag
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	counts := make(map[string]int, len(tags))
+	primary := map[string]struct{}{}
+	if err := db.Iterate([]byte(tagRelationPrefix), func(dbKey []byte, _ []byte) error {
+		r.Entry()
+		k := string(dbKey)
+		parts := strings.Split(k, ":")
+		if len(parts) < 3 || parts[1] == "" || parts[2] == "" {
+			r.Report(check, k, "malformed relation key", func() error { return db.Delete([]byte(k)) })
+			return nil
+		}
+		// parts[0] = "tag_relation", parts[1] = tagID, parts[2] = key
+		tagID, key := parts[1], parts[2]
+		// The by-key index of a relation goes with it; it is not reported on its own.
+		primary[tagID+":"+key] = struct{}{}
+		drop := func() error {
+			if err := db.Delete([]byte(k)); err != nil {
+				return err
+			}
+			return db.Delete([]byte(tagRelationByKeyKey(key, tagID)))
+		}
+		if _, ok := tags[tagID]; !ok {
+			r.Report(check, k, "relation of missing tag", drop)
+			return nil
+		}
+		if !keyExists(key) {
+			r.Report(check, k, "relation of missing item", drop)
+			return nil
+		}
+		counts[tagID]++
+		if v, _ := db.Get([]byte(tagRelationByKeyKey(key, tagID))); v == nil {
+			r.Report(check, k, "missing by-key index", func() error {
+				return db.Set([]byte(tagRelationByKeyKey(key, tagID)), tagRelationValue, nil)
+			})
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := db.Iterate([]byte(tagRelationByKeyPrefix), func(dbKey []byte, _ []byte) error {
+		r.Entry()
+		k := string(dbKey)
+		parts := strings.Split(k, ":")
+		// parts[0] = "tag_relation_key", parts[1] = key, parts[2] = tagID
+		if len(parts) < 3 {
+			r.Report(check, k, "malformed by-key index", func() error { return db.Delete([]byte(k)) })
+			return nil
+		}
+		if _, ok := primary[parts[2]+":"+parts[1]]; !ok {
+			r.Report(check, k, "dangling by-key index", func() error { return db.Delete([]byte(k)) })
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if tag.Count == counts[tag.ID] {
+			continue
+		}
+		t := *tag
+		t.Count = counts[tag.ID]
+		r.Report(check, tagKey(tag.ID), "wrong count", func() error { return SaveTag(&t) })
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package db
+
+import "testing"
+
+func TestCheckTagRelations(t *testing.T) {
+	id, err := AddOrUpdateTag("", func(tag *Tag) {
+		tag.Name = "fsck"
+		tag.Type = 1
+	})
+	if err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	if err := SaveTagRelations([]*TagRelationRef{{TagID: id, Key: "live1"}, {TagID: id, Key: "live2"}, {TagID: id, Key: "gone"}}); err != nil {
+		t.Fatalf("save relations: %v", err)
+	}
+	peb := GetDefault()
+	_ = peb.Delete([]byte(tagRelationByKeyKey("live2", id)))
+	_ = peb.Set([]byte(tagRelationByKeyKey("stray", id)), tagRelationValue, nil)
+	exists := func(key string) bool { return key != "gone" }
+
+	dry := NewFsckReport(false)
+	if err := CheckTagRelations(dry, exists); err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	// gone relation, missing by-key index of live2, stray by-key index, count 3 instead of 2
+	if got := dry.IssueCount(); got != 4 {
+		t.Fatalf("dry run issues = %d, want 4: %+v", got, dry.Issues)
+	}
+	if dry.RepairedCount() != 0 {
+		t.Fatalf("dry run repaired %d issues", dry.RepairedCount())
+	}
+	if v, _ := peb.Get([]byte(tagRelationByKeyKey("stray", id))); v == nil {
+		t.Fatalf("dry run deleted a key")
+	}
+
+	apply := NewFsckReport(true)
+	if err := CheckTagRelations(apply, exists); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if apply.RepairedCount() != apply.IssueCount() {
+		t.Fatalf("repaired %d of %d issues", apply.RepairedCount(), apply.IssueCount())
+	}
+	tag, _ := GetTagByID(id)
+	if tag.Count != 2 {
+		t.Fatalf("count after repair = %d, want 2", tag.Count)
+	}
+
+	again := NewFsckReport(false)
+	if err := CheckTagRelations(again, exists); err != nil {
+		t.Fatalf("recheck: %v", err)
+	}
+	if again.IssueCount() != 0 {
+		t.Fatalf("issues after repair: %+v", again.Issues)
+	}
+}
@@ -0,0 +1,83 @@
+package fs
+
+import (
+	"errors"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// ErrCloneUnsupported is returned by Reflink when the filesystem cannot share extents
+// between the two paths (not btrfs/xfs/bcachefs, or different filesystems).
+var ErrCloneUnsupported = errors.New("reflink not supported")
+
+// SameFilesystem reports whether a and b live on the same filesystem. Paths that do not
+// exist yet are resolved to their nearest existing ancestor.
+func SameFilesystem(a, b string) bool {
+	var sa, sb syscall.Stat_t
+	if syscall.Stat(nearestExisting(a), &sa) != nil || syscall.Stat(nearestExisting(b), &sb) != nil {
+		return false
+	}
+	return sa.Dev == sb.Dev
+}
+
+// CanReflink reports whether the filesystem holding p is one that supports reflinks (btrfs,
+// XFS, bcachefs). XFS formatted without reflink support still reports true; Reflink then
+// returns ErrCloneUnsupported.
+func CanReflink(p string) bool {
+	switch fsMagic(p) {
+	case unix.BTRFS_SUPER_MAGIC, unix.XFS_SUPER_MAGIC, unix.BCACHEFS_SUPER_MAGIC:
+		return true
+	}
+	return false
+}
+
+// CanHardlink reports whether the filesystem holding p supports hardlinks (FAT and exFAT
+// do not).
+func CanHardlink(p string) bool {
+	switch fsMagic(p) {
+	case 0, unix.MSDOS_SUPER_MAGIC, unix.EXFAT_SUPER_MAGIC:
+		return false
+	}
+	return true
+}
+
+// fsMagic returns the filesystem type of the filesystem holding p, or 0 if it is unknown.
+func fsMagic(p string) int64 {
+	var st unix.Statfs_t
+	if unix.Statfs(nearestExisting(p), &st) != nil {
+		return 0
+	}
+	return int64(st.Type)
+}
+
+// Reflink creates dst as a copy-on-write clone of src (FICLONE). dst must not exist.
+// The clone is a separate inode, so later edits of either file do not affect the other.
+func Reflink(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	fi, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fi.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	err = unix.IoctlFileClone(int(out.Fd()), int(in.Fd()))
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(dst)
+		if errors.Is(err, unix.EOPNOTSUPP) || errors.Is(err, unix.EXDEV) || errors.Is(err, unix.EINVAL) || errors.Is(err, unix.ENOTTY) {
+			return ErrCloneUnsupported
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package fs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// copyChunk is the most copy_file_range/sendfile move per call, so progress keeps flowing on
+	// large files.
+	copyChunk = 8 << 20
+	// copyWorkers bounds the files of a tree copied at once. Files of at least copyInlineSize are
+	// copied one at a time by the walker instead: parallelism only pays off for small files,
+	// where per-file latency dominates.
+	copyWorkers    = 8
+	copyInlineSize = 4 << 20
+)
+
+var copyBufPool = sync.Pool{New: func() any { b := make([]byte, 1<<20); return &b }}
+
+// CopyFile copies src to dst, replacing dst, and keeps the mode, mtime and extended attributes
+// of src. The data is cloned (FICLONE) when both are on the same filesystem, otherwise copied
+// in the kernel with copy_file_range or sendfile, and through a buffer as a last resort.
+// onBytes, when set, receives the number of bytes copied as they are. Canceling ctx stops the
+// copy between chunks and removes the partial dst; the error then wraps ctx.Err().
+func CopyFile(ctx context.Context, src, dst string, onBytes func(n int64)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	fi, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = out.Close() }()
+
+	if err := copyData(ctx, out, in, fi.Size(), SameFilesystem(src, dst), onBytes); err != nil {
+		if ctx.Err() != nil {
+			_ = out.Close()
+			_ = os.Remove(dst)
+		}
+		return err
+	}
+	_ = out.Chmod(fi.Mode().Perm())
+	copyXattrs(out, in)
+	if err := out.Sync(); err != nil {
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	// Writing bumps the mtime, so the timestamps go last.
+	return os.Chtimes(dst, statAtime(fi), fi.ModTime())
+}
+
+// CopyTree copies the directory src into dst, merging into dst if it exists. Small files are
+// copied by a bounded pool of workers. onBytes and onFile, when set, are called from several
+// goroutines. The first error, or canceling ctx, stops the copy; files copied by then are kept.
+func CopyTree(ctx context.Context, src, dst string, onBytes func(n int64), onFile func()) error {
+	type job struct{ src, dst string }
+	var (
+		wg       sync.WaitGroup
+		errMu    sync.Mutex
+		firstErr error
+	)
+	setErr := func(err error) {
+		errMu.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		errMu.Unlock()
+	}
+	failed := func() bool {
+		errMu.Lock()
+		defer errMu.Unlock()
+		return firstErr != nil
+	}
+	copyOne := func(j job) {
+		if failed() {
+			return
+		}
+		if err := CopyFile(ctx, j.src, j.dst, onBytes); err != nil {
+			setErr(err)
+			return
+		}
+		if onFile != nil {
+			onFile()
+		}
+	}
+
+	jobs := make(chan job)
+	for i := 0; i < copyWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				copyOne(j)
+			}
+		}()
+	}
+
+	var dirs []job
+	err := filepath.WalkDir(src, func(p string, d os.DirEntry, e error) error {
+		if e != nil {
+			return e
+		}
+		if failed() {
+			return filepath.SkipAll
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(src, p)
+		j := job{src: p, dst: filepath.Join(dst, rel)}
+		if d.IsDir() {
+			dirs = append(dirs, j)
+			return os.MkdirAll(j.dst, 0o755)
+		}
+		if info, err := d.Info(); err == nil && info.Mode().IsRegular() && info.Size() >= copyInlineSize {
+			copyOne(j)
+			return nil
+		}
+		jobs <- j
+		return nil
+	})
+	close(jobs)
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+
+	// Directory attributes go last, deepest first, once nothing is created in them anymore.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		copyDirAttrs(dirs[i].src, dirs[i].dst)
+	}
+	return nil
+}
+
+// copyData copies all of in to out, which are both at offset 0, checking ctx between chunks.
+func copyData(ctx context.Context, out, in *os.File, size int64, sameFS bool, onBytes func(n int64)) error {
+	if sameFS && size > 0 {
+		if err := unix.IoctlFileClone(int(out.Fd()), int(in.Fd())); err == nil {
+			report(onBytes, size)
+			return nil
+		}
+	}
+	if handled, err := kernelCopy(ctx, out, in, onBytes); handled {
+		return err
+	}
+	bp := copyBufPool.Get().(*[]byte)
+	defer copyBufPool.Put(bp)
+	// Hide ReadFrom so io.CopyBuffer uses the buffer and progress is reported per read.
+	_, err := io.CopyBuffer(struct{ io.Writer }{out}, &progressReader{ctx: ctx, r: in, onRead: onBytes}, *bp)
+	return err
+}
+
+// kernelCopy copies the rest of in with copy_file_range, or with sendfile where that is not
+// available. handled is false when neither works for this pair of files; nothing was copied then.
+func kernelCopy(ctx context.Context, out, in *os.File, onBytes func(n int64)) (handled bool, err error) {
+	rfd, wfd := int(in.Fd()), int(out.Fd())
+	useRange := true
+	copied := false
+	for {
+		if err := ctx.Err(); err != nil {
+			return true, err
+		}
+		var n int
+		if useRange {
+			n, err = unix.CopyFileRange(rfd, nil, wfd, nil, copyChunk, 0)
+		} else {
+			n, err = unix.Sendfile(wfd, rfd, nil, copyChunk)
+		}
+		if errors.Is(err, unix.EINTR) {
+			continue
+		}
+		if err != nil && !copied && kernelCopyUnsupported(err) {
+			if useRange {
+				useRange = false
+				continue
+			}
+			return false, nil
+		}
+		if err != nil {
+			return true, err
+		}
+		if n == 0 {
+			return true, nil
+		}
+		copied = true
+		report(onBytes, int64(n))
+	}
+}
+
+func kernelCopyUnsupported(err error) bool {
+	return errors.Is(err, unix.ENOSYS) || errors.Is(err, unix.EXDEV) || errors.Is(err, unix.EINVAL) ||
+		errors.Is(err, unix.EOPNOTSUPP) || errors.Is(err, unix.EPERM)
+}
+
+// copyXattrs copies the extended attributes of in to out. It is best-effort: filesystems
+// without xattrs and namespaces the process may not write (security.*, trusted.*) are skipped.
+func copyXattrs(out, in *os.File) {
+	sz, err := unix.Flistxattr(int(in.Fd()), nil)
+	if err != nil || sz <= 0 {
+		return
+	}
+	buf := make([]byte, sz)
+	sz, err = unix.Flistxattr(int(in.Fd()), buf)
+	if err != nil {
+		return
+	}
+	for _, name := range splitXattrNames(buf[:sz]) {
+		vsz, err := unix.Fgetxattr(int(in.Fd()), name, nil)
+		if err != nil {
+			continue
+		}
+		val := make([]byte, vsz)
+		if vsz, err = unix.Fgetxattr(int(in.Fd()), name, val); err != nil {
+			continue
+		}
+		_ = unix.Fsetxattr(int(out.Fd()), name, val[:vsz], 0)
+	}
+}
+
+func splitXattrNames(buf []byte) []string {
+	var names []string
+	start := 0
+	for i, b := range buf {
+		if b == 0 {
+			if i > start {
+				names = append(names, string(buf[start:i]))
+			}
+			start = i + 1
+		}
+	}
+	return names
+}
+
+func copyDirAttrs(src, dst string) {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return
+	}
+	_ = os.Chmod(dst, fi.Mode().Perm())
+	if in, err := os.Open(src); err == nil {
+		if out, err := os.Open(dst); err == nil {
+			copyXattrs(out, in)
+			_ = out.Close()
+		}
+		_ = in.Close()
+	}
+	_ = os.Chtimes(dst, statAtime(fi), fi.ModTime())
+}
+
+func statAtime(fi os.FileInfo) time.Time {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return time.Unix(st.Atim.Sec, st.Atim.Nsec)
+	}
+	return fi.ModTime()
+}
+
+func report(onBytes func(n int64), n int64) {
+	if onBytes != nil && n > 0 {
+		onBytes(n)
+	}
+}
+
+type progressReader struct {
+	ctx    context.Context
+	r      io.Reader
+	onRead func(n int64)
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	if err := pr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := pr.r.Read(p)
+	report(pr.onRead, int64(n))
+	return n, err
+}
@@ -0,0 +1,81 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCopyTreeKeepsContentModeAndMtime(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "src")
+	mtime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	files := map[string][]byte{
+		"a.txt":         []byte("hello"),
+		"sub/b.bin":     bytes.Repeat([]byte{7}, copyInlineSize+123),
+		"sub/deep/c.sh": []byte("#!/bin/sh\n"),
+		"sub/empty":     nil,
+	}
+	var total int64
+	for rel, data := range files {
+		p := filepath.Join(src, rel)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, data, 0o640); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(p, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+		total += int64(len(data))
+	}
+	_ = os.Chmod(filepath.Join(src, "sub/deep/c.sh"), 0o750)
+	_ = os.Chtimes(filepath.Join(src, "sub"), mtime, mtime)
+
+	dst := filepath.Join(t.TempDir(), "dst")
+	var copied, items atomic.Int64
+	if err := CopyTree(context.Background(), src, dst, func(n int64) { copied.Add(n) }, func() { items.Add(1) }); err != nil {
+		t.Fatal(err)
+	}
+	if copied.Load() != total || items.Load() != int64(len(files)) {
+		t.Fatalf("progress = %d bytes, %d items; want %d, %d", copied.Load(), items.Load(), total, len(files))
+	}
+	for rel, data := range files {
+		p := filepath.Join(dst, rel)
+		got, err := os.ReadFile(p)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Fatalf("%s: content differs (err %v)", rel, err)
+		}
+		fi, _ := os.Stat(p)
+		if !fi.ModTime().Equal(mtime) {
+			t.Fatalf("%s: mtime %v, want %v", rel, fi.ModTime(), mtime)
+		}
+	}
+	if fi, _ := os.Stat(filepath.Join(dst, "sub/deep/c.sh")); fi.Mode().Perm() != 0o750 {
+		t.Fatalf("mode %v, want 0750", fi.Mode().Perm())
+	}
+	if fi, _ := os.Stat(filepath.Join(dst, "sub")); !fi.ModTime().Equal(mtime) {
+		t.Fatalf("directory mtime %v, want %v", fi.ModTime(), mtime)
+	}
+}
+
+func TestCopyTreeStopsWhenCanceled(t *testing.T) {
+	src := t.TempDir()
+	if err := os.WriteFile(filepath.Join(src, "a.txt"), []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dst := filepath.Join(t.TempDir(), "dst")
+	if err := CopyTree(ctx, src, dst, nil, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "a.txt")); !os.IsNotExist(err) {
+		t.Fatalf("a.txt was copied after cancel (stat err %v)", err)
+	}
+}
@@ -0,0 +1,33 @@
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// FreeSpace returns the bytes available to writers on the filesystem that holds p.
+// p does not need to exist yet: the nearest existing ancestor is used, which is the
+// filesystem a later MkdirAll+Create at p would land on.
+func FreeSpace(p string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(nearestExisting(p), &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
+
+// nearestExisting returns p or its closest ancestor that exists.
+func nearestExisting(p string) string {
+	dir := filepath.Clean(p)
+	for {
+		if _, err := os.Lstat(dir); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return dir
+		}
+		dir = parent
+	}
+}
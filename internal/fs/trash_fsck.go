@@ -0,0 +1,72 @@
+package fs
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"ismartcoding/plainnas/internal/db"
+)
+
+func trashIndexKeys(it *TrashItem) []string {
+	dirFlag := trashDirFlag(it)
+	return []string{
+		trashDeletedAtIndexKey(it.DeletedAt, it.ID),
+		trashDeletedAtDirFirstIndexKey(it.DeletedAt, dirFlag, it.ID),
+		trashNameDirFirstIndexKey(dirFlag, trashDisplayNameLower(it), it.ID),
+		trashSizeDirFirstIndexKey(dirFlag, trashSortSize(it), it.ID),
+	}
+}
+
+// CheckTrash cross-checks the trash items with their listing indexes and with the trashed entries
+// on disk. Items are only checked against the disk while their disk's .nas-trash is present, so
+// the trash of an unplugged disk is kept.
+func CheckTrash(r *db.FsckReport) error {
+	const check = "trash"
+	peb := db.GetDefault()
+	items := map[string]*TrashItem{}
+
+	if err := peb.Iterate([]byte("trash:item:"), func(key []byte, value []byte) error {
+		r.Entry()
+		k := string(key)
+		var it TrashItem
+		if err := json.Unmarshal(value, &it); err != nil || it.ID == "" || k != trashItemKey(it.ID) {
+			r.Report(check, k, "corrupt item", func() error { return peb.Delete([]byte(k)) })
+			return nil
+		}
+		items[it.ID] = &it
+		if _, err := os.Stat(filepath.Join(it.Disk, ".nas-trash")); err == nil {
+			if _, err := os.Lstat(trashAbsPath(it.Disk, it.TrashRelPath)); os.IsNotExist(err) {
+				r.Report(check, k, "trashed entry missing on disk", func() error {
+					delete(items, it.ID)
+					return deleteTrashItemKeys(&it)
+				})
+				return nil
+			}
+		}
+		for _, ik := range trashIndexKeys(&it) {
+			if v, _ := peb.Get([]byte(ik)); v == nil {
+				// storeTrashItem rewrites all indexes of the item.
+				r.Report(check, ik, "missing index", func() error { return storeTrashItem(&it) })
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return peb.Iterate([]byte("trash:by_"), func(key []byte, value []byte) error {
+		r.Entry()
+		k := string(key)
+		if it := items[string(value)]; it != nil {
+			for _, ik := range trashIndexKeys(it) {
+				if k == ik {
+					return nil
+				}
+			}
+		}
+		r.Report(check, k, "dangling index", func() error { return peb.Delete([]byte(k)) })
+		return nil
+	})
+}
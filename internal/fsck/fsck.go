@@ -0,0 +1,45 @@
+// Package fsck checks that the catalog kept in Pebble agrees with itself and with the disks.
+package fsck
+
+import (
+	"sync"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/fs"
+	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/search"
+)
+
+var mu sync.Mutex
+
+// Run cross-checks media records, tag relations and counts, file metadata and trash items, and
+// reports orphans and dangling entries. With apply set the findings are repaired; otherwise
+// nothing is written. Data of unmounted volumes is kept as offline catalog.
+func Run(apply bool) (*db.FsckReport, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Disk checks only apply to mounted volumes.
+	ids := media.MountedVolumeIDs()
+	media.SetActiveVolumes(ids)
+	search.SetActiveVolumes(ids)
+
+	r := db.NewFsckReport(apply)
+	// Media first: tag relations are checked against the records that remain.
+	if err := media.CheckCatalog(r); err != nil {
+		return r, err
+	}
+	if err := db.CheckTagRelations(r, func(key string) bool {
+		m, _ := media.GetFile(key)
+		return m != nil
+	}); err != nil {
+		return r, err
+	}
+	if err := search.CheckCatalog(r); err != nil {
+		return r, err
+	}
+	if err := fs.CheckTrash(r); err != nil {
+		return r, err
+	}
+	return r, nil
+}
@@ -0,0 +1,20 @@
+package graph
+
+import (
+	"context"
+	"strings"
+
+	"ismartcoding/plainnas/internal/backup"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/graph/model"
+)
+
+func createBackup(ctx context.Context, path string, excludeCaches bool) (*model.BackupResult, error) {
+	res, err := backup.Create(strings.TrimSpace(path), excludeCaches)
+	if err != nil {
+		return nil, err
+	}
+	clientID, _ := ctx.Value(ContextKeyClientID).(string)
+	db.AddEvent("backup", res.Path, strings.TrimSpace(clientID))
+	return &model.BackupResult{Path: res.Path, Keys: res.Keys, Size: res.Size, SchemaVersion: res.SchemaVersion}, nil
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"ismartcoding/plainnas/internal/archive"
+	"ismartcoding/plainnas/internal/graph/model"
+)
+
+type archiveTaskSpec struct {
+	Dst    string
+	Format archive.Format
+	Level  int
+}
+
+func createArchiveTaskModel(ctx context.Context, paths []string, dst string, format model.ArchiveFormat, level int) (*model.FileTask, error) {
+	ft, err := createArchiveTask(ctx, paths, dst, archive.Format(format), level)
+	if err != nil {
+		return nil, err
+	}
+	return toModelFileTask(ft), nil
+}
+
+func cancelFileTaskModel(ctx context.Context, id string) (bool, error) {
+	clientID, err := getClientIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := getFileTaskManager().cancelTask(clientID, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// createArchiveTask queues an archive of paths to be written at dst.
+// If dst is an existing directory, the archive is created inside it and named after the
+// first source (or "archive" for multi-selections). The format extension is always enforced.
+func createArchiveTask(ctx context.Context, paths []string, dst string, format archive.Format, level int) (*fileTask, error) {
+	clientID, err := getClientIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !format.Valid() {
+		return nil, fmt.Errorf("unsupported archive format")
+	}
+	dst = filepath.Clean(strings.TrimSpace(dst))
+	if dst == "" || dst == "." || dst == string(os.PathSeparator) {
+		return nil, fmt.Errorf("invalid destination")
+	}
+
+	ops := make([]fileTaskOp, 0, len(paths))
+	seen := map[string]struct{}{}
+	for _, raw := range paths {
+		p := filepath.Clean(strings.TrimSpace(raw))
+		if p == "" || p == "." {
+			continue
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		if _, err := os.Lstat(p); err != nil {
+			return nil, err
+		}
+		seen[p] = struct{}{}
+		ops = append(ops, fileTaskOp{Src: p, Dst: dst})
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("no operations")
+	}
+
+	if fi, err := os.Stat(dst); err == nil && fi.IsDir() {
+		name := "archive"
+		if len(ops) == 1 {
+			name = filepath.Base(ops[0].Src)
+		}
+		dst = filepath.Join(dst, name)
+	}
+	dst = archive.WithExt(dst, format)
+	for i := range ops {
+		ops[i].Dst = dst
+	}
+
+	mgr := getFileTaskManager()
+	t := mgr.newTask(clientID, fileTaskTypeArchive, "Create "+filepath.Base(dst), ops)
+	t.Archive = &archiveTaskSpec{Dst: dst, Format: format, Level: level}
+	return mgr.enqueue(t), nil
+}
+
+// runArchive writes the archive to a hidden temporary file next to the destination and
+// renames it into place once complete, so a partial archive is never visible under the
+// final name. The temporary file is removed on error or cancellation.
+func (m *fileTaskManager) runArchive(t *fileTask, progress *fileOpProgress) error {
+	spec := t.Archive
+	if spec == nil {
+		return fmt.Errorf("invalid archive task")
+	}
+	if err := os.MkdirAll(filepath.Dir(spec.Dst), 0o755); err != nil {
+		return err
+	}
+
+	tmpPath := filepath.Join(filepath.Dir(spec.Dst), "."+filepath.Base(spec.Dst)+"."+t.ID+".part")
+	out, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = out.Close()
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	items := make([]archive.Item, 0, len(t.Ops))
+	for _, op := range t.Ops {
+		items = append(items, archive.Item{Path: op.Src})
+	}
+	opts := archive.Options{
+		Level: spec.Level,
+		// Never archive the output into itself when it lives inside a selected folder.
+		Exclude: []string{tmpPath},
+		OnBytes: func(n int64) { safeAddBytes(progress, n) },
+		OnItem:  func() { safeAddItem(progress) },
+	}
+	if err := archive.Write(t.ctx, out, spec.Format, items, opts); err != nil {
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	finalPath, err := makeUniqueArchivePath(spec.Dst, spec.Format)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, finalPath); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
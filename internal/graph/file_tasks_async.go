@@ -2,6 +2,7 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -19,13 +20,15 @@ type fileTaskType string
 type fileTaskStatus string
 
 const (
-	fileTaskTypeCopy fileTaskType = "COPY"
-	fileTaskTypeMove fileTaskType = "MOVE"
-
-	fileTaskStatusQueued  fileTaskStatus = "QUEUED"
-	fileTaskStatusRunning fileTaskStatus = "RUNNING"
-	fileTaskStatusDone    fileTaskStatus = "DONE"
-	fileTaskStatusError   fileTaskStatus = "ERROR"
+	fileTaskTypeCopy    fileTaskType = "COPY"
+	fileTaskTypeMove    fileTaskType = "MOVE"
+	fileTaskTypeArchive fileTaskType = "ARCHIVE"
+
+	fileTaskStatusQueued   fileTaskStatus = "QUEUED"
+	fileTaskStatusRunning  fileTaskStatus = "RUNNING"
+	fileTaskStatusDone     fileTaskStatus = "DONE"
+	fileTaskStatusError    fileTaskStatus = "ERROR"
+	fileTaskStatusCanceled fileTaskStatus = "CANCELED"
 )
 
 type fileTaskOp struct {
@@ -51,7 +54,11 @@ type fileTask struct {
 	UpdatedAt   time.Time
 	lastPersist time.Time
 
-	Ops []fileTaskOp
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	Ops     []fileTaskOp
+	Archive *archiveTaskSpec
 }
 
 type fileTaskManager struct {
@@ -78,8 +85,13 @@ func getFileTaskManager() *fileTaskManager {
 }
 
 func (m *fileTaskManager) create(clientID string, typ fileTaskType, title string, ops []fileTaskOp) *fileTask {
+	return m.enqueue(m.newTask(clientID, typ, title, ops))
+}
+
+func (m *fileTaskManager) newTask(clientID string, typ fileTaskType, title string, ops []fileTaskOp) *fileTask {
 	now := time.Now().UTC()
-	t := &fileTask{
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fileTask{
 		ID:        shortid.New(),
 		ClientID:  clientID,
 		Type:      typ,
@@ -87,9 +99,13 @@ func (m *fileTaskManager) create(clientID string, typ fileTaskType, title string
 		Status:    fileTaskStatusQueued,
 		CreatedAt: now,
 		UpdatedAt: now,
+		ctx:       ctx,
+		cancel:    cancel,
 		Ops:       ops,
 	}
+}
 
+func (m *fileTaskManager) enqueue(t *fileTask) *fileTask {
 	m.mu.Lock()
 	m.tasks[t.ID] = t
 	m.mu.Unlock()
@@ -134,7 +150,7 @@ func (m *fileTaskManager) publishSnapshot(t *fileTask) {
 		CreatedAt:  t.CreatedAt,
 		UpdatedAt:  t.UpdatedAt,
 	}
-	forcePersist := t.Status == fileTaskStatusDone || t.Status == fileTaskStatusError
+	forcePersist := t.Status == fileTaskStatusDone || t.Status == fileTaskStatusError || t.Status == fileTaskStatusCanceled
 	now := time.Now().UTC()
 	shouldPersist := persistThrottle(now, &t.lastPersist, forcePersist)
 	t.mu.Unlock()
@@ -155,8 +171,47 @@ func (m *fileTaskManager) worker() {
 	}
 }
 
+// cancelTask requests cancellation of a task owned by clientID.
+// Queued tasks are finalized immediately; running tasks stop at the next checkpoint.
+func (m *fileTaskManager) cancelTask(clientID string, id string) error {
+	t := m.get(id)
+	if t == nil {
+		return fmt.Errorf("task not found")
+	}
+	t.mu.Lock()
+	if t.ClientID != clientID {
+		t.mu.Unlock()
+		return fmt.Errorf("task not found")
+	}
+	switch t.Status {
+	case fileTaskStatusDone, fileTaskStatusError, fileTaskStatusCanceled:
+		t.mu.Unlock()
+		return nil
+	}
+	t.cancel()
+	// Finalize a queued task under the same lock run uses to claim it, so it never starts.
+	queued := t.Status == fileTaskStatusQueued
+	if queued {
+		t.Status = fileTaskStatusCanceled
+		t.Error = ""
+		t.UpdatedAt = time.Now().UTC()
+	}
+	t.mu.Unlock()
+	if queued {
+		m.publishSnapshot(t)
+	}
+	return nil
+}
+
 func (m *fileTaskManager) run(t *fileTask) {
+	defer t.cancel()
+
+	// Claim the task; one canceled while queued was already finalized by cancelTask.
 	t.mu.Lock()
+	if t.Status != fileTaskStatusQueued {
+		t.mu.Unlock()
+		return
+	}
 	t.Status = fileTaskStatusRunning
 	t.Error = ""
 	t.UpdatedAt = time.Now().UTC()
@@ -214,23 +269,40 @@ func (m *fileTaskManager) run(t *fileTask) {
 		},
 	}
 
+	if t.Type == fileTaskTypeArchive {
+		if err := m.runArchive(t, progress); err != nil {
+			m.failOrCancel(t, err)
+			return
+		}
+		m.finishDone(t)
+		return
+	}
+
 	for _, op := range t.Ops {
+		if t.ctx.Err() != nil {
+			m.finishCanceled(t)
+			return
+		}
 		var err error
 		switch t.Type {
 		case fileTaskTypeCopy:
-			_, err = copyFileOpWithProgress(op.Src, op.Dst, op.Overwrite, progress)
+			_, err = copyFileOpWithProgress(t.ctx, op.Src, op.Dst, op.Overwrite, progress)
 		case fileTaskTypeMove:
-			_, err = moveFileOpWithProgress(op.Src, op.Dst, op.Overwrite, progress)
+			_, err = moveFileOpWithProgress(t.ctx, op.Src, op.Dst, op.Overwrite, progress)
 		default:
 			err = fmt.Errorf("unknown task type")
 		}
 		if err != nil {
-			m.fail(t, err)
+			m.failOrCancel(t, err)
 			return
 		}
 		emit(true)
 	}
 
+	m.finishDone(t)
+}
+
+func (m *fileTaskManager) finishDone(t *fileTask) {
 	t.mu.Lock()
 	t.Status = fileTaskStatusDone
 	t.UpdatedAt = time.Now().UTC()
@@ -238,6 +310,24 @@ func (m *fileTaskManager) run(t *fileTask) {
 	m.publishSnapshot(t)
 }
 
+func (m *fileTaskManager) finishCanceled(t *fileTask) {
+	t.mu.Lock()
+	t.Status = fileTaskStatusCanceled
+	t.Error = ""
+	t.UpdatedAt = time.Now().UTC()
+	t.mu.Unlock()
+	m.publishSnapshot(t)
+}
+
+// failOrCancel reports err, unless it is the consequence of a cancellation request.
+func (m *fileTaskManager) failOrCancel(t *fileTask, err error) {
+	if t.ctx.Err() != nil && errors.Is(err, context.Canceled) {
+		m.finishCanceled(t)
+		return
+	}
+	m.fail(t, err)
+}
+
 func (m *fileTaskManager) fail(t *fileTask, err error) {
 	t.mu.Lock()
 	t.Status = fileTaskStatusError
@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"ismartcoding/plainnas/internal/archive"
+	"ismartcoding/plainnas/internal/graph/model"
+)
+
+// archiveEntries lists the direct children of prefix inside the archive at path.
+// Entries are returned as virtual File rows whose path is "<archive>!/<entry>";
+// such paths can be passed to /fs to stream the entry (or a thumbnail of it).
+func archiveEntries(path string, prefix string) ([]*model.File, error) {
+	p := filepath.Clean(strings.TrimSpace(path))
+	if p == "" || p == "." {
+		return nil, fmt.Errorf("invalid path")
+	}
+	ix, err := archive.OpenIndex(p)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix = archive.CleanEntryName(prefix)
+	if prefix != "" {
+		e, ok := ix.Lookup(prefix)
+		if !ok || !e.IsDir {
+			return nil, fmt.Errorf("directory not found in archive")
+		}
+	}
+
+	entries := ix.List(prefix)
+	out := make([]*model.File, 0, len(entries))
+	for _, e := range entries {
+		childCount := 0
+		if e.IsDir {
+			childCount = ix.ChildCount(e.Name)
+		}
+		out = append(out, &model.File{
+			Path:       filepath.ToSlash(archive.JoinVirtual(p, e.Name)),
+			IsDir:      e.IsDir,
+			CreatedAt:  e.ModTime,
+			UpdatedAt:  e.ModTime,
+			Size:       e.Size,
+			ChildCount: childCount,
+			Online:     true,
+		})
+	}
+	return out, nil
+}
@@ -1,11 +1,13 @@
 package graph
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"ismartcoding/plainnas/internal/fs"
 	"ismartcoding/plainnas/internal/media"
 )
 
@@ -27,10 +29,12 @@ func safeAddItem(p *fileOpProgress) {
 }
 
 func copyFileOp(src string, dst string, overwrite bool) (bool, error) {
-	return copyFileOpWithProgress(src, dst, overwrite, nil)
+	return copyFileOpWithProgress(context.Background(), src, dst, overwrite, nil)
 }
 
-func copyFileOpWithProgress(src string, dst string, overwrite bool, progress *fileOpProgress) (bool, error) {
+// copyFileOpWithProgress copies src to dst. Canceling ctx stops the copy between files and
+// chunks with an error wrapping ctx.Err(); files copied by then are kept.
+func copyFileOpWithProgress(ctx context.Context, src string, dst string, overwrite bool, progress *fileOpProgress) (bool, error) {
 	src = filepath.Clean(src)
 	dst = filepath.Clean(dst)
 	if strings.TrimSpace(src) == "" || strings.TrimSpace(dst) == "" {
@@ -75,29 +79,16 @@ func copyFileOpWithProgress(src string, dst string, overwrite bool, progress *fi
 	}
 
 	if sfi.IsDir() {
-		// recursive copy
-		err = filepath.WalkDir(src, func(p string, d os.DirEntry, e error) error {
-			if e != nil {
-				return e
-			}
-			rel, _ := filepath.Rel(src, p)
-			target := filepath.Join(resolvedDst, rel)
-			if d.IsDir() {
-				return os.MkdirAll(target, 0o755)
-			}
-			if err := copyFileContentsWithProgress(p, target, func(n int64) {
-				safeAddBytes(progress, n)
-			}); err != nil {
-				return err
-			}
+		err = fs.CopyTree(ctx, src, resolvedDst, func(n int64) {
+			safeAddBytes(progress, n)
+		}, func() {
 			safeAddItem(progress)
-			return nil
 		})
 		if err != nil {
 			return false, err
 		}
 	} else {
-		if err := copyFileContentsWithProgress(src, resolvedDst, func(n int64) {
+		if err := copyFileContentsWithProgress(ctx, src, resolvedDst, func(n int64) {
 			safeAddBytes(progress, n)
 		}); err != nil {
 			return false, err
@@ -110,10 +101,12 @@ func copyFileOpWithProgress(src string, dst string, overwrite bool, progress *fi
 }
 
 func moveFileOp(src string, dst string, overwrite bool) (bool, error) {
-	return moveFileOpWithProgress(src, dst, overwrite, nil)
+	return moveFileOpWithProgress(context.Background(), src, dst, overwrite, nil)
 }
 
-func moveFileOpWithProgress(src string, dst string, overwrite bool, progress *fileOpProgress) (bool, error) {
+// moveFileOpWithProgress moves src to dst. A move across filesystems copies first and honors ctx
+// like copyFileOpWithProgress; src is only removed once the copy completed.
+func moveFileOpWithProgress(ctx context.Context, src string, dst string, overwrite bool, progress *fileOpProgress) (bool, error) {
 	src = filepath.Clean(src)
 	dst = filepath.Clean(dst)
 	if strings.TrimSpace(src) == "" || strings.TrimSpace(dst) == "" {
@@ -153,13 +146,19 @@ func moveFileOpWithProgress(src string, dst string, overwrite bool, progress *fi
 		// fallback cross-fs: copy then remove
 		if sfi.IsDir() {
 			// directories cannot be copied with copyFileContents; reuse copyFileOpWithProgress
-			if _, err2 := copyFileOpWithProgress(src, dst, overwrite, progress); err2 != nil {
+			if _, err2 := copyFileOpWithProgress(ctx, src, dst, overwrite, progress); err2 != nil {
+				if ctx.Err() != nil {
+					return false, err2
+				}
 				return false, err
 			}
 		} else {
-			if err2 := copyFileContentsWithProgress(src, dst, func(n int64) {
+			if err2 := copyFileContentsWithProgress(ctx, src, dst, func(n int64) {
 				safeAddBytes(progress, n)
 			}); err2 != nil {
+				if ctx.Err() != nil {
+					return false, err2
+				}
 				return false, err
 			}
 			safeAddItem(progress)
@@ -173,7 +172,9 @@ func moveFileOpWithProgress(src string, dst string, overwrite bool, progress *fi
 		safeAddItem(progress)
 	}
 
-	_ = media.RemovePath(src)
+	// Index dst while the source record still exists, so a move to another disk is recognized
+	// by fingerprint and keeps its tags.
 	_ = media.ScanFile(dst)
+	_ = media.RemovePath(src)
 	return true, nil
 }
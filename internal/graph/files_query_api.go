@@ -19,9 +19,14 @@ func files(offset int, limit int, query string, sortBy model.FileSortBy) ([]*mod
 
 	base := helpers.BuildBaseDir(q.RootPath, q.RelativePath)
 
-	// Use index search if we have text search OR file size filter
-	if q.Text != "" || (q.FileSizeOp != "" && q.FileSizeVal > 0) {
-		items, err := helpers.SearchIndexFiles(q.Text, base, offset, limit, q.ShowHidden, q.FileSizeOp, q.FileSizeVal)
+	// Full-text search over extracted document content
+	if q.Content != "" {
+		return helpers.SearchContentFiles(q.Content, q.Text, base, offset, limit, q.FileSizeOp, q.FileSizeVal, q.Filters)
+	}
+
+	// Use index search if we have text search, file size or ext/type/mtime filters
+	if q.HasFilters() {
+		items, err := helpers.SearchIndexFiles(q.Text, base, offset, limit, q.ShowHidden, q.FileSizeOp, q.FileSizeVal, q.Filters)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,36 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/fsck"
+	"ismartcoding/plainnas/internal/graph/model"
+)
+
+func runFsck(ctx context.Context, apply bool) (*model.FsckReport, error) {
+	r, err := fsck.Run(apply)
+	if err != nil {
+		return nil, err
+	}
+	if apply && r.IssueCount() > 0 {
+		clientID, _ := ctx.Value(ContextKeyClientID).(string)
+		db.AddEvent("fsck", fmt.Sprintf("repaired %d of %d issues", r.RepairedCount(), r.IssueCount()), strings.TrimSpace(clientID))
+	}
+	out := &model.FsckReport{
+		Apply:     r.Apply,
+		Entries:   r.Entries,
+		Issues:    make([]*model.FsckIssue, 0, len(r.Issues)),
+		Counts:    make([]*model.FsckCount, 0, len(r.Counts)),
+		Truncated: r.Truncated,
+	}
+	for _, it := range r.Issues {
+		out.Issues = append(out.Issues, &model.FsckIssue{Check: it.Check, Key: it.Key, Problem: it.Problem, Repaired: it.Repaired})
+	}
+	for _, c := range r.Counts {
+		out.Counts = append(out.Counts, &model.FsckCount{Check: c.Check, Problem: c.Problem, Count: c.Count, Repaired: c.Repaired})
+	}
+	return out, nil
+}
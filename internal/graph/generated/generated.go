@@ -67,17 +67,27 @@ type ComplexityRoot struct {
 	}
 
 	Audio struct {
+		Album       func(childComplexity int) int
+		AlbumArtist func(childComplexity int) int
 		AlbumFileID func(childComplexity int) int
 		Artist      func(childComplexity int) int
 		BucketID    func(childComplexity int) int
+		Composer    func(childComplexity int) int
 		CreatedAt   func(childComplexity int) int
+		Cursor      func(childComplexity int) int
+		Disc        func(childComplexity int) int
 		Duration    func(childComplexity int) int
+		Genre       func(childComplexity int) int
 		ID          func(childComplexity int) int
+		Online      func(childComplexity int) int
 		Path        func(childComplexity int) int
 		Size        func(childComplexity int) int
 		Tags        func(childComplexity int) int
 		Title       func(childComplexity int) int
+		Track       func(childComplexity int) int
 		UpdatedAt   func(childComplexity int) int
+		Volume      func(childComplexity int) int
+		Year        func(childComplexity int) int
 	}
 
 	AudioFileInfo struct {
@@ -85,6 +95,13 @@ type ComplexityRoot struct {
 		Location func(childComplexity int) int
 	}
 
+	BackupResult struct {
+		Keys          func(childComplexity int) int
+		Path          func(childComplexity int) int
+		SchemaVersion func(childComplexity int) int
+		Size          func(childComplexity int) int
+	}
+
 	DeviceInfo struct {
 		AppFullVersion   func(childComplexity int) int
 		AppVersion       func(childComplexity int) int
@@ -133,12 +150,17 @@ type ComplexityRoot struct {
 	}
 
 	File struct {
-		ChildCount func(childComplexity int) int
-		CreatedAt  func(childComplexity int) int
-		IsDir      func(childComplexity int) int
-		Path       func(childComplexity int) int
-		Size       func(childComplexity int) int
-		UpdatedAt  func(childComplexity int) int
+		ChildCount     func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		IsDir          func(childComplexity int) int
+		NameMatches    func(childComplexity int) int
+		Online         func(childComplexity int) int
+		Path           func(childComplexity int) int
+		Size           func(childComplexity int) int
+		Snippet        func(childComplexity int) int
+		SnippetMatches func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+		Volume         func(childComplexity int) int
 	}
 
 	FileInfo struct {
@@ -163,6 +185,28 @@ type ComplexityRoot struct {
 		UpdatedAt  func(childComplexity int) int
 	}
 
+	FsckCount struct {
+		Check    func(childComplexity int) int
+		Count    func(childComplexity int) int
+		Problem  func(childComplexity int) int
+		Repaired func(childComplexity int) int
+	}
+
+	FsckIssue struct {
+		Check    func(childComplexity int) int
+		Key      func(childComplexity int) int
+		Problem  func(childComplexity int) int
+		Repaired func(childComplexity int) int
+	}
+
+	FsckReport struct {
+		Apply     func(childComplexity int) int
+		Counts    func(childComplexity int) int
+		Entries   func(childComplexity int) int
+		Issues    func(childComplexity int) int
+		Truncated func(childComplexity int) int
+	}
+
 	GeoLocation struct {
 		Latitude  func(childComplexity int) int
 		Longitude func(childComplexity int) int
@@ -171,12 +215,15 @@ type ComplexityRoot struct {
 	Image struct {
 		BucketID  func(childComplexity int) int
 		CreatedAt func(childComplexity int) int
+		Cursor    func(childComplexity int) int
 		ID        func(childComplexity int) int
+		Online    func(childComplexity int) int
 		Path      func(childComplexity int) int
 		Size      func(childComplexity int) int
 		Tags      func(childComplexity int) int
 		Title     func(childComplexity int) int
 		UpdatedAt func(childComplexity int) int
+		Volume    func(childComplexity int) int
 	}
 
 	ImageFileInfo struct {
@@ -197,12 +244,29 @@ type ComplexityRoot struct {
 		TopItems  func(childComplexity int) int
 	}
 
+	MediaScanJob struct {
+		CreatedAt   func(childComplexity int) int
+		ErrorCount  func(childComplexity int) int
+		Errors      func(childComplexity int) int
+		FinishedAt  func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Incremental func(childComplexity int) int
+		Indexed     func(childComplexity int) int
+		Root        func(childComplexity int) int
+		StartedAt   func(childComplexity int) int
+		State       func(childComplexity int) int
+		Total       func(childComplexity int) int
+	}
+
 	Mutation struct {
 		AddFavoriteFolder      func(childComplexity int, rootPath string, relativePath string) int
 		AddPlaylistAudios      func(childComplexity int, query string) int
 		AddToTags              func(childComplexity int, typeArg model.DataType, tagIds []string, query string) int
+		Backup                 func(childComplexity int, path string, excludeCaches bool) int
+		CancelFileTask         func(childComplexity int, id string) int
 		ClearAudioPlaylist     func(childComplexity int) int
 		CopyFile               func(childComplexity int, src string, dst string, overwrite bool) int
+		CreateArchive          func(childComplexity int, paths []string, dst string, format model.ArchiveFormat, level int) int
 		CreateCopyTask         func(childComplexity int, ops []*model.FileTaskOpInput) int
 		CreateDir              func(childComplexity int, path string) int
 		CreateMoveTask         func(childComplexity int, ops []*model.FileTaskOpInput) int
@@ -214,10 +278,12 @@ type ComplexityRoot struct {
 		DeleteTag              func(childComplexity int, id string) int
 		DlnaCast               func(childComplexity int, rendererUdn string, url string, title string, mime string, typeArg model.DataType) int
 		FormatDisk             func(childComplexity int, path string) int
+		Fsck                   func(childComplexity int, apply bool) int
 		Logout                 func(childComplexity int) int
-		MergeChunks            func(childComplexity int, fileID string, totalChunks int, path string, replace bool) int
+		MergeChunks            func(childComplexity int, fileID string, totalChunks int, path string, replace bool, batchID string, batchTotal int, batchDir string) int
 		MoveFile               func(childComplexity int, src string, dst string, overwrite bool) int
 		PauseMediaScan         func(childComplexity int) int
+		PauseMediaScanJob      func(childComplexity int, id string) int
 		PlayAudio              func(childComplexity int, path string) int
 		RebuildMediaIndex      func(childComplexity int, root string) int
 		RemoveFavoriteFolder   func(childComplexity int, rootPath string, relativePath string) int
@@ -227,22 +293,26 @@ type ComplexityRoot struct {
 		RestoreFiles           func(childComplexity int, paths []string) int
 		RestoreMediaItems      func(childComplexity int, typeArg model.DataType, query string) int
 		ResumeMediaScan        func(childComplexity int) int
+		ResumeMediaScanJob     func(childComplexity int, id string) int
 		RevokeSession          func(childComplexity int, clientID string) int
 		SetDeviceName          func(childComplexity int, name string) int
 		SetFavoriteFolderAlias func(childComplexity int, rootPath string, relativePath string, alias string) int
+		SetIgnorePatterns      func(childComplexity int, patterns []string) int
 		SetKeyValue            func(childComplexity int, key string, value string) int
 		SetMediaSourceDirs     func(childComplexity int, dirs []string) int
 		SetMountAlias          func(childComplexity int, id string, alias string) int
 		SetSambaSettings       func(childComplexity int, input model.SambaSettingsInput) int
 		SetSambaUserPassword   func(childComplexity int, password string) int
 		SetTempValue           func(childComplexity int, key string, value string) int
-		StartMediaScan         func(childComplexity int, root string) int
+		StartMediaScan         func(childComplexity int, root string, incremental bool) int
 		StopMediaScan          func(childComplexity int) int
+		StopMediaScanJob       func(childComplexity int, id string) int
 		TrashFiles             func(childComplexity int, paths []string) int
 		TrashMediaItems        func(childComplexity int, typeArg model.DataType, query string) int
 		UpdateAudioPlayMode    func(childComplexity int, mode model.MediaPlayMode) int
 		UpdateTag              func(childComplexity int, id string, name string) int
 		UpdateTagRelations     func(childComplexity int, typeArg model.DataType, item model.TagRelationStub, addTagIds []string, removeTagIds []string) int
+		UploadByHash           func(childComplexity int, hash string, size int64, path string, replace bool) int
 		WriteTextFile          func(childComplexity int, path string, content string, overwrite bool) int
 	}
 
@@ -271,35 +341,39 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		App              func(childComplexity int) int
-		AppUpdate        func(childComplexity int) int
-		AudioCount       func(childComplexity int, query string) int
-		Audios           func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy) int
-		DeviceInfo       func(childComplexity int) int
-		Disks            func(childComplexity int) int
-		DlnaRenderers    func(childComplexity int) int
-		Events           func(childComplexity int, limit int) int
-		FavoriteFolders  func(childComplexity int) int
-		FileInfo         func(childComplexity int, id string, path string, includeDirSize *bool) int
-		Files            func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy) int
-		FilesCount       func(childComplexity int, query string) int
-		GetTasks         func(childComplexity int) int
-		ImageCount       func(childComplexity int, query string) int
-		Images           func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy) int
-		MediaBuckets     func(childComplexity int, typeArg model.DataType) int
-		MediaSourceDirs  func(childComplexity int) int
-		Mounts           func(childComplexity int) int
-		PathStat         func(childComplexity int, path string) int
-		PathStats        func(childComplexity int, paths []string) int
-		RecentFiles      func(childComplexity int) int
-		RecentFilesCount func(childComplexity int) int
-		SambaSettings    func(childComplexity int) int
-		Sessions         func(childComplexity int) int
-		Tags             func(childComplexity int, typeArg model.DataType) int
-		TrashCount       func(childComplexity int) int
-		UploadedChunks   func(childComplexity int, fileID string) int
-		VideoCount       func(childComplexity int, query string) int
-		Videos           func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy) int
+		App               func(childComplexity int) int
+		AppUpdate         func(childComplexity int) int
+		ArchiveEntries    func(childComplexity int, path string, prefix string) int
+		AudioCount        func(childComplexity int, query string) int
+		Audios            func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) int
+		CheckUploadHashes func(childComplexity int, hashes []string, dir string) int
+		DeviceInfo        func(childComplexity int) int
+		Disks             func(childComplexity int) int
+		DlnaRenderers     func(childComplexity int) int
+		Events            func(childComplexity int, limit int) int
+		FavoriteFolders   func(childComplexity int) int
+		FileInfo          func(childComplexity int, id string, path string, includeDirSize *bool) int
+		Files             func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy) int
+		FilesCount        func(childComplexity int, query string) int
+		GetTasks          func(childComplexity int) int
+		IgnorePatterns    func(childComplexity int) int
+		ImageCount        func(childComplexity int, query string) int
+		Images            func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) int
+		MediaBuckets      func(childComplexity int, typeArg model.DataType) int
+		MediaScanJobs     func(childComplexity int) int
+		MediaSourceDirs   func(childComplexity int) int
+		Mounts            func(childComplexity int) int
+		PathStat          func(childComplexity int, path string) int
+		PathStats         func(childComplexity int, paths []string) int
+		RecentFiles       func(childComplexity int) int
+		RecentFilesCount  func(childComplexity int) int
+		SambaSettings     func(childComplexity int) int
+		Sessions          func(childComplexity int) int
+		Tags              func(childComplexity int, typeArg model.DataType) int
+		TrashCount        func(childComplexity int) int
+		UploadedChunks    func(childComplexity int, fileID string) int
+		VideoCount        func(childComplexity int, query string) int
+		Videos            func(childComplexity int, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) int
 	}
 
 	SambaSettings struct {
@@ -351,6 +425,7 @@ type ComplexityRoot struct {
 		FsType       func(childComplexity int) int
 		ID           func(childComplexity int) int
 		Label        func(childComplexity int) int
+		LastSeen     func(childComplexity int) int
 		MountPoint   func(childComplexity int) int
 		Name         func(childComplexity int) int
 		PartitionNum func(childComplexity int) int
@@ -373,16 +448,24 @@ type ComplexityRoot struct {
 		Value func(childComplexity int) int
 	}
 
+	TextRange struct {
+		Length func(childComplexity int) int
+		Start  func(childComplexity int) int
+	}
+
 	Video struct {
 		BucketID  func(childComplexity int) int
 		CreatedAt func(childComplexity int) int
+		Cursor    func(childComplexity int) int
 		Duration  func(childComplexity int) int
 		ID        func(childComplexity int) int
+		Online    func(childComplexity int) int
 		Path      func(childComplexity int) int
 		Size      func(childComplexity int) int
 		Tags      func(childComplexity int) int
 		Title     func(childComplexity int) int
 		UpdatedAt func(childComplexity int) int
+		Volume    func(childComplexity int) int
 	}
 
 	VideoFileInfo struct {
@@ -391,6 +474,12 @@ type ComplexityRoot struct {
 		Location func(childComplexity int) int
 		Width    func(childComplexity int) int
 	}
+
+	VolumeRef struct {
+		ID   func(childComplexity int) int
+		Name func(childComplexity int) int
+		UUID func(childComplexity int) int
+	}
 }
 
 type MutationResolver interface {
@@ -400,6 +489,7 @@ type MutationResolver interface {
 	Logout(ctx context.Context) (bool, error)
 	RevokeSession(ctx context.Context, clientID string) (bool, error)
 	SetMediaSourceDirs(ctx context.Context, dirs []string) (bool, error)
+	SetIgnorePatterns(ctx context.Context, patterns []string) (bool, error)
 	SetSambaSettings(ctx context.Context, input model.SambaSettingsInput) (bool, error)
 	SetSambaUserPassword(ctx context.Context, password string) (bool, error)
 	AddFavoriteFolder(ctx context.Context, rootPath string, relativePath string) (*model.FavoriteFolder, error)
@@ -412,15 +502,21 @@ type MutationResolver interface {
 	MoveFile(ctx context.Context, src string, dst string, overwrite bool) (bool, error)
 	CreateCopyTask(ctx context.Context, ops []*model.FileTaskOpInput) (*model.FileTask, error)
 	CreateMoveTask(ctx context.Context, ops []*model.FileTaskOpInput) (*model.FileTask, error)
+	CreateArchive(ctx context.Context, paths []string, dst string, format model.ArchiveFormat, level int) (*model.FileTask, error)
+	CancelFileTask(ctx context.Context, id string) (bool, error)
 	DeleteFiles(ctx context.Context, paths []string) (bool, error)
 	TrashFiles(ctx context.Context, paths []string) (bool, error)
 	RestoreFiles(ctx context.Context, paths []string) (bool, error)
 	SetTempValue(ctx context.Context, key string, value string) (*model.TempValue, error)
-	MergeChunks(ctx context.Context, fileID string, totalChunks int, path string, replace bool) (string, error)
-	StartMediaScan(ctx context.Context, root string) (bool, error)
+	MergeChunks(ctx context.Context, fileID string, totalChunks int, path string, replace bool, batchID string, batchTotal int, batchDir string) (string, error)
+	UploadByHash(ctx context.Context, hash string, size int64, path string, replace bool) (string, error)
+	StartMediaScan(ctx context.Context, root string, incremental bool) (bool, error)
 	PauseMediaScan(ctx context.Context) (bool, error)
 	ResumeMediaScan(ctx context.Context) (bool, error)
 	StopMediaScan(ctx context.Context) (bool, error)
+	PauseMediaScanJob(ctx context.Context, id string) (bool, error)
+	ResumeMediaScanJob(ctx context.Context, id string) (bool, error)
+	StopMediaScanJob(ctx context.Context, id string) (bool, error)
 	RebuildMediaIndex(ctx context.Context, root string) (bool, error)
 	AddPlaylistAudios(ctx context.Context, query string) (bool, error)
 	ReorderPlaylistAudios(ctx context.Context, paths []string) (bool, error)
@@ -439,6 +535,8 @@ type MutationResolver interface {
 	RemoveFromTags(ctx context.Context, typeArg model.DataType, tagIds []string, query string) (bool, error)
 	SetMountAlias(ctx context.Context, id string, alias string) (bool, error)
 	FormatDisk(ctx context.Context, path string) (bool, error)
+	Fsck(ctx context.Context, apply bool) (*model.FsckReport, error)
+	Backup(ctx context.Context, path string, excludeCaches bool) (*model.BackupResult, error)
 	DlnaCast(ctx context.Context, rendererUdn string, url string, title string, mime string, typeArg model.DataType) (bool, error)
 }
 type QueryResolver interface {
@@ -450,14 +548,16 @@ type QueryResolver interface {
 	Mounts(ctx context.Context) ([]*model.StorageMount, error)
 	Disks(ctx context.Context) ([]*model.StorageDisk, error)
 	MediaSourceDirs(ctx context.Context) ([]string, error)
+	IgnorePatterns(ctx context.Context) ([]string, error)
+	MediaScanJobs(ctx context.Context) ([]*model.MediaScanJob, error)
 	SambaSettings(ctx context.Context) (*model.SambaSettings, error)
-	Images(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Image, error)
+	Images(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) ([]*model.Image, error)
 	ImageCount(ctx context.Context, query string) (int, error)
 	RecentFilesCount(ctx context.Context) (int, error)
 	Tags(ctx context.Context, typeArg model.DataType) ([]*model.Tag, error)
 	MediaBuckets(ctx context.Context, typeArg model.DataType) ([]*model.MediaBucket, error)
-	Videos(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Video, error)
-	Audios(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Audio, error)
+	Videos(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) ([]*model.Video, error)
+	Audios(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) ([]*model.Audio, error)
 	AudioCount(ctx context.Context, query string) (int, error)
 	VideoCount(ctx context.Context, query string) (int, error)
 	DeviceInfo(ctx context.Context) (*model.DeviceInfo, error)
@@ -468,8 +568,10 @@ type QueryResolver interface {
 	Files(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.File, error)
 	FilesCount(ctx context.Context, query string) (int, error)
 	RecentFiles(ctx context.Context) ([]*model.File, error)
+	ArchiveEntries(ctx context.Context, path string, prefix string) ([]*model.File, error)
 	TrashCount(ctx context.Context) (int, error)
 	UploadedChunks(ctx context.Context, fileID string) ([]int, error)
+	CheckUploadHashes(ctx context.Context, hashes []string, dir string) ([]string, error)
 	DlnaRenderers(ctx context.Context) ([]*model.DlnaRenderer, error)
 }
 
@@ -583,6 +685,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AppUpdate.URL(childComplexity), true
 
+	case "Audio.album":
+		if e.complexity.Audio.Album == nil {
+			break
+		}
+
+		return e.complexity.Audio.Album(childComplexity), true
+
+	case "Audio.albumArtist":
+		if e.complexity.Audio.AlbumArtist == nil {
+			break
+		}
+
+		return e.complexity.Audio.AlbumArtist(childComplexity), true
+
 	case "Audio.albumFileId":
 		if e.complexity.Audio.AlbumFileID == nil {
 			break
@@ -604,6 +720,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Audio.BucketID(childComplexity), true
 
+	case "Audio.composer":
+		if e.complexity.Audio.Composer == nil {
+			break
+		}
+
+		return e.complexity.Audio.Composer(childComplexity), true
+
 	case "Audio.createdAt":
 		if e.complexity.Audio.CreatedAt == nil {
 			break
@@ -611,6 +734,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Audio.CreatedAt(childComplexity), true
 
+	case "Audio.cursor":
+		if e.complexity.Audio.Cursor == nil {
+			break
+		}
+
+		return e.complexity.Audio.Cursor(childComplexity), true
+
+	case "Audio.disc":
+		if e.complexity.Audio.Disc == nil {
+			break
+		}
+
+		return e.complexity.Audio.Disc(childComplexity), true
+
 	case "Audio.duration":
 		if e.complexity.Audio.Duration == nil {
 			break
@@ -618,6 +755,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Audio.Duration(childComplexity), true
 
+	case "Audio.genre":
+		if e.complexity.Audio.Genre == nil {
+			break
+		}
+
+		return e.complexity.Audio.Genre(childComplexity), true
+
 	case "Audio.id":
 		if e.complexity.Audio.ID == nil {
 			break
@@ -625,6 +769,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Audio.ID(childComplexity), true
 
+	case "Audio.online":
+		if e.complexity.Audio.Online == nil {
+			break
+		}
+
+		return e.complexity.Audio.Online(childComplexity), true
+
 	case "Audio.path":
 		if e.complexity.Audio.Path == nil {
 			break
@@ -653,6 +804,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Audio.Title(childComplexity), true
 
+	case "Audio.track":
+		if e.complexity.Audio.Track == nil {
+			break
+		}
+
+		return e.complexity.Audio.Track(childComplexity), true
+
 	case "Audio.updatedAt":
 		if e.complexity.Audio.UpdatedAt == nil {
 			break
@@ -660,6 +818,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Audio.UpdatedAt(childComplexity), true
 
+	case "Audio.volume":
+		if e.complexity.Audio.Volume == nil {
+			break
+		}
+
+		return e.complexity.Audio.Volume(childComplexity), true
+
+	case "Audio.year":
+		if e.complexity.Audio.Year == nil {
+			break
+		}
+
+		return e.complexity.Audio.Year(childComplexity), true
+
 	case "AudioFileInfo.duration":
 		if e.complexity.AudioFileInfo.Duration == nil {
 			break
@@ -674,6 +846,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AudioFileInfo.Location(childComplexity), true
 
+	case "BackupResult.keys":
+		if e.complexity.BackupResult.Keys == nil {
+			break
+		}
+
+		return e.complexity.BackupResult.Keys(childComplexity), true
+
+	case "BackupResult.path":
+		if e.complexity.BackupResult.Path == nil {
+			break
+		}
+
+		return e.complexity.BackupResult.Path(childComplexity), true
+
+	case "BackupResult.schemaVersion":
+		if e.complexity.BackupResult.SchemaVersion == nil {
+			break
+		}
+
+		return e.complexity.BackupResult.SchemaVersion(childComplexity), true
+
+	case "BackupResult.size":
+		if e.complexity.BackupResult.Size == nil {
+			break
+		}
+
+		return e.complexity.BackupResult.Size(childComplexity), true
+
 	case "DeviceInfo.appFullVersion":
 		if e.complexity.DeviceInfo.AppFullVersion == nil {
 			break
@@ -940,6 +1140,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.File.IsDir(childComplexity), true
 
+	case "File.nameMatches":
+		if e.complexity.File.NameMatches == nil {
+			break
+		}
+
+		return e.complexity.File.NameMatches(childComplexity), true
+
+	case "File.online":
+		if e.complexity.File.Online == nil {
+			break
+		}
+
+		return e.complexity.File.Online(childComplexity), true
+
 	case "File.path":
 		if e.complexity.File.Path == nil {
 			break
@@ -954,6 +1168,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.File.Size(childComplexity), true
 
+	case "File.snippet":
+		if e.complexity.File.Snippet == nil {
+			break
+		}
+
+		return e.complexity.File.Snippet(childComplexity), true
+
+	case "File.snippetMatches":
+		if e.complexity.File.SnippetMatches == nil {
+			break
+		}
+
+		return e.complexity.File.SnippetMatches(childComplexity), true
+
 	case "File.updatedAt":
 		if e.complexity.File.UpdatedAt == nil {
 			break
@@ -961,6 +1189,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.File.UpdatedAt(childComplexity), true
 
+	case "File.volume":
+		if e.complexity.File.Volume == nil {
+			break
+		}
+
+		return e.complexity.File.Volume(childComplexity), true
+
 	case "FileInfo.data":
 		if e.complexity.FileInfo.Data == nil {
 			break
@@ -1073,6 +1308,97 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FileTask.UpdatedAt(childComplexity), true
 
+	case "FsckCount.check":
+		if e.complexity.FsckCount.Check == nil {
+			break
+		}
+
+		return e.complexity.FsckCount.Check(childComplexity), true
+
+	case "FsckCount.count":
+		if e.complexity.FsckCount.Count == nil {
+			break
+		}
+
+		return e.complexity.FsckCount.Count(childComplexity), true
+
+	case "FsckCount.problem":
+		if e.complexity.FsckCount.Problem == nil {
+			break
+		}
+
+		return e.complexity.FsckCount.Problem(childComplexity), true
+
+	case "FsckCount.repaired":
+		if e.complexity.FsckCount.Repaired == nil {
+			break
+		}
+
+		return e.complexity.FsckCount.Repaired(childComplexity), true
+
+	case "FsckIssue.check":
+		if e.complexity.FsckIssue.Check == nil {
+			break
+		}
+
+		return e.complexity.FsckIssue.Check(childComplexity), true
+
+	case "FsckIssue.key":
+		if e.complexity.FsckIssue.Key == nil {
+			break
+		}
+
+		return e.complexity.FsckIssue.Key(childComplexity), true
+
+	case "FsckIssue.problem":
+		if e.complexity.FsckIssue.Problem == nil {
+			break
+		}
+
+		return e.complexity.FsckIssue.Problem(childComplexity), true
+
+	case "FsckIssue.repaired":
+		if e.complexity.FsckIssue.Repaired == nil {
+			break
+		}
+
+		return e.complexity.FsckIssue.Repaired(childComplexity), true
+
+	case "FsckReport.apply":
+		if e.complexity.FsckReport.Apply == nil {
+			break
+		}
+
+		return e.complexity.FsckReport.Apply(childComplexity), true
+
+	case "FsckReport.counts":
+		if e.complexity.FsckReport.Counts == nil {
+			break
+		}
+
+		return e.complexity.FsckReport.Counts(childComplexity), true
+
+	case "FsckReport.entries":
+		if e.complexity.FsckReport.Entries == nil {
+			break
+		}
+
+		return e.complexity.FsckReport.Entries(childComplexity), true
+
+	case "FsckReport.issues":
+		if e.complexity.FsckReport.Issues == nil {
+			break
+		}
+
+		return e.complexity.FsckReport.Issues(childComplexity), true
+
+	case "FsckReport.truncated":
+		if e.complexity.FsckReport.Truncated == nil {
+			break
+		}
+
+		return e.complexity.FsckReport.Truncated(childComplexity), true
+
 	case "GeoLocation.latitude":
 		if e.complexity.GeoLocation.Latitude == nil {
 			break
@@ -1101,6 +1427,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Image.CreatedAt(childComplexity), true
 
+	case "Image.cursor":
+		if e.complexity.Image.Cursor == nil {
+			break
+		}
+
+		return e.complexity.Image.Cursor(childComplexity), true
+
 	case "Image.id":
 		if e.complexity.Image.ID == nil {
 			break
@@ -1108,6 +1441,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Image.ID(childComplexity), true
 
+	case "Image.online":
+		if e.complexity.Image.Online == nil {
+			break
+		}
+
+		return e.complexity.Image.Online(childComplexity), true
+
 	case "Image.path":
 		if e.complexity.Image.Path == nil {
 			break
@@ -1143,6 +1483,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Image.UpdatedAt(childComplexity), true
 
+	case "Image.volume":
+		if e.complexity.Image.Volume == nil {
+			break
+		}
+
+		return e.complexity.Image.Volume(childComplexity), true
+
 	case "ImageFileInfo.height":
 		if e.complexity.ImageFileInfo.Height == nil {
 			break
@@ -1206,6 +1553,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MediaBucket.TopItems(childComplexity), true
 
+	case "MediaScanJob.createdAt":
+		if e.complexity.MediaScanJob.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.CreatedAt(childComplexity), true
+
+	case "MediaScanJob.errorCount":
+		if e.complexity.MediaScanJob.ErrorCount == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.ErrorCount(childComplexity), true
+
+	case "MediaScanJob.errors":
+		if e.complexity.MediaScanJob.Errors == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.Errors(childComplexity), true
+
+	case "MediaScanJob.finishedAt":
+		if e.complexity.MediaScanJob.FinishedAt == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.FinishedAt(childComplexity), true
+
+	case "MediaScanJob.id":
+		if e.complexity.MediaScanJob.ID == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.ID(childComplexity), true
+
+	case "MediaScanJob.incremental":
+		if e.complexity.MediaScanJob.Incremental == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.Incremental(childComplexity), true
+
+	case "MediaScanJob.indexed":
+		if e.complexity.MediaScanJob.Indexed == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.Indexed(childComplexity), true
+
+	case "MediaScanJob.root":
+		if e.complexity.MediaScanJob.Root == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.Root(childComplexity), true
+
+	case "MediaScanJob.startedAt":
+		if e.complexity.MediaScanJob.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.StartedAt(childComplexity), true
+
+	case "MediaScanJob.state":
+		if e.complexity.MediaScanJob.State == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.State(childComplexity), true
+
+	case "MediaScanJob.total":
+		if e.complexity.MediaScanJob.Total == nil {
+			break
+		}
+
+		return e.complexity.MediaScanJob.Total(childComplexity), true
+
 	case "Mutation.addFavoriteFolder":
 		if e.complexity.Mutation.AddFavoriteFolder == nil {
 			break
@@ -1242,6 +1666,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddToTags(childComplexity, args["type"].(model.DataType), args["tagIds"].([]string), args["query"].(string)), true
 
+	case "Mutation.backup":
+		if e.complexity.Mutation.Backup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_backup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Backup(childComplexity, args["path"].(string), args["excludeCaches"].(bool)), true
+
+	case "Mutation.cancelFileTask":
+		if e.complexity.Mutation.CancelFileTask == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelFileTask_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelFileTask(childComplexity, args["id"].(string)), true
+
 	case "Mutation.clearAudioPlaylist":
 		if e.complexity.Mutation.ClearAudioPlaylist == nil {
 			break
@@ -1261,6 +1709,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CopyFile(childComplexity, args["src"].(string), args["dst"].(string), args["overwrite"].(bool)), true
 
+	case "Mutation.createArchive":
+		if e.complexity.Mutation.CreateArchive == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createArchive_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateArchive(childComplexity, args["paths"].([]string), args["dst"].(string), args["format"].(model.ArchiveFormat), args["level"].(int)), true
+
 	case "Mutation.createCopyTask":
 		if e.complexity.Mutation.CreateCopyTask == nil {
 			break
@@ -1393,6 +1853,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.FormatDisk(childComplexity, args["path"].(string)), true
 
+	case "Mutation.fsck":
+		if e.complexity.Mutation.Fsck == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_fsck_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Fsck(childComplexity, args["apply"].(bool)), true
+
 	case "Mutation.logout":
 		if e.complexity.Mutation.Logout == nil {
 			break
@@ -1410,7 +1882,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.MergeChunks(childComplexity, args["fileId"].(string), args["totalChunks"].(int), args["path"].(string), args["replace"].(bool)), true
+		return e.complexity.Mutation.MergeChunks(childComplexity, args["fileId"].(string), args["totalChunks"].(int), args["path"].(string), args["replace"].(bool), args["batchId"].(string), args["batchTotal"].(int), args["batchDir"].(string)), true
 
 	case "Mutation.moveFile":
 		if e.complexity.Mutation.MoveFile == nil {
@@ -1431,6 +1903,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.PauseMediaScan(childComplexity), true
 
+	case "Mutation.pauseMediaScanJob":
+		if e.complexity.Mutation.PauseMediaScanJob == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_pauseMediaScanJob_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PauseMediaScanJob(childComplexity, args["id"].(string)), true
+
 	case "Mutation.playAudio":
 		if e.complexity.Mutation.PlayAudio == nil {
 			break
@@ -1534,6 +2018,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.ResumeMediaScan(childComplexity), true
 
+	case "Mutation.resumeMediaScanJob":
+		if e.complexity.Mutation.ResumeMediaScanJob == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resumeMediaScanJob_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResumeMediaScanJob(childComplexity, args["id"].(string)), true
+
 	case "Mutation.revokeSession":
 		if e.complexity.Mutation.RevokeSession == nil {
 			break
@@ -1570,20 +2066,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.SetFavoriteFolderAlias(childComplexity, args["rootPath"].(string), args["relativePath"].(string), args["alias"].(string)), true
 
-	case "Mutation.setKeyValue":
-		if e.complexity.Mutation.SetKeyValue == nil {
+	case "Mutation.setIgnorePatterns":
+		if e.complexity.Mutation.SetIgnorePatterns == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_setKeyValue_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_setIgnorePatterns_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.SetKeyValue(childComplexity, args["key"].(string), args["value"].(string)), true
+		return e.complexity.Mutation.SetIgnorePatterns(childComplexity, args["patterns"].([]string)), true
 
-	case "Mutation.setMediaSourceDirs":
-		if e.complexity.Mutation.SetMediaSourceDirs == nil {
+	case "Mutation.setKeyValue":
+		if e.complexity.Mutation.SetKeyValue == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setKeyValue_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetKeyValue(childComplexity, args["key"].(string), args["value"].(string)), true
+
+	case "Mutation.setMediaSourceDirs":
+		if e.complexity.Mutation.SetMediaSourceDirs == nil {
 			break
 		}
 
@@ -1652,7 +2160,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.StartMediaScan(childComplexity, args["root"].(string)), true
+		return e.complexity.Mutation.StartMediaScan(childComplexity, args["root"].(string), args["incremental"].(bool)), true
 
 	case "Mutation.stopMediaScan":
 		if e.complexity.Mutation.StopMediaScan == nil {
@@ -1661,6 +2169,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.StopMediaScan(childComplexity), true
 
+	case "Mutation.stopMediaScanJob":
+		if e.complexity.Mutation.StopMediaScanJob == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_stopMediaScanJob_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.StopMediaScanJob(childComplexity, args["id"].(string)), true
+
 	case "Mutation.trashFiles":
 		if e.complexity.Mutation.TrashFiles == nil {
 			break
@@ -1721,6 +2241,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateTagRelations(childComplexity, args["type"].(model.DataType), args["item"].(model.TagRelationStub), args["addTagIds"].([]string), args["removeTagIds"].([]string)), true
 
+	case "Mutation.uploadByHash":
+		if e.complexity.Mutation.UploadByHash == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadByHash_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadByHash(childComplexity, args["hash"].(string), args["size"].(int64), args["path"].(string), args["replace"].(bool)), true
+
 	case "Mutation.writeTextFile":
 		if e.complexity.Mutation.WriteTextFile == nil {
 			break
@@ -1831,6 +2363,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AppUpdate(childComplexity), true
 
+	case "Query.archiveEntries":
+		if e.complexity.Query.ArchiveEntries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_archiveEntries_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ArchiveEntries(childComplexity, args["path"].(string), args["prefix"].(string)), true
+
 	case "Query.audioCount":
 		if e.complexity.Query.AudioCount == nil {
 			break
@@ -1853,7 +2397,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Audios(childComplexity, args["offset"].(int), args["limit"].(int), args["query"].(string), args["sortBy"].(model.FileSortBy)), true
+		return e.complexity.Query.Audios(childComplexity, args["offset"].(int), args["limit"].(int), args["query"].(string), args["sortBy"].(model.FileSortBy), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.checkUploadHashes":
+		if e.complexity.Query.CheckUploadHashes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_checkUploadHashes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CheckUploadHashes(childComplexity, args["hashes"].([]string), args["dir"].(string)), true
 
 	case "Query.deviceInfo":
 		if e.complexity.Query.DeviceInfo == nil {
@@ -1938,6 +2494,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetTasks(childComplexity), true
 
+	case "Query.ignorePatterns":
+		if e.complexity.Query.IgnorePatterns == nil {
+			break
+		}
+
+		return e.complexity.Query.IgnorePatterns(childComplexity), true
+
 	case "Query.imageCount":
 		if e.complexity.Query.ImageCount == nil {
 			break
@@ -1960,7 +2523,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Images(childComplexity, args["offset"].(int), args["limit"].(int), args["query"].(string), args["sortBy"].(model.FileSortBy)), true
+		return e.complexity.Query.Images(childComplexity, args["offset"].(int), args["limit"].(int), args["query"].(string), args["sortBy"].(model.FileSortBy), args["after"].(*string), args["first"].(*int)), true
 
 	case "Query.mediaBuckets":
 		if e.complexity.Query.MediaBuckets == nil {
@@ -1974,6 +2537,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.MediaBuckets(childComplexity, args["type"].(model.DataType)), true
 
+	case "Query.mediaScanJobs":
+		if e.complexity.Query.MediaScanJobs == nil {
+			break
+		}
+
+		return e.complexity.Query.MediaScanJobs(childComplexity), true
+
 	case "Query.mediaSourceDirs":
 		if e.complexity.Query.MediaSourceDirs == nil {
 			break
@@ -2093,7 +2663,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Videos(childComplexity, args["offset"].(int), args["limit"].(int), args["query"].(string), args["sortBy"].(model.FileSortBy)), true
+		return e.complexity.Query.Videos(childComplexity, args["offset"].(int), args["limit"].(int), args["query"].(string), args["sortBy"].(model.FileSortBy), args["after"].(*string), args["first"].(*int)), true
 
 	case "SambaSettings.enabled":
 		if e.complexity.SambaSettings.Enabled == nil {
@@ -2326,6 +2896,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.StorageMount.Label(childComplexity), true
 
+	case "StorageMount.lastSeen":
+		if e.complexity.StorageMount.LastSeen == nil {
+			break
+		}
+
+		return e.complexity.StorageMount.LastSeen(childComplexity), true
+
 	case "StorageMount.mountPoint":
 		if e.complexity.StorageMount.MountPoint == nil {
 			break
@@ -2424,6 +3001,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TempValue.Value(childComplexity), true
 
+	case "TextRange.length":
+		if e.complexity.TextRange.Length == nil {
+			break
+		}
+
+		return e.complexity.TextRange.Length(childComplexity), true
+
+	case "TextRange.start":
+		if e.complexity.TextRange.Start == nil {
+			break
+		}
+
+		return e.complexity.TextRange.Start(childComplexity), true
+
 	case "Video.bucketId":
 		if e.complexity.Video.BucketID == nil {
 			break
@@ -2438,6 +3029,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Video.CreatedAt(childComplexity), true
 
+	case "Video.cursor":
+		if e.complexity.Video.Cursor == nil {
+			break
+		}
+
+		return e.complexity.Video.Cursor(childComplexity), true
+
 	case "Video.duration":
 		if e.complexity.Video.Duration == nil {
 			break
@@ -2452,6 +3050,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Video.ID(childComplexity), true
 
+	case "Video.online":
+		if e.complexity.Video.Online == nil {
+			break
+		}
+
+		return e.complexity.Video.Online(childComplexity), true
+
 	case "Video.path":
 		if e.complexity.Video.Path == nil {
 			break
@@ -2487,6 +3092,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Video.UpdatedAt(childComplexity), true
 
+	case "Video.volume":
+		if e.complexity.Video.Volume == nil {
+			break
+		}
+
+		return e.complexity.Video.Volume(childComplexity), true
+
 	case "VideoFileInfo.duration":
 		if e.complexity.VideoFileInfo.Duration == nil {
 			break
@@ -2515,6 +3127,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VideoFileInfo.Width(childComplexity), true
 
+	case "VolumeRef.id":
+		if e.complexity.VolumeRef.ID == nil {
+			break
+		}
+
+		return e.complexity.VolumeRef.ID(childComplexity), true
+
+	case "VolumeRef.name":
+		if e.complexity.VolumeRef.Name == nil {
+			break
+		}
+
+		return e.complexity.VolumeRef.Name(childComplexity), true
+
+	case "VolumeRef.uuid":
+		if e.complexity.VolumeRef.UUID == nil {
+			break
+		}
+
+		return e.complexity.VolumeRef.UUID(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -2663,6 +3296,7 @@ input SambaSettingsInput {
 enum FileTaskType {
   COPY
   MOVE
+  ARCHIVE
 }
 
 enum FileTaskStatus {
@@ -2670,6 +3304,14 @@ enum FileTaskStatus {
   RUNNING
   DONE
   ERROR
+  CANCELED
+}
+
+enum ArchiveFormat {
+  ZIP
+  TAR
+  TAR_GZ
+  TAR_ZST
 }
 
 input FileTaskOpInput {
@@ -2717,6 +3359,8 @@ type Mutation {
   logout: Boolean!
   revokeSession(clientId: String!): Boolean!
   setMediaSourceDirs(dirs: [String!]!): Boolean!
+  # Global ignore patterns (gitignore syntax), applied with the .plainnasignore files.
+  setIgnorePatterns(patterns: [String!]!): Boolean!
   setSambaSettings(input: SambaSettingsInput!): Boolean!
   setSambaUserPassword(password: String!): Boolean!
   addFavoriteFolder(rootPath: String!, relativePath: String!): FavoriteFolder!
@@ -2729,15 +3373,28 @@ type Mutation {
   moveFile(src: String!, dst: String!, overwrite: Boolean!): Boolean!
   createCopyTask(ops: [FileTaskOpInput!]!): FileTask!
   createMoveTask(ops: [FileTaskOpInput!]!): FileTask!
+  # Write an archive of paths to dst on disk as a background file task.
+  # level: -1 for the format default; 0-9 for ZIP/TAR_GZ, 1-22 for TAR_ZST.
+  createArchive(paths: [String!]!, dst: String!, format: ArchiveFormat!, level: Int! = -1): FileTask!
+  cancelFileTask(id: ID!): Boolean!
   deleteFiles(paths: [String!]!): Boolean!
   trashFiles(paths: [String!]!): Boolean!
   restoreFiles(paths: [String!]!): Boolean!
   setTempValue(key: String!, value: String!): TempValue!
-  mergeChunks(fileId: String!, totalChunks: Int!, path: String!, replace: Boolean!): String!
-  startMediaScan(root: String!): Boolean!
+  # batchId/batchTotal add the merged file to a folder upload batch (see /upload); batchDir is the
+  # batch's upload directory, which the file is tracked relative to.
+  mergeChunks(fileId: String!, totalChunks: Int!, path: String!, replace: Boolean!, batchId: String! = "", batchTotal: Int! = 0, batchDir: String! = ""): String!
+  # Creates path from a file already on the NAS with the same SHA-256 content hash (see checkUploadHashes).
+  uploadByHash(hash: String!, size: Long!, path: String!, replace: Boolean!): String!
+  # An incremental scan only looks at files of directories whose mtime changed since the last scan.
+  startMediaScan(root: String!, incremental: Boolean! = false): Boolean!
+  # Pause, resume and stop apply to all unfinished scan jobs; the *MediaScanJob variants to one.
   pauseMediaScan: Boolean!
   resumeMediaScan: Boolean!
   stopMediaScan: Boolean!
+  pauseMediaScanJob(id: ID!): Boolean!
+  resumeMediaScanJob(id: ID!): Boolean!
+  stopMediaScanJob(id: ID!): Boolean!
   rebuildMediaIndex(root: String!): Boolean!
   # Audio playlist controls
   addPlaylistAudios(query: String!): Boolean!
@@ -2764,6 +3421,11 @@ type Mutation {
   # Storage disk management
   formatDisk(path: String!): Boolean!
 
+  # Catalog consistency check; apply repairs the findings, otherwise nothing is written.
+  fsck(apply: Boolean!): FsckReport!
+  # Write a consistent snapshot of the database to path (absolute, on the NAS); restore with ` + "`" + `plainnas restore` + "`" + `.
+  backup(path: String!, excludeCaches: Boolean!): BackupResult!
+
   # DLNA casting
   dlnaCast(rendererUdn: String!, url: String!, title: String!, mime: String!, type: DataType!): Boolean!
 }
@@ -2777,14 +3439,17 @@ type Query {
   mounts: [StorageMount!]!
   disks: [StorageDisk!]!
   mediaSourceDirs: [String!]!
+  ignorePatterns: [String!]!
+  # Queued, running and recently finished media scans, oldest first.
+  mediaScanJobs: [MediaScanJob!]!
   sambaSettings: SambaSettings!
-  images(offset: Int!, limit: Int!, query: String!, sortBy: FileSortBy!): [Image!]!
+  images(offset: Int!, limit: Int!, query: String!, sortBy: FileSortBy!, after: String, first: Int): [Image!]!
   imageCount(query: String!): Int!
   recentFilesCount: Int!
   tags(type: DataType!): [Tag!]!
   mediaBuckets(type: DataType!): [MediaBucket!]!
-  videos(offset: Int!, limit: Int!, query: String!, sortBy: FileSortBy!): [Video!]!
-  audios(offset: Int!, limit: Int!, query: String!, sortBy: FileSortBy!): [Audio!]!
+  videos(offset: Int!, limit: Int!, query: String!, sortBy: FileSortBy!, after: String, first: Int): [Video!]!
+  audios(offset: Int!, limit: Int!, query: String!, sortBy: FileSortBy!, after: String, first: Int): [Audio!]!
   audioCount(query: String!): Int!
   videoCount(query: String!): Int!
   deviceInfo: DeviceInfo!
@@ -2795,8 +3460,13 @@ type Query {
   files(offset: Int!, limit: Int!, query: String!, sortBy: FileSortBy!): [File!]!
   filesCount(query: String!): Int!
   recentFiles: [File!]!
+  # List entries directly under prefix inside a zip/tar archive, as virtual files
+  # with paths of the form "<archive>!/<entry>".
+  archiveEntries(path: String!, prefix: String!): [File!]!
   trashCount: Int!
   uploadedChunks(fileId: String!): [Int!]!
+  # Returns the hashes (hex SHA-256) that uploadByHash can satisfy; with dir, only those linkable into dir.
+  checkUploadHashes(hashes: [String!]!, dir: String! = ""): [String!]!
 
   # DLNA casting
   dlnaRenderers: [DlnaRenderer!]!
@@ -2859,6 +3529,37 @@ type MediaActionResult {
   query: String!
 }
 
+type BackupResult {
+  path: String!
+  keys: Int!
+  size: Long!
+  schemaVersion: Int!
+}
+
+type FsckIssue {
+  check: String!
+  key: String!
+  problem: String!
+  repaired: Boolean!
+}
+
+type FsckCount {
+  check: String!
+  problem: String!
+  count: Int!
+  repaired: Int!
+}
+
+type FsckReport {
+  apply: Boolean!
+  # Records and index keys examined
+  entries: Int!
+  # At most 1000 issues are listed (truncated); counts cover all of them.
+  issues: [FsckIssue!]!
+  counts: [FsckCount!]!
+  truncated: Boolean!
+}
+
 enum MediaPlayMode {
   REPEAT
   REPEAT_ONE
@@ -2896,6 +3597,9 @@ type StorageMount {
   remote: Boolean!
   driveType: String
   diskID: ID
+  # Last time the volume was seen mounted. Known volumes that are unplugged are listed with
+  # lastSeen and without mountPoint or path.
+  lastSeen: Time
 }
 
 type StorageDisk {
@@ -2956,6 +3660,27 @@ type File {
   updatedAt: Time!
   size: Long!
   childCount: Int!
+  snippet: String
+  nameMatches: [TextRange!]
+  snippetMatches: [TextRange!]
+  # False for a search hit on a volume that is not mounted (offline catalog).
+  online: Boolean!
+  # The unplugged volume holding the file; null while online.
+  volume: VolumeRef
+}
+
+# Identifies a volume, e.g. the disk to plug in for an offline item.
+type VolumeRef {
+  # Same as StorageMount.id ("fsuuid:<uuid>").
+  id: String!
+  uuid: String!
+  # The volume alias, or "usb<slot>" when it has none.
+  name: String!
+}
+
+type TextRange {
+  start: Int!
+  length: Int!
 }
 
 type GeoLocation {
@@ -3013,6 +3738,11 @@ type Image {
   createdAt: Time!
   updatedAt: Time!
   tags: [Tag!]!
+  cursor: String!
+  # False when the item's volume is not mounted (offline catalog).
+  online: Boolean!
+  # The unplugged volume holding the item; null while online.
+  volume: VolumeRef
 }
 
 enum DataType {
@@ -3036,6 +3766,30 @@ type ScanProgress {
   state: String!
 }
 
+enum MediaScanJobState {
+  QUEUED
+  RUNNING
+  PAUSED
+  STOPPED
+  DONE
+  ERROR
+}
+
+type MediaScanJob {
+  id: ID!
+  root: String!
+  incremental: Boolean!
+  state: MediaScanJobState!
+  indexed: Long!
+  total: Long!
+  # The first errors of the job; errorCount counts all of them.
+  errors: [String!]!
+  errorCount: Int!
+  createdAt: Time!
+  startedAt: Time
+  finishedAt: Time
+}
+
 type Video {
   id: ID!
   title: String!
@@ -3046,12 +3800,25 @@ type Video {
   createdAt: Time!
   updatedAt: Time!
   tags: [Tag!]!
+  cursor: String!
+  # False when the item's volume is not mounted (offline catalog).
+  online: Boolean!
+  # The unplugged volume holding the item; null while online.
+  volume: VolumeRef
 }
 
 type Audio {
   id: ID!
   title: String!
   artist: String!
+  # Audio tags; empty strings and 0 mean the tag is absent.
+  album: String!
+  albumArtist: String!
+  track: Int!
+  disc: Int!
+  year: Int!
+  genre: String!
+  composer: String!
   path: String!
   duration: Int!
   size: Long!
@@ -3060,6 +3827,11 @@ type Audio {
   createdAt: Time!
   updatedAt: Time!
   tags: [Tag!]!
+  cursor: String!
+  # False when the item's volume is not mounted (offline catalog).
+  online: Boolean!
+  # The unplugged volume holding the item; null while online.
+  volume: VolumeRef
 }
 
 input TagRelationStub {
@@ -3227,6 +3999,85 @@ func (ec *executionContext) field_Mutation_addToTags_argsQuery(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_backup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_backup_argsPath(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["path"] = arg0
+	arg1, err := ec.field_Mutation_backup_argsExcludeCaches(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["excludeCaches"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_backup_argsPath(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["path"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+	if tmp, ok := rawArgs["path"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_backup_argsExcludeCaches(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["excludeCaches"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("excludeCaches"))
+	if tmp, ok := rawArgs["excludeCaches"]; ok {
+		return ec.unmarshalNBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_cancelFileTask_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_cancelFileTask_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_cancelFileTask_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_copyFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -3301,55 +4152,60 @@ func (ec *executionContext) field_Mutation_copyFile_argsOverwrite(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_createCopyTask_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_createArchive_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_createCopyTask_argsOps(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_createArchive_argsPaths(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["ops"] = arg0
+	args["paths"] = arg0
+	arg1, err := ec.field_Mutation_createArchive_argsDst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["dst"] = arg1
+	arg2, err := ec.field_Mutation_createArchive_argsFormat(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["format"] = arg2
+	arg3, err := ec.field_Mutation_createArchive_argsLevel(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["level"] = arg3
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_createCopyTask_argsOps(
+func (ec *executionContext) field_Mutation_createArchive_argsPaths(
 	ctx context.Context,
 	rawArgs map[string]any,
-) ([]*model.FileTaskOpInput, error) {
-	if _, ok := rawArgs["ops"]; !ok {
-		var zeroVal []*model.FileTaskOpInput
+) ([]string, error) {
+	if _, ok := rawArgs["paths"]; !ok {
+		var zeroVal []string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("ops"))
-	if tmp, ok := rawArgs["ops"]; ok {
-		return ec.unmarshalNFileTaskOpInput2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskOpInputᚄ(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("paths"))
+	if tmp, ok := rawArgs["paths"]; ok {
+		return ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
 	}
 
-	var zeroVal []*model.FileTaskOpInput
+	var zeroVal []string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_createDir_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Mutation_createDir_argsPath(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["path"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Mutation_createDir_argsPath(
+func (ec *executionContext) field_Mutation_createArchive_argsDst(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["path"]; !ok {
+	if _, ok := rawArgs["dst"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
-	if tmp, ok := rawArgs["path"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("dst"))
+	if tmp, ok := rawArgs["dst"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -3357,38 +4213,130 @@ func (ec *executionContext) field_Mutation_createDir_argsPath(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_createMoveTask_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Mutation_createMoveTask_argsOps(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["ops"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Mutation_createMoveTask_argsOps(
+func (ec *executionContext) field_Mutation_createArchive_argsFormat(
 	ctx context.Context,
 	rawArgs map[string]any,
-) ([]*model.FileTaskOpInput, error) {
-	if _, ok := rawArgs["ops"]; !ok {
-		var zeroVal []*model.FileTaskOpInput
+) (model.ArchiveFormat, error) {
+	if _, ok := rawArgs["format"]; !ok {
+		var zeroVal model.ArchiveFormat
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("ops"))
-	if tmp, ok := rawArgs["ops"]; ok {
-		return ec.unmarshalNFileTaskOpInput2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskOpInputᚄ(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+	if tmp, ok := rawArgs["format"]; ok {
+		return ec.unmarshalNArchiveFormat2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐArchiveFormat(ctx, tmp)
 	}
 
-	var zeroVal []*model.FileTaskOpInput
+	var zeroVal model.ArchiveFormat
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_createTag_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Mutation_createTag_argsType(ctx, rawArgs)
+func (ec *executionContext) field_Mutation_createArchive_argsLevel(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (int, error) {
+	if _, ok := rawArgs["level"]; !ok {
+		var zeroVal int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("level"))
+	if tmp, ok := rawArgs["level"]; ok {
+		return ec.unmarshalNInt2int(ctx, tmp)
+	}
+
+	var zeroVal int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_createCopyTask_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_createCopyTask_argsOps(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["ops"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_createCopyTask_argsOps(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]*model.FileTaskOpInput, error) {
+	if _, ok := rawArgs["ops"]; !ok {
+		var zeroVal []*model.FileTaskOpInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("ops"))
+	if tmp, ok := rawArgs["ops"]; ok {
+		return ec.unmarshalNFileTaskOpInput2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskOpInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.FileTaskOpInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_createDir_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_createDir_argsPath(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["path"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_createDir_argsPath(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["path"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+	if tmp, ok := rawArgs["path"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_createMoveTask_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_createMoveTask_argsOps(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["ops"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_createMoveTask_argsOps(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]*model.FileTaskOpInput, error) {
+	if _, ok := rawArgs["ops"]; !ok {
+		var zeroVal []*model.FileTaskOpInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("ops"))
+	if tmp, ok := rawArgs["ops"]; ok {
+		return ec.unmarshalNFileTaskOpInput2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskOpInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.FileTaskOpInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_createTag_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_createTag_argsType(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -3747,6 +4695,34 @@ func (ec *executionContext) field_Mutation_formatDisk_argsPath(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_fsck_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_fsck_argsApply(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["apply"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_fsck_argsApply(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["apply"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("apply"))
+	if tmp, ok := rawArgs["apply"]; ok {
+		return ec.unmarshalNBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_mergeChunks_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -3770,6 +4746,21 @@ func (ec *executionContext) field_Mutation_mergeChunks_args(ctx context.Context,
 		return nil, err
 	}
 	args["replace"] = arg3
+	arg4, err := ec.field_Mutation_mergeChunks_argsBatchID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["batchId"] = arg4
+	arg5, err := ec.field_Mutation_mergeChunks_argsBatchTotal(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["batchTotal"] = arg5
+	arg6, err := ec.field_Mutation_mergeChunks_argsBatchDir(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["batchDir"] = arg6
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_mergeChunks_argsFileID(
@@ -3844,6 +4835,60 @@ func (ec *executionContext) field_Mutation_mergeChunks_argsReplace(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_mergeChunks_argsBatchID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["batchId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("batchId"))
+	if tmp, ok := rawArgs["batchId"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_mergeChunks_argsBatchTotal(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (int, error) {
+	if _, ok := rawArgs["batchTotal"]; !ok {
+		var zeroVal int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("batchTotal"))
+	if tmp, ok := rawArgs["batchTotal"]; ok {
+		return ec.unmarshalNInt2int(ctx, tmp)
+	}
+
+	var zeroVal int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_mergeChunks_argsBatchDir(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["batchDir"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("batchDir"))
+	if tmp, ok := rawArgs["batchDir"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_moveFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -3918,6 +4963,34 @@ func (ec *executionContext) field_Mutation_moveFile_argsOverwrite(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_pauseMediaScanJob_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_pauseMediaScanJob_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_pauseMediaScanJob_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_playAudio_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -4257,6 +5330,34 @@ func (ec *executionContext) field_Mutation_restoreMediaItems_argsQuery(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_resumeMediaScanJob_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_resumeMediaScanJob_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_resumeMediaScanJob_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_revokeSession_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -4387,6 +5488,34 @@ func (ec *executionContext) field_Mutation_setFavoriteFolderAlias_argsAlias(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_setIgnorePatterns_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_setIgnorePatterns_argsPatterns(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["patterns"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_setIgnorePatterns_argsPatterns(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]string, error) {
+	if _, ok := rawArgs["patterns"]; !ok {
+		var zeroVal []string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("patterns"))
+	if tmp, ok := rawArgs["patterns"]; ok {
+		return ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+	}
+
+	var zeroVal []string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_setKeyValue_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -4632,6 +5761,11 @@ func (ec *executionContext) field_Mutation_startMediaScan_args(ctx context.Conte
 		return nil, err
 	}
 	args["root"] = arg0
+	arg1, err := ec.field_Mutation_startMediaScan_argsIncremental(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["incremental"] = arg1
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_startMediaScan_argsRoot(
@@ -4652,14 +5786,60 @@ func (ec *executionContext) field_Mutation_startMediaScan_argsRoot(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_trashFiles_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Mutation_trashFiles_argsPaths(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["paths"] = arg0
+func (ec *executionContext) field_Mutation_startMediaScan_argsIncremental(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["incremental"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("incremental"))
+	if tmp, ok := rawArgs["incremental"]; ok {
+		return ec.unmarshalNBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_stopMediaScanJob_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_stopMediaScanJob_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_stopMediaScanJob_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_trashFiles_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_trashFiles_argsPaths(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["paths"] = arg0
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_trashFiles_argsPaths(
@@ -4907,6 +6087,103 @@ func (ec *executionContext) field_Mutation_updateTag_argsName(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_uploadByHash_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_uploadByHash_argsHash(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["hash"] = arg0
+	arg1, err := ec.field_Mutation_uploadByHash_argsSize(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["size"] = arg1
+	arg2, err := ec.field_Mutation_uploadByHash_argsPath(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["path"] = arg2
+	arg3, err := ec.field_Mutation_uploadByHash_argsReplace(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["replace"] = arg3
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_uploadByHash_argsHash(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["hash"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("hash"))
+	if tmp, ok := rawArgs["hash"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_uploadByHash_argsSize(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (int64, error) {
+	if _, ok := rawArgs["size"]; !ok {
+		var zeroVal int64
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("size"))
+	if tmp, ok := rawArgs["size"]; ok {
+		return ec.unmarshalNLong2int64(ctx, tmp)
+	}
+
+	var zeroVal int64
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_uploadByHash_argsPath(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["path"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+	if tmp, ok := rawArgs["path"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_uploadByHash_argsReplace(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["replace"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("replace"))
+	if tmp, ok := rawArgs["replace"]; ok {
+		return ec.unmarshalNBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_writeTextFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -5009,6 +6286,57 @@ func (ec *executionContext) field_Query___type_argsName(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_archiveEntries_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_archiveEntries_argsPath(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["path"] = arg0
+	arg1, err := ec.field_Query_archiveEntries_argsPrefix(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["prefix"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_archiveEntries_argsPath(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["path"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+	if tmp, ok := rawArgs["path"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_archiveEntries_argsPrefix(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["prefix"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+	if tmp, ok := rawArgs["prefix"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_audioCount_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -5060,6 +6388,16 @@ func (ec *executionContext) field_Query_audios_args(ctx context.Context, rawArgs
 		return nil, err
 	}
 	args["sortBy"] = arg3
+	arg4, err := ec.field_Query_audios_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg4
+	arg5, err := ec.field_Query_audios_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg5
 	return args, nil
 }
 func (ec *executionContext) field_Query_audios_argsOffset(
@@ -5134,6 +6472,93 @@ func (ec *executionContext) field_Query_audios_argsSortBy(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_audios_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["after"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_audios_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["first"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_checkUploadHashes_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_checkUploadHashes_argsHashes(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["hashes"] = arg0
+	arg1, err := ec.field_Query_checkUploadHashes_argsDir(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["dir"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_checkUploadHashes_argsHashes(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]string, error) {
+	if _, ok := rawArgs["hashes"]; !ok {
+		var zeroVal []string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("hashes"))
+	if tmp, ok := rawArgs["hashes"]; ok {
+		return ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+	}
+
+	var zeroVal []string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_checkUploadHashes_argsDir(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["dir"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("dir"))
+	if tmp, ok := rawArgs["dir"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_events_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -5412,6 +6837,16 @@ func (ec *executionContext) field_Query_images_args(ctx context.Context, rawArgs
 		return nil, err
 	}
 	args["sortBy"] = arg3
+	arg4, err := ec.field_Query_images_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg4
+	arg5, err := ec.field_Query_images_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg5
 	return args, nil
 }
 func (ec *executionContext) field_Query_images_argsOffset(
@@ -5486,6 +6921,42 @@ func (ec *executionContext) field_Query_images_argsSortBy(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_images_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["after"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_images_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["first"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_mediaBuckets_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -5677,7 +7148,17 @@ func (ec *executionContext) field_Query_videos_args(ctx context.Context, rawArgs
 		return nil, err
 	}
 	args["sortBy"] = arg3
-	return args, nil
+	arg4, err := ec.field_Query_videos_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg4
+	arg5, err := ec.field_Query_videos_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg5
+	return args, nil
 }
 func (ec *executionContext) field_Query_videos_argsOffset(
 	ctx context.Context,
@@ -5751,6 +7232,42 @@ func (ec *executionContext) field_Query_videos_argsSortBy(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_videos_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["after"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_videos_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["first"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -6589,8 +8106,8 @@ func (ec *executionContext) fieldContext_Audio_artist(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_path(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_path(ctx, field)
+func (ec *executionContext) _Audio_album(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_album(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6603,7 +8120,7 @@ func (ec *executionContext) _Audio_path(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.Album, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6620,7 +8137,7 @@ func (ec *executionContext) _Audio_path(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_album(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
@@ -6633,8 +8150,8 @@ func (ec *executionContext) fieldContext_Audio_path(_ context.Context, field gra
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_duration(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_duration(ctx, field)
+func (ec *executionContext) _Audio_albumArtist(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_albumArtist(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6647,7 +8164,7 @@ func (ec *executionContext) _Audio_duration(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Duration, nil
+		return obj.AlbumArtist, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6659,26 +8176,26 @@ func (ec *executionContext) _Audio_duration(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_albumArtist(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_size(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_size(ctx, field)
+func (ec *executionContext) _Audio_track(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_track(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6691,7 +8208,7 @@ func (ec *executionContext) _Audio_size(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Size, nil
+		return obj.Track, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6703,26 +8220,26 @@ func (ec *executionContext) _Audio_size(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_track(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_bucketId(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_bucketId(ctx, field)
+func (ec *executionContext) _Audio_disc(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_disc(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6735,7 +8252,7 @@ func (ec *executionContext) _Audio_bucketId(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BucketID, nil
+		return obj.Disc, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6747,26 +8264,26 @@ func (ec *executionContext) _Audio_bucketId(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_bucketId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_disc(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_albumFileId(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_albumFileId(ctx, field)
+func (ec *executionContext) _Audio_year(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_year(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6779,7 +8296,7 @@ func (ec *executionContext) _Audio_albumFileId(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AlbumFileID, nil
+		return obj.Year, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6791,26 +8308,26 @@ func (ec *executionContext) _Audio_albumFileId(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_albumFileId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_createdAt(ctx, field)
+func (ec *executionContext) _Audio_genre(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_genre(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6823,7 +8340,7 @@ func (ec *executionContext) _Audio_createdAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Genre, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6835,26 +8352,26 @@ func (ec *executionContext) _Audio_createdAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_genre(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_updatedAt(ctx, field)
+func (ec *executionContext) _Audio_composer(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_composer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6867,7 +8384,7 @@ func (ec *executionContext) _Audio_updatedAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Composer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6879,26 +8396,26 @@ func (ec *executionContext) _Audio_updatedAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_composer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Audio_tags(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Audio_tags(ctx, field)
+func (ec *executionContext) _Audio_path(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6911,7 +8428,7 @@ func (ec *executionContext) _Audio_tags(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tags, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6923,36 +8440,26 @@ func (ec *executionContext) _Audio_tags(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Tag)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTag2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTagᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Audio_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Tag_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Tag_name(ctx, field)
-			case "type":
-				return ec.fieldContext_Tag_type(ctx, field)
-			case "count":
-				return ec.fieldContext_Tag_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Tag", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AudioFileInfo_duration(ctx context.Context, field graphql.CollectedField, obj *model.AudioFileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AudioFileInfo_duration(ctx, field)
+func (ec *executionContext) _Audio_duration(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6972,16 +8479,19 @@ func (ec *executionContext) _AudioFileInfo_duration(ctx context.Context, field g
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AudioFileInfo_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AudioFileInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6992,8 +8502,8 @@ func (ec *executionContext) fieldContext_AudioFileInfo_duration(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _AudioFileInfo_location(ctx context.Context, field graphql.CollectedField, obj *model.AudioFileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AudioFileInfo_location(ctx, field)
+func (ec *executionContext) _Audio_size(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7006,41 +8516,38 @@ func (ec *executionContext) _AudioFileInfo_location(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.Size, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.GeoLocation)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalOGeoLocation2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐGeoLocation(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AudioFileInfo_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AudioFileInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "latitude":
-				return ec.fieldContext_GeoLocation_latitude(ctx, field)
-			case "longitude":
-				return ec.fieldContext_GeoLocation_longitude(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GeoLocation", field.Name)
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_hostname(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_hostname(ctx, field)
+func (ec *executionContext) _Audio_bucketId(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_bucketId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7053,7 +8560,7 @@ func (ec *executionContext) _DeviceInfo_hostname(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hostname, nil
+		return obj.BucketID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7070,9 +8577,9 @@ func (ec *executionContext) _DeviceInfo_hostname(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_hostname(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_bucketId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7083,8 +8590,8 @@ func (ec *executionContext) fieldContext_DeviceInfo_hostname(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_os(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_os(ctx, field)
+func (ec *executionContext) _Audio_albumFileId(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_albumFileId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7097,7 +8604,7 @@ func (ec *executionContext) _DeviceInfo_os(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Os, nil
+		return obj.AlbumFileID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7114,9 +8621,9 @@ func (ec *executionContext) _DeviceInfo_os(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_os(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_albumFileId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7127,8 +8634,8 @@ func (ec *executionContext) fieldContext_DeviceInfo_os(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_kernelVersion(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_kernelVersion(ctx, field)
+func (ec *executionContext) _Audio_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7141,7 +8648,7 @@ func (ec *executionContext) _DeviceInfo_kernelVersion(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.KernelVersion, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7153,26 +8660,26 @@ func (ec *executionContext) _DeviceInfo_kernelVersion(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_kernelVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_appVersion(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_appVersion(ctx, field)
+func (ec *executionContext) _Audio_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7185,7 +8692,7 @@ func (ec *executionContext) _DeviceInfo_appVersion(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AppVersion, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7197,26 +8704,26 @@ func (ec *executionContext) _DeviceInfo_appVersion(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_appVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_appFullVersion(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_appFullVersion(ctx, field)
+func (ec *executionContext) _Audio_tags(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_tags(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7229,7 +8736,7 @@ func (ec *executionContext) _DeviceInfo_appFullVersion(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AppFullVersion, nil
+		return obj.Tags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7241,26 +8748,36 @@ func (ec *executionContext) _DeviceInfo_appFullVersion(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Tag)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTag2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_appFullVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Tag_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Tag_name(ctx, field)
+			case "type":
+				return ec.fieldContext_Tag_type(ctx, field)
+			case "count":
+				return ec.fieldContext_Tag_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tag", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_arch(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_arch(ctx, field)
+func (ec *executionContext) _Audio_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7273,7 +8790,7 @@ func (ec *executionContext) _DeviceInfo_arch(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Arch, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7290,9 +8807,9 @@ func (ec *executionContext) _DeviceInfo_arch(ctx context.Context, field graphql.
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_arch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7303,8 +8820,8 @@ func (ec *executionContext) fieldContext_DeviceInfo_arch(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_uptime(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_uptime(ctx, field)
+func (ec *executionContext) _Audio_online(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_online(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7317,7 +8834,7 @@ func (ec *executionContext) _DeviceInfo_uptime(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Uptime, nil
+		return obj.Online, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7329,26 +8846,26 @@ func (ec *executionContext) _DeviceInfo_uptime(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_uptime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_bootTime(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_bootTime(ctx, field)
+func (ec *executionContext) _Audio_volume(ctx context.Context, field graphql.CollectedField, obj *model.Audio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Audio_volume(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7361,38 +8878,43 @@ func (ec *executionContext) _DeviceInfo_bootTime(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BootTime, nil
+		return obj.Volume, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(*model.VolumeRef)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalOVolumeRef2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐVolumeRef(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_bootTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Audio_volume(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "Audio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VolumeRef_id(ctx, field)
+			case "uuid":
+				return ec.fieldContext_VolumeRef_uuid(ctx, field)
+			case "name":
+				return ec.fieldContext_VolumeRef_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VolumeRef", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_cpuModel(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_cpuModel(ctx, field)
+func (ec *executionContext) _AudioFileInfo_duration(ctx context.Context, field graphql.CollectedField, obj *model.AudioFileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AudioFileInfo_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7405,38 +8927,35 @@ func (ec *executionContext) _DeviceInfo_cpuModel(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CPUModel, nil
+		return obj.Duration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_cpuModel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AudioFileInfo_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "AudioFileInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_cpuCores(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_cpuCores(ctx, field)
+func (ec *executionContext) _AudioFileInfo_location(ctx context.Context, field graphql.CollectedField, obj *model.AudioFileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AudioFileInfo_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7449,38 +8968,41 @@ func (ec *executionContext) _DeviceInfo_cpuCores(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CPUCores, nil
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.GeoLocation)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOGeoLocation2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐGeoLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_cpuCores(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AudioFileInfo_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "AudioFileInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_GeoLocation_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_GeoLocation_longitude(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GeoLocation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_cpuThreads(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_cpuThreads(ctx, field)
+func (ec *executionContext) _BackupResult_path(ctx context.Context, field graphql.CollectedField, obj *model.BackupResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BackupResult_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7493,7 +9015,7 @@ func (ec *executionContext) _DeviceInfo_cpuThreads(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CPUThreads, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7505,26 +9027,26 @@ func (ec *executionContext) _DeviceInfo_cpuThreads(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_cpuThreads(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BackupResult_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "BackupResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_load1(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_load1(ctx, field)
+func (ec *executionContext) _BackupResult_keys(ctx context.Context, field graphql.CollectedField, obj *model.BackupResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BackupResult_keys(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7537,7 +9059,7 @@ func (ec *executionContext) _DeviceInfo_load1(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Load1, nil
+		return obj.Keys, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7549,26 +9071,26 @@ func (ec *executionContext) _DeviceInfo_load1(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_load1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BackupResult_keys(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "BackupResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_load5(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_load5(ctx, field)
+func (ec *executionContext) _BackupResult_size(ctx context.Context, field graphql.CollectedField, obj *model.BackupResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BackupResult_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7581,7 +9103,7 @@ func (ec *executionContext) _DeviceInfo_load5(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Load5, nil
+		return obj.Size, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7593,26 +9115,26 @@ func (ec *executionContext) _DeviceInfo_load5(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_load5(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BackupResult_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "BackupResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_load15(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_load15(ctx, field)
+func (ec *executionContext) _BackupResult_schemaVersion(ctx context.Context, field graphql.CollectedField, obj *model.BackupResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BackupResult_schemaVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7625,7 +9147,7 @@ func (ec *executionContext) _DeviceInfo_load15(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Load15, nil
+		return obj.SchemaVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7637,26 +9159,26 @@ func (ec *executionContext) _DeviceInfo_load15(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_load15(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BackupResult_schemaVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DeviceInfo",
+		Object:     "BackupResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_memoryTotalBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_memoryTotalBytes(ctx, field)
+func (ec *executionContext) _DeviceInfo_hostname(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_hostname(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7669,7 +9191,7 @@ func (ec *executionContext) _DeviceInfo_memoryTotalBytes(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MemoryTotalBytes, nil
+		return obj.Hostname, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7681,26 +9203,26 @@ func (ec *executionContext) _DeviceInfo_memoryTotalBytes(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_memoryTotalBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_hostname(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_memoryFreeBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_memoryFreeBytes(ctx, field)
+func (ec *executionContext) _DeviceInfo_os(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_os(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7713,7 +9235,7 @@ func (ec *executionContext) _DeviceInfo_memoryFreeBytes(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MemoryFreeBytes, nil
+		return obj.Os, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7725,26 +9247,26 @@ func (ec *executionContext) _DeviceInfo_memoryFreeBytes(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_memoryFreeBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_os(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_swapTotalBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_swapTotalBytes(ctx, field)
+func (ec *executionContext) _DeviceInfo_kernelVersion(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_kernelVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7757,7 +9279,7 @@ func (ec *executionContext) _DeviceInfo_swapTotalBytes(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SwapTotalBytes, nil
+		return obj.KernelVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7769,26 +9291,26 @@ func (ec *executionContext) _DeviceInfo_swapTotalBytes(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_swapTotalBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_kernelVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_swapFreeBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_swapFreeBytes(ctx, field)
+func (ec *executionContext) _DeviceInfo_appVersion(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_appVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7801,7 +9323,7 @@ func (ec *executionContext) _DeviceInfo_swapFreeBytes(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SwapFreeBytes, nil
+		return obj.AppVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7813,26 +9335,26 @@ func (ec *executionContext) _DeviceInfo_swapFreeBytes(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_swapFreeBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_appVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_swapUsedBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_swapUsedBytes(ctx, field)
+func (ec *executionContext) _DeviceInfo_appFullVersion(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_appFullVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7845,7 +9367,7 @@ func (ec *executionContext) _DeviceInfo_swapUsedBytes(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SwapUsedBytes, nil
+		return obj.AppFullVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7857,26 +9379,26 @@ func (ec *executionContext) _DeviceInfo_swapUsedBytes(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_swapUsedBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_appFullVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_ips(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_ips(ctx, field)
+func (ec *executionContext) _DeviceInfo_arch(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_arch(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7889,7 +9411,7 @@ func (ec *executionContext) _DeviceInfo_ips(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ips, nil
+		return obj.Arch, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7901,12 +9423,12 @@ func (ec *executionContext) _DeviceInfo_ips(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_ips(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_arch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
@@ -7919,8 +9441,8 @@ func (ec *executionContext) fieldContext_DeviceInfo_ips(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_nics(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_nics(ctx, field)
+func (ec *executionContext) _DeviceInfo_uptime(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_uptime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7933,7 +9455,7 @@ func (ec *executionContext) _DeviceInfo_nics(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nics, nil
+		return obj.Uptime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7945,34 +9467,26 @@ func (ec *executionContext) _DeviceInfo_nics(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.NicInfo)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNNicInfo2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐNicInfoᚄ(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_nics(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_uptime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_NicInfo_name(ctx, field)
-			case "mac":
-				return ec.fieldContext_NicInfo_mac(ctx, field)
-			case "speedRate":
-				return ec.fieldContext_NicInfo_speedRate(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type NicInfo", field.Name)
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DeviceInfo_model(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DeviceInfo_model(ctx, field)
+func (ec *executionContext) _DeviceInfo_bootTime(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_bootTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7985,7 +9499,7 @@ func (ec *executionContext) _DeviceInfo_model(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Model, nil
+		return obj.BootTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7997,26 +9511,26 @@ func (ec *executionContext) _DeviceInfo_model(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DeviceInfo_model(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_bootTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DlnaRenderer_udn(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DlnaRenderer_udn(ctx, field)
+func (ec *executionContext) _DeviceInfo_cpuModel(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_cpuModel(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8029,7 +9543,7 @@ func (ec *executionContext) _DlnaRenderer_udn(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Udn, nil
+		return obj.CPUModel, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8046,9 +9560,9 @@ func (ec *executionContext) _DlnaRenderer_udn(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DlnaRenderer_udn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_cpuModel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DlnaRenderer",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8059,8 +9573,8 @@ func (ec *executionContext) fieldContext_DlnaRenderer_udn(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _DlnaRenderer_name(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DlnaRenderer_name(ctx, field)
+func (ec *executionContext) _DeviceInfo_cpuCores(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_cpuCores(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8073,7 +9587,7 @@ func (ec *executionContext) _DlnaRenderer_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.CPUCores, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8085,26 +9599,26 @@ func (ec *executionContext) _DlnaRenderer_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DlnaRenderer_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_cpuCores(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DlnaRenderer",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DlnaRenderer_manufacturer(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DlnaRenderer_manufacturer(ctx, field)
+func (ec *executionContext) _DeviceInfo_cpuThreads(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_cpuThreads(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8117,35 +9631,38 @@ func (ec *executionContext) _DlnaRenderer_manufacturer(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Manufacturer, nil
+		return obj.CPUThreads, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DlnaRenderer_manufacturer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_cpuThreads(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DlnaRenderer",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DlnaRenderer_modelName(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DlnaRenderer_modelName(ctx, field)
+func (ec *executionContext) _DeviceInfo_load1(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_load1(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8158,35 +9675,38 @@ func (ec *executionContext) _DlnaRenderer_modelName(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ModelName, nil
+		return obj.Load1, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DlnaRenderer_modelName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_load1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DlnaRenderer",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DlnaRenderer_location(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DlnaRenderer_location(ctx, field)
+func (ec *executionContext) _DeviceInfo_load5(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_load5(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8199,7 +9719,7 @@ func (ec *executionContext) _DlnaRenderer_location(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.Load5, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8211,26 +9731,26 @@ func (ec *executionContext) _DlnaRenderer_location(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DlnaRenderer_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_load5(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DlnaRenderer",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_id(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_id(ctx, field)
+func (ec *executionContext) _DeviceInfo_load15(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_load15(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8243,7 +9763,7 @@ func (ec *executionContext) _Event_id(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Load15, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8255,26 +9775,26 @@ func (ec *executionContext) _Event_id(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_load15(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_type(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_type(ctx, field)
+func (ec *executionContext) _DeviceInfo_memoryTotalBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_memoryTotalBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8287,7 +9807,7 @@ func (ec *executionContext) _Event_type(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.MemoryTotalBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8299,26 +9819,26 @@ func (ec *executionContext) _Event_type(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_memoryTotalBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_message(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_message(ctx, field)
+func (ec *executionContext) _DeviceInfo_memoryFreeBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_memoryFreeBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8331,7 +9851,7 @@ func (ec *executionContext) _Event_message(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.MemoryFreeBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8343,26 +9863,26 @@ func (ec *executionContext) _Event_message(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_memoryFreeBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_clientId(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_clientId(ctx, field)
+func (ec *executionContext) _DeviceInfo_swapTotalBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_swapTotalBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8375,7 +9895,7 @@ func (ec *executionContext) _Event_clientId(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClientID, nil
+		return obj.SwapTotalBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8387,26 +9907,26 @@ func (ec *executionContext) _Event_clientId(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_clientId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_swapTotalBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_createdAt(ctx, field)
+func (ec *executionContext) _DeviceInfo_swapFreeBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_swapFreeBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8419,7 +9939,7 @@ func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.SwapFreeBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8431,26 +9951,26 @@ func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_swapFreeBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FavoriteFolder_rootPath(ctx context.Context, field graphql.CollectedField, obj *model.FavoriteFolder) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FavoriteFolder_rootPath(ctx, field)
+func (ec *executionContext) _DeviceInfo_swapUsedBytes(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_swapUsedBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8463,7 +9983,7 @@ func (ec *executionContext) _FavoriteFolder_rootPath(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RootPath, nil
+		return obj.SwapUsedBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8475,26 +9995,26 @@ func (ec *executionContext) _FavoriteFolder_rootPath(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FavoriteFolder_rootPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_swapUsedBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FavoriteFolder",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FavoriteFolder_relativePath(ctx context.Context, field graphql.CollectedField, obj *model.FavoriteFolder) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FavoriteFolder_relativePath(ctx, field)
+func (ec *executionContext) _DeviceInfo_ips(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_ips(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8507,7 +10027,7 @@ func (ec *executionContext) _FavoriteFolder_relativePath(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RelativePath, nil
+		return obj.Ips, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8519,14 +10039,14 @@ func (ec *executionContext) _FavoriteFolder_relativePath(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FavoriteFolder_relativePath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_ips(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FavoriteFolder",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8537,8 +10057,8 @@ func (ec *executionContext) fieldContext_FavoriteFolder_relativePath(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _FavoriteFolder_alias(ctx context.Context, field graphql.CollectedField, obj *model.FavoriteFolder) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FavoriteFolder_alias(ctx, field)
+func (ec *executionContext) _DeviceInfo_nics(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_nics(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8551,35 +10071,46 @@ func (ec *executionContext) _FavoriteFolder_alias(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Alias, nil
+		return obj.Nics, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.NicInfo)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNNicInfo2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐNicInfoᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FavoriteFolder_alias(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_nics(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FavoriteFolder",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_NicInfo_name(ctx, field)
+			case "mac":
+				return ec.fieldContext_NicInfo_mac(ctx, field)
+			case "speedRate":
+				return ec.fieldContext_NicInfo_speedRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NicInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _File_path(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_path(ctx, field)
+func (ec *executionContext) _DeviceInfo_model(ctx context.Context, field graphql.CollectedField, obj *model.DeviceInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DeviceInfo_model(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8592,7 +10123,7 @@ func (ec *executionContext) _File_path(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.Model, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8609,9 +10140,9 @@ func (ec *executionContext) _File_path(ctx context.Context, field graphql.Collec
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeviceInfo_model(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DeviceInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8622,8 +10153,8 @@ func (ec *executionContext) fieldContext_File_path(_ context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _File_isDir(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_isDir(ctx, field)
+func (ec *executionContext) _DlnaRenderer_udn(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DlnaRenderer_udn(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8636,7 +10167,7 @@ func (ec *executionContext) _File_isDir(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDir, nil
+		return obj.Udn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8648,26 +10179,26 @@ func (ec *executionContext) _File_isDir(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_isDir(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DlnaRenderer_udn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DlnaRenderer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _File_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_createdAt(ctx, field)
+func (ec *executionContext) _DlnaRenderer_name(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DlnaRenderer_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8680,7 +10211,7 @@ func (ec *executionContext) _File_createdAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8692,26 +10223,26 @@ func (ec *executionContext) _File_createdAt(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DlnaRenderer_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DlnaRenderer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _File_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_updatedAt(ctx, field)
+func (ec *executionContext) _DlnaRenderer_manufacturer(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DlnaRenderer_manufacturer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8724,38 +10255,35 @@ func (ec *executionContext) _File_updatedAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Manufacturer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DlnaRenderer_manufacturer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DlnaRenderer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _File_size(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_size(ctx, field)
+func (ec *executionContext) _DlnaRenderer_modelName(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DlnaRenderer_modelName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8768,38 +10296,35 @@ func (ec *executionContext) _File_size(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Size, nil
+		return obj.ModelName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DlnaRenderer_modelName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DlnaRenderer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _File_childCount(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_File_childCount(ctx, field)
+func (ec *executionContext) _DlnaRenderer_location(ctx context.Context, field graphql.CollectedField, obj *model.DlnaRenderer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DlnaRenderer_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8812,7 +10337,7 @@ func (ec *executionContext) _File_childCount(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChildCount, nil
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8824,26 +10349,26 @@ func (ec *executionContext) _File_childCount(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_File_childCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DlnaRenderer_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "File",
+		Object:     "DlnaRenderer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileInfo_path(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileInfo_path(ctx, field)
+func (ec *executionContext) _Event_id(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8856,7 +10381,7 @@ func (ec *executionContext) _FileInfo_path(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8870,24 +10395,24 @@ func (ec *executionContext) _FileInfo_path(ctx context.Context, field graphql.Co
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileInfo_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileInfo",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileInfo_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileInfo_updatedAt(ctx, field)
+func (ec *executionContext) _Event_type(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8900,7 +10425,7 @@ func (ec *executionContext) _FileInfo_updatedAt(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8912,26 +10437,26 @@ func (ec *executionContext) _FileInfo_updatedAt(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileInfo_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileInfo",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileInfo_size(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileInfo_size(ctx, field)
+func (ec *executionContext) _Event_message(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8944,7 +10469,7 @@ func (ec *executionContext) _FileInfo_size(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Size, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8956,26 +10481,26 @@ func (ec *executionContext) _FileInfo_size(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLong2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileInfo_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileInfo",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Long does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileInfo_tags(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileInfo_tags(ctx, field)
+func (ec *executionContext) _Event_clientId(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_clientId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8988,7 +10513,7 @@ func (ec *executionContext) _FileInfo_tags(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tags, nil
+		return obj.ClientID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9000,36 +10525,26 @@ func (ec *executionContext) _FileInfo_tags(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Tag)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTag2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTagᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileInfo_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_clientId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileInfo",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Tag_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Tag_name(ctx, field)
-			case "type":
-				return ec.fieldContext_Tag_type(ctx, field)
-			case "count":
-				return ec.fieldContext_Tag_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Tag", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileInfo_data(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileInfo_data(ctx, field)
+func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9042,35 +10557,38 @@ func (ec *executionContext) _FileInfo_data(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(model.FileInfoData)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOFileInfoData2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileInfoData(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileInfo_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileInfo",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type FileInfoData does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_id(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_id(ctx, field)
+func (ec *executionContext) _FavoriteFolder_rootPath(ctx context.Context, field graphql.CollectedField, obj *model.FavoriteFolder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FavoriteFolder_rootPath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9083,7 +10601,7 @@ func (ec *executionContext) _FileTask_id(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.RootPath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9097,24 +10615,24 @@ func (ec *executionContext) _FileTask_id(ctx context.Context, field graphql.Coll
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FavoriteFolder_rootPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "FavoriteFolder",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_type(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_type(ctx, field)
+func (ec *executionContext) _FavoriteFolder_relativePath(ctx context.Context, field graphql.CollectedField, obj *model.FavoriteFolder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FavoriteFolder_relativePath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9127,7 +10645,7 @@ func (ec *executionContext) _FileTask_type(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.RelativePath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9139,26 +10657,26 @@ func (ec *executionContext) _FileTask_type(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.FileTaskType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFileTaskType2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FavoriteFolder_relativePath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "FavoriteFolder",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type FileTaskType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_title(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_title(ctx, field)
+func (ec *executionContext) _FavoriteFolder_alias(ctx context.Context, field graphql.CollectedField, obj *model.FavoriteFolder) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FavoriteFolder_alias(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9171,26 +10689,23 @@ func (ec *executionContext) _FileTask_title(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Alias, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FavoriteFolder_alias(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "FavoriteFolder",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9201,8 +10716,8 @@ func (ec *executionContext) fieldContext_FileTask_title(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_status(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_status(ctx, field)
+func (ec *executionContext) _File_path(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9215,7 +10730,7 @@ func (ec *executionContext) _FileTask_status(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9227,26 +10742,26 @@ func (ec *executionContext) _FileTask_status(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.FileTaskStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFileTaskStatus2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type FileTaskStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_error(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_error(ctx, field)
+func (ec *executionContext) _File_isDir(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_isDir(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9259,7 +10774,7 @@ func (ec *executionContext) _FileTask_error(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		return obj.IsDir, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9271,26 +10786,26 @@ func (ec *executionContext) _FileTask_error(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_isDir(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_totalBytes(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_totalBytes(ctx, field)
+func (ec *executionContext) _File_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9303,7 +10818,7 @@ func (ec *executionContext) _FileTask_totalBytes(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalBytes, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9315,26 +10830,26 @@ func (ec *executionContext) _FileTask_totalBytes(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_totalBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_doneBytes(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_doneBytes(ctx, field)
+func (ec *executionContext) _File_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9347,7 +10862,7 @@ func (ec *executionContext) _FileTask_doneBytes(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DoneBytes, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9359,26 +10874,26 @@ func (ec *executionContext) _FileTask_doneBytes(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_doneBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_totalItems(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_totalItems(ctx, field)
+func (ec *executionContext) _File_size(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9391,7 +10906,7 @@ func (ec *executionContext) _FileTask_totalItems(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalItems, nil
+		return obj.Size, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9403,26 +10918,26 @@ func (ec *executionContext) _FileTask_totalItems(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_totalItems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Long does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_doneItems(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_doneItems(ctx, field)
+func (ec *executionContext) _File_childCount(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_childCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9435,7 +10950,7 @@ func (ec *executionContext) _FileTask_doneItems(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DoneItems, nil
+		return obj.ChildCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9452,9 +10967,9 @@ func (ec *executionContext) _FileTask_doneItems(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_doneItems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_childCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9465,8 +10980,8 @@ func (ec *executionContext) fieldContext_FileTask_doneItems(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_createdAt(ctx, field)
+func (ec *executionContext) _File_snippet(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_snippet(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9479,38 +10994,35 @@ func (ec *executionContext) _FileTask_createdAt(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Snippet, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_snippet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileTask_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FileTask_updatedAt(ctx, field)
+func (ec *executionContext) _File_nameMatches(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_nameMatches(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9523,38 +11035,41 @@ func (ec *executionContext) _FileTask_updatedAt(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.NameMatches, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.TextRange)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOTextRange2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTextRangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FileTask_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_nameMatches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileTask",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "start":
+				return ec.fieldContext_TextRange_start(ctx, field)
+			case "length":
+				return ec.fieldContext_TextRange_length(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TextRange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeoLocation_latitude(ctx context.Context, field graphql.CollectedField, obj *model.GeoLocation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeoLocation_latitude(ctx, field)
+func (ec *executionContext) _File_snippetMatches(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_snippetMatches(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9567,7 +11082,7 @@ func (ec *executionContext) _GeoLocation_latitude(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Latitude, nil
+		return obj.SnippetMatches, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9576,26 +11091,32 @@ func (ec *executionContext) _GeoLocation_latitude(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.([]*model.TextRange)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOTextRange2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTextRangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeoLocation_latitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_snippetMatches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeoLocation",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "start":
+				return ec.fieldContext_TextRange_start(ctx, field)
+			case "length":
+				return ec.fieldContext_TextRange_length(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TextRange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GeoLocation_longitude(ctx context.Context, field graphql.CollectedField, obj *model.GeoLocation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GeoLocation_longitude(ctx, field)
+func (ec *executionContext) _File_online(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_online(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9608,35 +11129,38 @@ func (ec *executionContext) _GeoLocation_longitude(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Longitude, nil
+		return obj.Online, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GeoLocation_longitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GeoLocation",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_id(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_id(ctx, field)
+func (ec *executionContext) _File_volume(ctx context.Context, field graphql.CollectedField, obj *model.File) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_File_volume(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9649,38 +11173,43 @@ func (ec *executionContext) _Image_id(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Volume, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.VolumeRef)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOVolumeRef2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐVolumeRef(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_File_volume(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "File",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VolumeRef_id(ctx, field)
+			case "uuid":
+				return ec.fieldContext_VolumeRef_uuid(ctx, field)
+			case "name":
+				return ec.fieldContext_VolumeRef_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VolumeRef", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_title(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_title(ctx, field)
+func (ec *executionContext) _FileInfo_path(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileInfo_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9693,7 +11222,7 @@ func (ec *executionContext) _Image_title(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9710,9 +11239,9 @@ func (ec *executionContext) _Image_title(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileInfo_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "FileInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9723,8 +11252,8 @@ func (ec *executionContext) fieldContext_Image_title(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_path(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_path(ctx, field)
+func (ec *executionContext) _FileInfo_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileInfo_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9737,7 +11266,7 @@ func (ec *executionContext) _Image_path(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9749,26 +11278,26 @@ func (ec *executionContext) _Image_path(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileInfo_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "FileInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_size(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_size(ctx, field)
+func (ec *executionContext) _FileInfo_size(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileInfo_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9798,9 +11327,9 @@ func (ec *executionContext) _Image_size(ctx context.Context, field graphql.Colle
 	return ec.marshalNLong2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileInfo_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "FileInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9811,8 +11340,8 @@ func (ec *executionContext) fieldContext_Image_size(_ context.Context, field gra
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_bucketId(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_bucketId(ctx, field)
+func (ec *executionContext) _FileInfo_tags(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileInfo_tags(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9825,7 +11354,7 @@ func (ec *executionContext) _Image_bucketId(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BucketID, nil
+		return obj.Tags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9837,26 +11366,36 @@ func (ec *executionContext) _Image_bucketId(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Tag)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTag2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_bucketId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileInfo_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "FileInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Tag_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Tag_name(ctx, field)
+			case "type":
+				return ec.fieldContext_Tag_type(ctx, field)
+			case "count":
+				return ec.fieldContext_Tag_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tag", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_createdAt(ctx, field)
+func (ec *executionContext) _FileInfo_data(ctx context.Context, field graphql.CollectedField, obj *model.FileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileInfo_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9869,38 +11408,35 @@ func (ec *executionContext) _Image_createdAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(model.FileInfoData)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOFileInfoData2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileInfoData(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileInfo_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "FileInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type FileInfoData does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_updatedAt(ctx, field)
+func (ec *executionContext) _FileTask_id(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9913,7 +11449,7 @@ func (ec *executionContext) _Image_updatedAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9925,26 +11461,26 @@ func (ec *executionContext) _Image_updatedAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileTask_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "FileTask",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_tags(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Image_tags(ctx, field)
+func (ec *executionContext) _FileTask_type(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9957,7 +11493,7 @@ func (ec *executionContext) _Image_tags(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tags, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9969,36 +11505,26 @@ func (ec *executionContext) _Image_tags(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Tag)
+	res := resTmp.(model.FileTaskType)
 	fc.Result = res
-	return ec.marshalNTag2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTagᚄ(ctx, field.Selections, res)
+	return ec.marshalNFileTaskType2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Image_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileTask_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Image",
+		Object:     "FileTask",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Tag_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Tag_name(ctx, field)
-			case "type":
-				return ec.fieldContext_Tag_type(ctx, field)
-			case "count":
-				return ec.fieldContext_Tag_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Tag", field.Name)
+			return nil, errors.New("field of type FileTaskType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageFileInfo_width(ctx context.Context, field graphql.CollectedField, obj *model.ImageFileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageFileInfo_width(ctx, field)
+func (ec *executionContext) _FileTask_title(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10011,35 +11537,38 @@ func (ec *executionContext) _ImageFileInfo_width(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Width, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageFileInfo_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileTask_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageFileInfo",
+		Object:     "FileTask",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageFileInfo_height(ctx context.Context, field graphql.CollectedField, obj *model.ImageFileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageFileInfo_height(ctx, field)
+func (ec *executionContext) _FileTask_status(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10052,35 +11581,38 @@ func (ec *executionContext) _ImageFileInfo_height(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(model.FileTaskStatus)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNFileTaskStatus2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTaskStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageFileInfo_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileTask_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageFileInfo",
+		Object:     "FileTask",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type FileTaskStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageFileInfo_location(ctx context.Context, field graphql.CollectedField, obj *model.ImageFileInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ImageFileInfo_location(ctx, field)
+func (ec *executionContext) _FileTask_error(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10093,41 +11625,38 @@ func (ec *executionContext) _ImageFileInfo_location(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.GeoLocation)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOGeoLocation2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐGeoLocation(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ImageFileInfo_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileTask_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageFileInfo",
+		Object:     "FileTask",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "latitude":
-				return ec.fieldContext_GeoLocation_latitude(ctx, field)
-			case "longitude":
-				return ec.fieldContext_GeoLocation_longitude(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GeoLocation", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MediaActionResult_type(ctx context.Context, field graphql.CollectedField, obj *model.MediaActionResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MediaActionResult_type(ctx, field)
+func (ec *executionContext) _FileTask_totalBytes(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_totalBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10140,7 +11669,7 @@ func (ec *executionContext) _MediaActionResult_type(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.TotalBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10152,26 +11681,26 @@ func (ec *executionContext) _MediaActionResult_type(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.DataType)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNDataType2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐDataType(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MediaActionResult_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileTask_totalBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MediaActionResult",
+		Object:     "FileTask",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DataType does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MediaActionResult_query(ctx context.Context, field graphql.CollectedField, obj *model.MediaActionResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MediaActionResult_query(ctx, field)
+func (ec *executionContext) _FileTask_doneBytes(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_doneBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10184,7 +11713,7 @@ func (ec *executionContext) _MediaActionResult_query(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Query, nil
+		return obj.DoneBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10196,26 +11725,26 @@ func (ec *executionContext) _MediaActionResult_query(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MediaActionResult_query(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileTask_doneBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MediaActionResult",
+		Object:     "FileTask",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MediaBucket_id(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MediaBucket_id(ctx, field)
+func (ec *executionContext) _FileTask_totalItems(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_totalItems(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10228,7 +11757,183 @@ func (ec *executionContext) _MediaBucket_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.TotalItems, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FileTask_totalItems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileTask_doneItems(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_doneItems(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DoneItems, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FileTask_doneItems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileTask_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FileTask_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileTask_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.FileTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FileTask_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FileTask_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckCount_check(ctx context.Context, field graphql.CollectedField, obj *model.FsckCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckCount_check(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Check, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10242,24 +11947,24 @@ func (ec *executionContext) _MediaBucket_id(ctx context.Context, field graphql.C
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MediaBucket_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FsckCount_check(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MediaBucket",
+		Object:     "FsckCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MediaBucket_name(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MediaBucket_name(ctx, field)
+func (ec *executionContext) _FsckCount_problem(ctx context.Context, field graphql.CollectedField, obj *model.FsckCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckCount_problem(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10272,7 +11977,7 @@ func (ec *executionContext) _MediaBucket_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Problem, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10289,9 +11994,9 @@ func (ec *executionContext) _MediaBucket_name(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MediaBucket_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FsckCount_problem(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MediaBucket",
+		Object:     "FsckCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10302,8 +12007,8 @@ func (ec *executionContext) fieldContext_MediaBucket_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _MediaBucket_itemCount(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MediaBucket_itemCount(ctx, field)
+func (ec *executionContext) _FsckCount_count(ctx context.Context, field graphql.CollectedField, obj *model.FsckCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckCount_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10316,7 +12021,7 @@ func (ec *executionContext) _MediaBucket_itemCount(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ItemCount, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10333,9 +12038,9 @@ func (ec *executionContext) _MediaBucket_itemCount(ctx context.Context, field gr
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MediaBucket_itemCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FsckCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MediaBucket",
+		Object:     "FsckCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10346,8 +12051,8 @@ func (ec *executionContext) fieldContext_MediaBucket_itemCount(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _MediaBucket_topItems(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MediaBucket_topItems(ctx, field)
+func (ec *executionContext) _FsckCount_repaired(ctx context.Context, field graphql.CollectedField, obj *model.FsckCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckCount_repaired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10360,7 +12065,7 @@ func (ec *executionContext) _MediaBucket_topItems(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TopItems, nil
+		return obj.Repaired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10372,26 +12077,2268 @@ func (ec *executionContext) _MediaBucket_topItems(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MediaBucket_topItems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FsckCount_repaired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MediaBucket",
+		Object:     "FsckCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setDeviceName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setDeviceName(ctx, field)
+func (ec *executionContext) _FsckIssue_check(ctx context.Context, field graphql.CollectedField, obj *model.FsckIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckIssue_check(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Check, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckIssue_check(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckIssue_key(ctx context.Context, field graphql.CollectedField, obj *model.FsckIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckIssue_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckIssue_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckIssue_problem(ctx context.Context, field graphql.CollectedField, obj *model.FsckIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckIssue_problem(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Problem, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckIssue_problem(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckIssue_repaired(ctx context.Context, field graphql.CollectedField, obj *model.FsckIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckIssue_repaired(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repaired, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckIssue_repaired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckReport_apply(ctx context.Context, field graphql.CollectedField, obj *model.FsckReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckReport_apply(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Apply, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckReport_apply(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckReport_entries(ctx context.Context, field graphql.CollectedField, obj *model.FsckReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckReport_entries(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Entries, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckReport_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckReport_issues(ctx context.Context, field graphql.CollectedField, obj *model.FsckReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckReport_issues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Issues, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.FsckIssue)
+	fc.Result = res
+	return ec.marshalNFsckIssue2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckIssueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckReport_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "check":
+				return ec.fieldContext_FsckIssue_check(ctx, field)
+			case "key":
+				return ec.fieldContext_FsckIssue_key(ctx, field)
+			case "problem":
+				return ec.fieldContext_FsckIssue_problem(ctx, field)
+			case "repaired":
+				return ec.fieldContext_FsckIssue_repaired(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FsckIssue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckReport_counts(ctx context.Context, field graphql.CollectedField, obj *model.FsckReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckReport_counts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Counts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.FsckCount)
+	fc.Result = res
+	return ec.marshalNFsckCount2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckCountᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckReport_counts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "check":
+				return ec.fieldContext_FsckCount_check(ctx, field)
+			case "problem":
+				return ec.fieldContext_FsckCount_problem(ctx, field)
+			case "count":
+				return ec.fieldContext_FsckCount_count(ctx, field)
+			case "repaired":
+				return ec.fieldContext_FsckCount_repaired(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FsckCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FsckReport_truncated(ctx context.Context, field graphql.CollectedField, obj *model.FsckReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FsckReport_truncated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Truncated, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FsckReport_truncated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FsckReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeoLocation_latitude(ctx context.Context, field graphql.CollectedField, obj *model.GeoLocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeoLocation_latitude(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Latitude, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeoLocation_latitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeoLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeoLocation_longitude(ctx context.Context, field graphql.CollectedField, obj *model.GeoLocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeoLocation_longitude(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Longitude, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeoLocation_longitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeoLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_id(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_title(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_title(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_path(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_size(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_size(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Long does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_bucketId(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_bucketId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BucketID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_bucketId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_tags(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_tags(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tags, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Tag)
+	fc.Result = res
+	return ec.marshalNTag2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTagᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Tag_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Tag_name(ctx, field)
+			case "type":
+				return ec.fieldContext_Tag_type(ctx, field)
+			case "count":
+				return ec.fieldContext_Tag_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Tag", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_online(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_online(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Online, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Image_volume(ctx context.Context, field graphql.CollectedField, obj *model.Image) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Image_volume(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Volume, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.VolumeRef)
+	fc.Result = res
+	return ec.marshalOVolumeRef2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐVolumeRef(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Image_volume(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Image",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VolumeRef_id(ctx, field)
+			case "uuid":
+				return ec.fieldContext_VolumeRef_uuid(ctx, field)
+			case "name":
+				return ec.fieldContext_VolumeRef_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VolumeRef", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImageFileInfo_width(ctx context.Context, field graphql.CollectedField, obj *model.ImageFileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageFileInfo_width(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Width, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ImageFileInfo_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImageFileInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImageFileInfo_height(ctx context.Context, field graphql.CollectedField, obj *model.ImageFileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageFileInfo_height(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ImageFileInfo_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImageFileInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImageFileInfo_location(ctx context.Context, field graphql.CollectedField, obj *model.ImageFileInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImageFileInfo_location(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.GeoLocation)
+	fc.Result = res
+	return ec.marshalOGeoLocation2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐGeoLocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ImageFileInfo_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImageFileInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_GeoLocation_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_GeoLocation_longitude(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GeoLocation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaActionResult_type(ctx context.Context, field graphql.CollectedField, obj *model.MediaActionResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaActionResult_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.DataType)
+	fc.Result = res
+	return ec.marshalNDataType2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐDataType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaActionResult_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaActionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DataType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaActionResult_query(ctx context.Context, field graphql.CollectedField, obj *model.MediaActionResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaActionResult_query(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Query, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaActionResult_query(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaActionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaBucket_id(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaBucket_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaBucket_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaBucket_name(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaBucket_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaBucket_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaBucket_itemCount(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaBucket_itemCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ItemCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaBucket_itemCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaBucket_topItems(ctx context.Context, field graphql.CollectedField, obj *model.MediaBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaBucket_topItems(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TopItems, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaBucket_topItems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_id(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_root(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_root(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Root, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_root(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_incremental(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_incremental(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Incremental, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_incremental(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_state(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_state(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.MediaScanJobState)
+	fc.Result = res
+	return ec.marshalNMediaScanJobState2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐMediaScanJobState(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MediaScanJobState does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_indexed(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_indexed(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Indexed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_indexed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Long does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_total(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_total(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNLong2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Long does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_errors(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_errorCount(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_errorCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ErrorCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_errorCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_startedAt(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_startedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_startedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MediaScanJob_finishedAt(ctx context.Context, field graphql.CollectedField, obj *model.MediaScanJob) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MediaScanJob_finishedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FinishedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MediaScanJob_finishedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MediaScanJob",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setDeviceName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setDeviceName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetDeviceName(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setDeviceName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setDeviceName_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setKeyValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setKeyValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetKeyValue(rctx, fc.Args["key"].(string), fc.Args["value"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setKeyValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setKeyValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteKeyValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteKeyValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteKeyValue(rctx, fc.Args["key"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteKeyValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteKeyValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_logout(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Logout(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_logout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_revokeSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_revokeSession(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeSession(rctx, fc.Args["clientId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_revokeSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_revokeSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setMediaSourceDirs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setMediaSourceDirs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetMediaSourceDirs(rctx, fc.Args["dirs"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setMediaSourceDirs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setMediaSourceDirs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setIgnorePatterns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setIgnorePatterns(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetIgnorePatterns(rctx, fc.Args["patterns"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setIgnorePatterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setIgnorePatterns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setSambaSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setSambaSettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10404,7 +14351,7 @@ func (ec *executionContext) _Mutation_setDeviceName(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetDeviceName(rctx, fc.Args["name"].(string))
+		return ec.resolvers.Mutation().SetSambaSettings(rctx, fc.Args["input"].(model.SambaSettingsInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10421,7 +14368,7 @@ func (ec *executionContext) _Mutation_setDeviceName(ctx context.Context, field g
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setDeviceName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setSambaSettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -10438,15 +14385,15 @@ func (ec *executionContext) fieldContext_Mutation_setDeviceName(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setDeviceName_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setSambaSettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setKeyValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setKeyValue(ctx, field)
+func (ec *executionContext) _Mutation_setSambaUserPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setSambaUserPassword(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10459,7 +14406,7 @@ func (ec *executionContext) _Mutation_setKeyValue(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetKeyValue(rctx, fc.Args["key"].(string), fc.Args["value"].(string))
+		return ec.resolvers.Mutation().SetSambaUserPassword(rctx, fc.Args["password"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10476,7 +14423,7 @@ func (ec *executionContext) _Mutation_setKeyValue(ctx context.Context, field gra
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setKeyValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setSambaUserPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -10493,15 +14440,15 @@ func (ec *executionContext) fieldContext_Mutation_setKeyValue(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setKeyValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setSambaUserPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteKeyValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteKeyValue(ctx, field)
+func (ec *executionContext) _Mutation_addFavoriteFolder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_addFavoriteFolder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10514,7 +14461,7 @@ func (ec *executionContext) _Mutation_deleteKeyValue(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteKeyValue(rctx, fc.Args["key"].(string))
+		return ec.resolvers.Mutation().AddFavoriteFolder(rctx, fc.Args["rootPath"].(string), fc.Args["relativePath"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10526,19 +14473,27 @@ func (ec *executionContext) _Mutation_deleteKeyValue(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.FavoriteFolder)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFavoriteFolder2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFavoriteFolder(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteKeyValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addFavoriteFolder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "rootPath":
+				return ec.fieldContext_FavoriteFolder_rootPath(ctx, field)
+			case "relativePath":
+				return ec.fieldContext_FavoriteFolder_relativePath(ctx, field)
+			case "alias":
+				return ec.fieldContext_FavoriteFolder_alias(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FavoriteFolder", field.Name)
 		},
 	}
 	defer func() {
@@ -10548,15 +14503,15 @@ func (ec *executionContext) fieldContext_Mutation_deleteKeyValue(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteKeyValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_addFavoriteFolder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_logout(ctx, field)
+func (ec *executionContext) _Mutation_removeFavoriteFolder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removeFavoriteFolder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10569,7 +14524,7 @@ func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Logout(rctx)
+		return ec.resolvers.Mutation().RemoveFavoriteFolder(rctx, fc.Args["rootPath"].(string), fc.Args["relativePath"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10581,26 +14536,45 @@ func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.FavoriteFolder)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFavoriteFolder2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFavoriteFolder(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_logout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removeFavoriteFolder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "rootPath":
+				return ec.fieldContext_FavoriteFolder_rootPath(ctx, field)
+			case "relativePath":
+				return ec.fieldContext_FavoriteFolder_relativePath(ctx, field)
+			case "alias":
+				return ec.fieldContext_FavoriteFolder_alias(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FavoriteFolder", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removeFavoriteFolder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_revokeSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_revokeSession(ctx, field)
+func (ec *executionContext) _Mutation_setFavoriteFolderAlias(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setFavoriteFolderAlias(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10613,7 +14587,7 @@ func (ec *executionContext) _Mutation_revokeSession(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RevokeSession(rctx, fc.Args["clientId"].(string))
+		return ec.resolvers.Mutation().SetFavoriteFolderAlias(rctx, fc.Args["rootPath"].(string), fc.Args["relativePath"].(string), fc.Args["alias"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10630,7 +14604,7 @@ func (ec *executionContext) _Mutation_revokeSession(ctx context.Context, field g
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_revokeSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setFavoriteFolderAlias(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -10647,15 +14621,15 @@ func (ec *executionContext) fieldContext_Mutation_revokeSession(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_revokeSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setFavoriteFolderAlias_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setMediaSourceDirs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setMediaSourceDirs(ctx, field)
+func (ec *executionContext) _Mutation_createDir(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createDir(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10668,7 +14642,7 @@ func (ec *executionContext) _Mutation_setMediaSourceDirs(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetMediaSourceDirs(rctx, fc.Args["dirs"].([]string))
+		return ec.resolvers.Mutation().CreateDir(rctx, fc.Args["path"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10680,19 +14654,43 @@ func (ec *executionContext) _Mutation_setMediaSourceDirs(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.File)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFile2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setMediaSourceDirs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createDir(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_File_path(ctx, field)
+			case "isDir":
+				return ec.fieldContext_File_isDir(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_File_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "childCount":
+				return ec.fieldContext_File_childCount(ctx, field)
+			case "snippet":
+				return ec.fieldContext_File_snippet(ctx, field)
+			case "nameMatches":
+				return ec.fieldContext_File_nameMatches(ctx, field)
+			case "snippetMatches":
+				return ec.fieldContext_File_snippetMatches(ctx, field)
+			case "online":
+				return ec.fieldContext_File_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_File_volume(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
 	defer func() {
@@ -10702,15 +14700,15 @@ func (ec *executionContext) fieldContext_Mutation_setMediaSourceDirs(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setMediaSourceDirs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createDir_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setSambaSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setSambaSettings(ctx, field)
+func (ec *executionContext) _Mutation_writeTextFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_writeTextFile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10723,7 +14721,7 @@ func (ec *executionContext) _Mutation_setSambaSettings(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetSambaSettings(rctx, fc.Args["input"].(model.SambaSettingsInput))
+		return ec.resolvers.Mutation().WriteTextFile(rctx, fc.Args["path"].(string), fc.Args["content"].(string), fc.Args["overwrite"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10735,19 +14733,43 @@ func (ec *executionContext) _Mutation_setSambaSettings(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.File)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFile2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setSambaSettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_writeTextFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_File_path(ctx, field)
+			case "isDir":
+				return ec.fieldContext_File_isDir(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_File_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "childCount":
+				return ec.fieldContext_File_childCount(ctx, field)
+			case "snippet":
+				return ec.fieldContext_File_snippet(ctx, field)
+			case "nameMatches":
+				return ec.fieldContext_File_nameMatches(ctx, field)
+			case "snippetMatches":
+				return ec.fieldContext_File_snippetMatches(ctx, field)
+			case "online":
+				return ec.fieldContext_File_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_File_volume(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
 	defer func() {
@@ -10757,15 +14779,15 @@ func (ec *executionContext) fieldContext_Mutation_setSambaSettings(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setSambaSettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_writeTextFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setSambaUserPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setSambaUserPassword(ctx, field)
+func (ec *executionContext) _Mutation_renameFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renameFile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10778,7 +14800,7 @@ func (ec *executionContext) _Mutation_setSambaUserPassword(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetSambaUserPassword(rctx, fc.Args["password"].(string))
+		return ec.resolvers.Mutation().RenameFile(rctx, fc.Args["path"].(string), fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10795,7 +14817,7 @@ func (ec *executionContext) _Mutation_setSambaUserPassword(ctx context.Context,
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setSambaUserPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_renameFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -10812,15 +14834,15 @@ func (ec *executionContext) fieldContext_Mutation_setSambaUserPassword(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setSambaUserPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_renameFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addFavoriteFolder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_addFavoriteFolder(ctx, field)
+func (ec *executionContext) _Mutation_copyFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_copyFile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10833,7 +14855,7 @@ func (ec *executionContext) _Mutation_addFavoriteFolder(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddFavoriteFolder(rctx, fc.Args["rootPath"].(string), fc.Args["relativePath"].(string))
+		return ec.resolvers.Mutation().CopyFile(rctx, fc.Args["src"].(string), fc.Args["dst"].(string), fc.Args["overwrite"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10845,27 +14867,19 @@ func (ec *executionContext) _Mutation_addFavoriteFolder(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.FavoriteFolder)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFavoriteFolder2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFavoriteFolder(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addFavoriteFolder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_copyFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "rootPath":
-				return ec.fieldContext_FavoriteFolder_rootPath(ctx, field)
-			case "relativePath":
-				return ec.fieldContext_FavoriteFolder_relativePath(ctx, field)
-			case "alias":
-				return ec.fieldContext_FavoriteFolder_alias(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FavoriteFolder", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -10875,15 +14889,15 @@ func (ec *executionContext) fieldContext_Mutation_addFavoriteFolder(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addFavoriteFolder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_copyFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_removeFavoriteFolder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_removeFavoriteFolder(ctx, field)
+func (ec *executionContext) _Mutation_moveFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_moveFile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10896,7 +14910,7 @@ func (ec *executionContext) _Mutation_removeFavoriteFolder(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RemoveFavoriteFolder(rctx, fc.Args["rootPath"].(string), fc.Args["relativePath"].(string))
+		return ec.resolvers.Mutation().MoveFile(rctx, fc.Args["src"].(string), fc.Args["dst"].(string), fc.Args["overwrite"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10908,27 +14922,19 @@ func (ec *executionContext) _Mutation_removeFavoriteFolder(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.FavoriteFolder)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFavoriteFolder2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFavoriteFolder(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_removeFavoriteFolder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_moveFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "rootPath":
-				return ec.fieldContext_FavoriteFolder_rootPath(ctx, field)
-			case "relativePath":
-				return ec.fieldContext_FavoriteFolder_relativePath(ctx, field)
-			case "alias":
-				return ec.fieldContext_FavoriteFolder_alias(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FavoriteFolder", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -10938,15 +14944,15 @@ func (ec *executionContext) fieldContext_Mutation_removeFavoriteFolder(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removeFavoriteFolder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_moveFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setFavoriteFolderAlias(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setFavoriteFolderAlias(ctx, field)
+func (ec *executionContext) _Mutation_createCopyTask(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createCopyTask(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10959,7 +14965,7 @@ func (ec *executionContext) _Mutation_setFavoriteFolderAlias(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetFavoriteFolderAlias(rctx, fc.Args["rootPath"].(string), fc.Args["relativePath"].(string), fc.Args["alias"].(string))
+		return ec.resolvers.Mutation().CreateCopyTask(rctx, fc.Args["ops"].([]*model.FileTaskOpInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10971,19 +14977,43 @@ func (ec *executionContext) _Mutation_setFavoriteFolderAlias(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.FileTask)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFileTask2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTask(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setFavoriteFolderAlias(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createCopyTask(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FileTask_id(ctx, field)
+			case "type":
+				return ec.fieldContext_FileTask_type(ctx, field)
+			case "title":
+				return ec.fieldContext_FileTask_title(ctx, field)
+			case "status":
+				return ec.fieldContext_FileTask_status(ctx, field)
+			case "error":
+				return ec.fieldContext_FileTask_error(ctx, field)
+			case "totalBytes":
+				return ec.fieldContext_FileTask_totalBytes(ctx, field)
+			case "doneBytes":
+				return ec.fieldContext_FileTask_doneBytes(ctx, field)
+			case "totalItems":
+				return ec.fieldContext_FileTask_totalItems(ctx, field)
+			case "doneItems":
+				return ec.fieldContext_FileTask_doneItems(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FileTask_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_FileTask_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FileTask", field.Name)
 		},
 	}
 	defer func() {
@@ -10993,15 +15023,15 @@ func (ec *executionContext) fieldContext_Mutation_setFavoriteFolderAlias(ctx con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setFavoriteFolderAlias_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createCopyTask_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createDir(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createDir(ctx, field)
+func (ec *executionContext) _Mutation_createMoveTask(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createMoveTask(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11014,7 +15044,7 @@ func (ec *executionContext) _Mutation_createDir(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateDir(rctx, fc.Args["path"].(string))
+		return ec.resolvers.Mutation().CreateMoveTask(rctx, fc.Args["ops"].([]*model.FileTaskOpInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11026,12 +15056,12 @@ func (ec *executionContext) _Mutation_createDir(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.File)
+	res := resTmp.(*model.FileTask)
 	fc.Result = res
-	return ec.marshalNFile2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFile(ctx, field.Selections, res)
+	return ec.marshalNFileTask2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTask(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createDir(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createMoveTask(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11039,20 +15069,30 @@ func (ec *executionContext) fieldContext_Mutation_createDir(ctx context.Context,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "path":
-				return ec.fieldContext_File_path(ctx, field)
-			case "isDir":
-				return ec.fieldContext_File_isDir(ctx, field)
+			case "id":
+				return ec.fieldContext_FileTask_id(ctx, field)
+			case "type":
+				return ec.fieldContext_FileTask_type(ctx, field)
+			case "title":
+				return ec.fieldContext_FileTask_title(ctx, field)
+			case "status":
+				return ec.fieldContext_FileTask_status(ctx, field)
+			case "error":
+				return ec.fieldContext_FileTask_error(ctx, field)
+			case "totalBytes":
+				return ec.fieldContext_FileTask_totalBytes(ctx, field)
+			case "doneBytes":
+				return ec.fieldContext_FileTask_doneBytes(ctx, field)
+			case "totalItems":
+				return ec.fieldContext_FileTask_totalItems(ctx, field)
+			case "doneItems":
+				return ec.fieldContext_FileTask_doneItems(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
+				return ec.fieldContext_FileTask_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
-			case "size":
-				return ec.fieldContext_File_size(ctx, field)
-			case "childCount":
-				return ec.fieldContext_File_childCount(ctx, field)
+				return ec.fieldContext_FileTask_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type FileTask", field.Name)
 		},
 	}
 	defer func() {
@@ -11062,15 +15102,15 @@ func (ec *executionContext) fieldContext_Mutation_createDir(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createDir_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createMoveTask_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_writeTextFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_writeTextFile(ctx, field)
+func (ec *executionContext) _Mutation_createArchive(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createArchive(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11083,7 +15123,7 @@ func (ec *executionContext) _Mutation_writeTextFile(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().WriteTextFile(rctx, fc.Args["path"].(string), fc.Args["content"].(string), fc.Args["overwrite"].(bool))
+		return ec.resolvers.Mutation().CreateArchive(rctx, fc.Args["paths"].([]string), fc.Args["dst"].(string), fc.Args["format"].(model.ArchiveFormat), fc.Args["level"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11095,12 +15135,12 @@ func (ec *executionContext) _Mutation_writeTextFile(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.File)
+	res := resTmp.(*model.FileTask)
 	fc.Result = res
-	return ec.marshalNFile2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFile(ctx, field.Selections, res)
+	return ec.marshalNFileTask2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTask(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_writeTextFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createArchive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11108,20 +15148,30 @@ func (ec *executionContext) fieldContext_Mutation_writeTextFile(ctx context.Cont
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "path":
-				return ec.fieldContext_File_path(ctx, field)
-			case "isDir":
-				return ec.fieldContext_File_isDir(ctx, field)
+			case "id":
+				return ec.fieldContext_FileTask_id(ctx, field)
+			case "type":
+				return ec.fieldContext_FileTask_type(ctx, field)
+			case "title":
+				return ec.fieldContext_FileTask_title(ctx, field)
+			case "status":
+				return ec.fieldContext_FileTask_status(ctx, field)
+			case "error":
+				return ec.fieldContext_FileTask_error(ctx, field)
+			case "totalBytes":
+				return ec.fieldContext_FileTask_totalBytes(ctx, field)
+			case "doneBytes":
+				return ec.fieldContext_FileTask_doneBytes(ctx, field)
+			case "totalItems":
+				return ec.fieldContext_FileTask_totalItems(ctx, field)
+			case "doneItems":
+				return ec.fieldContext_FileTask_doneItems(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
+				return ec.fieldContext_FileTask_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
-			case "size":
-				return ec.fieldContext_File_size(ctx, field)
-			case "childCount":
-				return ec.fieldContext_File_childCount(ctx, field)
+				return ec.fieldContext_FileTask_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type FileTask", field.Name)
 		},
 	}
 	defer func() {
@@ -11131,15 +15181,15 @@ func (ec *executionContext) fieldContext_Mutation_writeTextFile(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_writeTextFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createArchive_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_renameFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_renameFile(ctx, field)
+func (ec *executionContext) _Mutation_cancelFileTask(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_cancelFileTask(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11152,7 +15202,7 @@ func (ec *executionContext) _Mutation_renameFile(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RenameFile(rctx, fc.Args["path"].(string), fc.Args["name"].(string))
+		return ec.resolvers.Mutation().CancelFileTask(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11169,7 +15219,7 @@ func (ec *executionContext) _Mutation_renameFile(ctx context.Context, field grap
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_renameFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_cancelFileTask(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11186,15 +15236,15 @@ func (ec *executionContext) fieldContext_Mutation_renameFile(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_renameFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_cancelFileTask_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_copyFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_copyFile(ctx, field)
+func (ec *executionContext) _Mutation_deleteFiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteFiles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11207,7 +15257,7 @@ func (ec *executionContext) _Mutation_copyFile(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CopyFile(rctx, fc.Args["src"].(string), fc.Args["dst"].(string), fc.Args["overwrite"].(bool))
+		return ec.resolvers.Mutation().DeleteFiles(rctx, fc.Args["paths"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11224,7 +15274,7 @@ func (ec *executionContext) _Mutation_copyFile(ctx context.Context, field graphq
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_copyFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteFiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11241,15 +15291,15 @@ func (ec *executionContext) fieldContext_Mutation_copyFile(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_copyFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteFiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_moveFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_moveFile(ctx, field)
+func (ec *executionContext) _Mutation_trashFiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_trashFiles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11262,7 +15312,7 @@ func (ec *executionContext) _Mutation_moveFile(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().MoveFile(rctx, fc.Args["src"].(string), fc.Args["dst"].(string), fc.Args["overwrite"].(bool))
+		return ec.resolvers.Mutation().TrashFiles(rctx, fc.Args["paths"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11279,7 +15329,7 @@ func (ec *executionContext) _Mutation_moveFile(ctx context.Context, field graphq
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_moveFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_trashFiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11296,15 +15346,15 @@ func (ec *executionContext) fieldContext_Mutation_moveFile(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_moveFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_trashFiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createCopyTask(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createCopyTask(ctx, field)
+func (ec *executionContext) _Mutation_restoreFiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_restoreFiles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11317,7 +15367,7 @@ func (ec *executionContext) _Mutation_createCopyTask(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateCopyTask(rctx, fc.Args["ops"].([]*model.FileTaskOpInput))
+		return ec.resolvers.Mutation().RestoreFiles(rctx, fc.Args["paths"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11329,43 +15379,19 @@ func (ec *executionContext) _Mutation_createCopyTask(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.FileTask)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFileTask2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTask(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createCopyTask(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreFiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_FileTask_id(ctx, field)
-			case "type":
-				return ec.fieldContext_FileTask_type(ctx, field)
-			case "title":
-				return ec.fieldContext_FileTask_title(ctx, field)
-			case "status":
-				return ec.fieldContext_FileTask_status(ctx, field)
-			case "error":
-				return ec.fieldContext_FileTask_error(ctx, field)
-			case "totalBytes":
-				return ec.fieldContext_FileTask_totalBytes(ctx, field)
-			case "doneBytes":
-				return ec.fieldContext_FileTask_doneBytes(ctx, field)
-			case "totalItems":
-				return ec.fieldContext_FileTask_totalItems(ctx, field)
-			case "doneItems":
-				return ec.fieldContext_FileTask_doneItems(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_FileTask_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_FileTask_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FileTask", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -11375,15 +15401,15 @@ func (ec *executionContext) fieldContext_Mutation_createCopyTask(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createCopyTask_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_restoreFiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createMoveTask(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createMoveTask(ctx, field)
+func (ec *executionContext) _Mutation_setTempValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setTempValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11396,7 +15422,7 @@ func (ec *executionContext) _Mutation_createMoveTask(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateMoveTask(rctx, fc.Args["ops"].([]*model.FileTaskOpInput))
+		return ec.resolvers.Mutation().SetTempValue(rctx, fc.Args["key"].(string), fc.Args["value"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11408,12 +15434,12 @@ func (ec *executionContext) _Mutation_createMoveTask(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.FileTask)
+	res := resTmp.(*model.TempValue)
 	fc.Result = res
-	return ec.marshalNFileTask2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileTask(ctx, field.Selections, res)
+	return ec.marshalNTempValue2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTempValue(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createMoveTask(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setTempValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11421,30 +15447,12 @@ func (ec *executionContext) fieldContext_Mutation_createMoveTask(ctx context.Con
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_FileTask_id(ctx, field)
-			case "type":
-				return ec.fieldContext_FileTask_type(ctx, field)
-			case "title":
-				return ec.fieldContext_FileTask_title(ctx, field)
-			case "status":
-				return ec.fieldContext_FileTask_status(ctx, field)
-			case "error":
-				return ec.fieldContext_FileTask_error(ctx, field)
-			case "totalBytes":
-				return ec.fieldContext_FileTask_totalBytes(ctx, field)
-			case "doneBytes":
-				return ec.fieldContext_FileTask_doneBytes(ctx, field)
-			case "totalItems":
-				return ec.fieldContext_FileTask_totalItems(ctx, field)
-			case "doneItems":
-				return ec.fieldContext_FileTask_doneItems(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_FileTask_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_FileTask_updatedAt(ctx, field)
+			case "key":
+				return ec.fieldContext_TempValue_key(ctx, field)
+			case "value":
+				return ec.fieldContext_TempValue_value(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type FileTask", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TempValue", field.Name)
 		},
 	}
 	defer func() {
@@ -11454,15 +15462,15 @@ func (ec *executionContext) fieldContext_Mutation_createMoveTask(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createMoveTask_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setTempValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteFiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteFiles(ctx, field)
+func (ec *executionContext) _Mutation_mergeChunks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_mergeChunks(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11475,7 +15483,7 @@ func (ec *executionContext) _Mutation_deleteFiles(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteFiles(rctx, fc.Args["paths"].([]string))
+		return ec.resolvers.Mutation().MergeChunks(rctx, fc.Args["fileId"].(string), fc.Args["totalChunks"].(int), fc.Args["path"].(string), fc.Args["replace"].(bool), fc.Args["batchId"].(string), fc.Args["batchTotal"].(int), fc.Args["batchDir"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11487,19 +15495,19 @@ func (ec *executionContext) _Mutation_deleteFiles(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteFiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_mergeChunks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	defer func() {
@@ -11509,15 +15517,15 @@ func (ec *executionContext) fieldContext_Mutation_deleteFiles(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteFiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_mergeChunks_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_trashFiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_trashFiles(ctx, field)
+func (ec *executionContext) _Mutation_uploadByHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_uploadByHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11530,7 +15538,7 @@ func (ec *executionContext) _Mutation_trashFiles(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().TrashFiles(rctx, fc.Args["paths"].([]string))
+		return ec.resolvers.Mutation().UploadByHash(rctx, fc.Args["hash"].(string), fc.Args["size"].(int64), fc.Args["path"].(string), fc.Args["replace"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11542,19 +15550,19 @@ func (ec *executionContext) _Mutation_trashFiles(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_trashFiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_uploadByHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	defer func() {
@@ -11564,15 +15572,15 @@ func (ec *executionContext) fieldContext_Mutation_trashFiles(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_trashFiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_uploadByHash_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_restoreFiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_restoreFiles(ctx, field)
+func (ec *executionContext) _Mutation_startMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_startMediaScan(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11585,7 +15593,7 @@ func (ec *executionContext) _Mutation_restoreFiles(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RestoreFiles(rctx, fc.Args["paths"].([]string))
+		return ec.resolvers.Mutation().StartMediaScan(rctx, fc.Args["root"].(string), fc.Args["incremental"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11602,7 +15610,7 @@ func (ec *executionContext) _Mutation_restoreFiles(ctx context.Context, field gr
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_restoreFiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_startMediaScan(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11619,15 +15627,15 @@ func (ec *executionContext) fieldContext_Mutation_restoreFiles(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_restoreFiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_startMediaScan_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setTempValue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setTempValue(ctx, field)
+func (ec *executionContext) _Mutation_pauseMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_pauseMediaScan(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11640,7 +15648,7 @@ func (ec *executionContext) _Mutation_setTempValue(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetTempValue(rctx, fc.Args["key"].(string), fc.Args["value"].(string))
+		return ec.resolvers.Mutation().PauseMediaScan(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11652,43 +15660,26 @@ func (ec *executionContext) _Mutation_setTempValue(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TempValue)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNTempValue2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTempValue(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setTempValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_pauseMediaScan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "key":
-				return ec.fieldContext_TempValue_key(ctx, field)
-			case "value":
-				return ec.fieldContext_TempValue_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TempValue", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setTempValue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_mergeChunks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_mergeChunks(ctx, field)
+func (ec *executionContext) _Mutation_resumeMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_resumeMediaScan(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11701,7 +15692,7 @@ func (ec *executionContext) _Mutation_mergeChunks(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().MergeChunks(rctx, fc.Args["fileId"].(string), fc.Args["totalChunks"].(int), fc.Args["path"].(string), fc.Args["replace"].(bool))
+		return ec.resolvers.Mutation().ResumeMediaScan(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11713,37 +15704,26 @@ func (ec *executionContext) _Mutation_mergeChunks(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_mergeChunks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_resumeMediaScan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_mergeChunks_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_startMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_startMediaScan(ctx, field)
+func (ec *executionContext) _Mutation_stopMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_stopMediaScan(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11756,7 +15736,7 @@ func (ec *executionContext) _Mutation_startMediaScan(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().StartMediaScan(rctx, fc.Args["root"].(string))
+		return ec.resolvers.Mutation().StopMediaScan(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11773,7 +15753,7 @@ func (ec *executionContext) _Mutation_startMediaScan(ctx context.Context, field
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_startMediaScan(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_stopMediaScan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11783,22 +15763,11 @@ func (ec *executionContext) fieldContext_Mutation_startMediaScan(ctx context.Con
 			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_startMediaScan_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_pauseMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_pauseMediaScan(ctx, field)
+func (ec *executionContext) _Mutation_pauseMediaScanJob(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_pauseMediaScanJob(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11811,7 +15780,7 @@ func (ec *executionContext) _Mutation_pauseMediaScan(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().PauseMediaScan(rctx)
+		return ec.resolvers.Mutation().PauseMediaScanJob(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11828,7 +15797,7 @@ func (ec *executionContext) _Mutation_pauseMediaScan(ctx context.Context, field
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_pauseMediaScan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_pauseMediaScanJob(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11838,11 +15807,22 @@ func (ec *executionContext) fieldContext_Mutation_pauseMediaScan(_ context.Conte
 			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_pauseMediaScanJob_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_resumeMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_resumeMediaScan(ctx, field)
+func (ec *executionContext) _Mutation_resumeMediaScanJob(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_resumeMediaScanJob(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11855,7 +15835,7 @@ func (ec *executionContext) _Mutation_resumeMediaScan(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ResumeMediaScan(rctx)
+		return ec.resolvers.Mutation().ResumeMediaScanJob(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11872,7 +15852,7 @@ func (ec *executionContext) _Mutation_resumeMediaScan(ctx context.Context, field
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_resumeMediaScan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_resumeMediaScanJob(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11882,11 +15862,22 @@ func (ec *executionContext) fieldContext_Mutation_resumeMediaScan(_ context.Cont
 			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resumeMediaScanJob_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_stopMediaScan(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_stopMediaScan(ctx, field)
+func (ec *executionContext) _Mutation_stopMediaScanJob(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_stopMediaScanJob(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11899,7 +15890,7 @@ func (ec *executionContext) _Mutation_stopMediaScan(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().StopMediaScan(rctx)
+		return ec.resolvers.Mutation().StopMediaScanJob(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11916,7 +15907,7 @@ func (ec *executionContext) _Mutation_stopMediaScan(ctx context.Context, field g
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_stopMediaScan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_stopMediaScanJob(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -11926,6 +15917,17 @@ func (ec *executionContext) fieldContext_Mutation_stopMediaScan(_ context.Contex
 			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_stopMediaScanJob_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
@@ -12950,6 +16952,138 @@ func (ec *executionContext) fieldContext_Mutation_formatDisk(ctx context.Context
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_fsck(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_fsck(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Fsck(rctx, fc.Args["apply"].(bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.FsckReport)
+	fc.Result = res
+	return ec.marshalNFsckReport2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckReport(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_fsck(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apply":
+				return ec.fieldContext_FsckReport_apply(ctx, field)
+			case "entries":
+				return ec.fieldContext_FsckReport_entries(ctx, field)
+			case "issues":
+				return ec.fieldContext_FsckReport_issues(ctx, field)
+			case "counts":
+				return ec.fieldContext_FsckReport_counts(ctx, field)
+			case "truncated":
+				return ec.fieldContext_FsckReport_truncated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FsckReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_fsck_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_backup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_backup(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Backup(rctx, fc.Args["path"].(string), fc.Args["excludeCaches"].(bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.BackupResult)
+	fc.Result = res
+	return ec.marshalNBackupResult2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐBackupResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_backup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_BackupResult_path(ctx, field)
+			case "keys":
+				return ec.fieldContext_BackupResult_keys(ctx, field)
+			case "size":
+				return ec.fieldContext_BackupResult_size(ctx, field)
+			case "schemaVersion":
+				return ec.fieldContext_BackupResult_schemaVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BackupResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_backup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_dlnaCast(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_dlnaCast(ctx, field)
 	if err != nil {
@@ -13909,6 +18043,8 @@ func (ec *executionContext) fieldContext_Query_mounts(_ context.Context, field g
 				return ec.fieldContext_StorageMount_driveType(ctx, field)
 			case "diskID":
 				return ec.fieldContext_StorageMount_diskID(ctx, field)
+			case "lastSeen":
+				return ec.fieldContext_StorageMount_lastSeen(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type StorageMount", field.Name)
 		},
@@ -13974,8 +18110,96 @@ func (ec *executionContext) fieldContext_Query_disks(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_mediaSourceDirs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_mediaSourceDirs(ctx, field)
+func (ec *executionContext) _Query_mediaSourceDirs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_mediaSourceDirs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MediaSourceDirs(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_mediaSourceDirs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_ignorePatterns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_ignorePatterns(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().IgnorePatterns(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_ignorePatterns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_mediaScanJobs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_mediaScanJobs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13988,7 +18212,7 @@ func (ec *executionContext) _Query_mediaSourceDirs(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MediaSourceDirs(rctx)
+		return ec.resolvers.Query().MediaScanJobs(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14000,19 +18224,43 @@ func (ec *executionContext) _Query_mediaSourceDirs(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*model.MediaScanJob)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNMediaScanJob2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐMediaScanJobᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_mediaSourceDirs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_mediaScanJobs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MediaScanJob_id(ctx, field)
+			case "root":
+				return ec.fieldContext_MediaScanJob_root(ctx, field)
+			case "incremental":
+				return ec.fieldContext_MediaScanJob_incremental(ctx, field)
+			case "state":
+				return ec.fieldContext_MediaScanJob_state(ctx, field)
+			case "indexed":
+				return ec.fieldContext_MediaScanJob_indexed(ctx, field)
+			case "total":
+				return ec.fieldContext_MediaScanJob_total(ctx, field)
+			case "errors":
+				return ec.fieldContext_MediaScanJob_errors(ctx, field)
+			case "errorCount":
+				return ec.fieldContext_MediaScanJob_errorCount(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_MediaScanJob_createdAt(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_MediaScanJob_startedAt(ctx, field)
+			case "finishedAt":
+				return ec.fieldContext_MediaScanJob_finishedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MediaScanJob", field.Name)
 		},
 	}
 	return fc, nil
@@ -14092,7 +18340,7 @@ func (ec *executionContext) _Query_images(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Images(rctx, fc.Args["offset"].(int), fc.Args["limit"].(int), fc.Args["query"].(string), fc.Args["sortBy"].(model.FileSortBy))
+		return ec.resolvers.Query().Images(rctx, fc.Args["offset"].(int), fc.Args["limit"].(int), fc.Args["query"].(string), fc.Args["sortBy"].(model.FileSortBy), fc.Args["after"].(*string), fc.Args["first"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14133,6 +18381,12 @@ func (ec *executionContext) fieldContext_Query_images(ctx context.Context, field
 				return ec.fieldContext_Image_updatedAt(ctx, field)
 			case "tags":
 				return ec.fieldContext_Image_tags(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Image_cursor(ctx, field)
+			case "online":
+				return ec.fieldContext_Image_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_Image_volume(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
 		},
@@ -14394,7 +18648,7 @@ func (ec *executionContext) _Query_videos(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Videos(rctx, fc.Args["offset"].(int), fc.Args["limit"].(int), fc.Args["query"].(string), fc.Args["sortBy"].(model.FileSortBy))
+		return ec.resolvers.Query().Videos(rctx, fc.Args["offset"].(int), fc.Args["limit"].(int), fc.Args["query"].(string), fc.Args["sortBy"].(model.FileSortBy), fc.Args["after"].(*string), fc.Args["first"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14437,6 +18691,12 @@ func (ec *executionContext) fieldContext_Query_videos(ctx context.Context, field
 				return ec.fieldContext_Video_updatedAt(ctx, field)
 			case "tags":
 				return ec.fieldContext_Video_tags(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Video_cursor(ctx, field)
+			case "online":
+				return ec.fieldContext_Video_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_Video_volume(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Video", field.Name)
 		},
@@ -14469,7 +18729,7 @@ func (ec *executionContext) _Query_audios(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Audios(rctx, fc.Args["offset"].(int), fc.Args["limit"].(int), fc.Args["query"].(string), fc.Args["sortBy"].(model.FileSortBy))
+		return ec.resolvers.Query().Audios(rctx, fc.Args["offset"].(int), fc.Args["limit"].(int), fc.Args["query"].(string), fc.Args["sortBy"].(model.FileSortBy), fc.Args["after"].(*string), fc.Args["first"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14500,6 +18760,20 @@ func (ec *executionContext) fieldContext_Query_audios(ctx context.Context, field
 				return ec.fieldContext_Audio_title(ctx, field)
 			case "artist":
 				return ec.fieldContext_Audio_artist(ctx, field)
+			case "album":
+				return ec.fieldContext_Audio_album(ctx, field)
+			case "albumArtist":
+				return ec.fieldContext_Audio_albumArtist(ctx, field)
+			case "track":
+				return ec.fieldContext_Audio_track(ctx, field)
+			case "disc":
+				return ec.fieldContext_Audio_disc(ctx, field)
+			case "year":
+				return ec.fieldContext_Audio_year(ctx, field)
+			case "genre":
+				return ec.fieldContext_Audio_genre(ctx, field)
+			case "composer":
+				return ec.fieldContext_Audio_composer(ctx, field)
 			case "path":
 				return ec.fieldContext_Audio_path(ctx, field)
 			case "duration":
@@ -14516,6 +18790,12 @@ func (ec *executionContext) fieldContext_Query_audios(ctx context.Context, field
 				return ec.fieldContext_Audio_updatedAt(ctx, field)
 			case "tags":
 				return ec.fieldContext_Audio_tags(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Audio_cursor(ctx, field)
+			case "online":
+				return ec.fieldContext_Audio_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_Audio_volume(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Audio", field.Name)
 		},
@@ -15027,6 +19307,16 @@ func (ec *executionContext) fieldContext_Query_files(ctx context.Context, field
 				return ec.fieldContext_File_size(ctx, field)
 			case "childCount":
 				return ec.fieldContext_File_childCount(ctx, field)
+			case "snippet":
+				return ec.fieldContext_File_snippet(ctx, field)
+			case "nameMatches":
+				return ec.fieldContext_File_nameMatches(ctx, field)
+			case "snippetMatches":
+				return ec.fieldContext_File_snippetMatches(ctx, field)
+			case "online":
+				return ec.fieldContext_File_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_File_volume(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
@@ -15151,10 +19441,99 @@ func (ec *executionContext) fieldContext_Query_recentFiles(_ context.Context, fi
 				return ec.fieldContext_File_size(ctx, field)
 			case "childCount":
 				return ec.fieldContext_File_childCount(ctx, field)
+			case "snippet":
+				return ec.fieldContext_File_snippet(ctx, field)
+			case "nameMatches":
+				return ec.fieldContext_File_nameMatches(ctx, field)
+			case "snippetMatches":
+				return ec.fieldContext_File_snippetMatches(ctx, field)
+			case "online":
+				return ec.fieldContext_File_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_File_volume(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_archiveEntries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_archiveEntries(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ArchiveEntries(rctx, fc.Args["path"].(string), fc.Args["prefix"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.File)
+	fc.Result = res
+	return ec.marshalNFile2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFileᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_archiveEntries(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_File_path(ctx, field)
+			case "isDir":
+				return ec.fieldContext_File_isDir(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_File_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "childCount":
+				return ec.fieldContext_File_childCount(ctx, field)
+			case "snippet":
+				return ec.fieldContext_File_snippet(ctx, field)
+			case "nameMatches":
+				return ec.fieldContext_File_nameMatches(ctx, field)
+			case "snippetMatches":
+				return ec.fieldContext_File_snippetMatches(ctx, field)
+			case "online":
+				return ec.fieldContext_File_online(ctx, field)
+			case "volume":
+				return ec.fieldContext_File_volume(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_archiveEntries_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
@@ -15257,6 +19636,61 @@ func (ec *executionContext) fieldContext_Query_uploadedChunks(ctx context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_checkUploadHashes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_checkUploadHashes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CheckUploadHashes(rctx, fc.Args["hashes"].([]string), fc.Args["dir"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_checkUploadHashes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_checkUploadHashes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_dlnaRenderers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_dlnaRenderers(ctx, field)
 	if err != nil {
@@ -17222,6 +21656,47 @@ func (ec *executionContext) fieldContext_StorageMount_diskID(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _StorageMount_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.StorageMount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StorageMount_lastSeen(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastSeen, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StorageMount_lastSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StorageMount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Tag_id(ctx context.Context, field graphql.CollectedField, obj *model.Tag) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Tag_id(ctx, field)
 	if err != nil {
@@ -17398,8 +21873,96 @@ func (ec *executionContext) fieldContext_Tag_count(_ context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _TempValue_key(ctx context.Context, field graphql.CollectedField, obj *model.TempValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TempValue_key(ctx, field)
+func (ec *executionContext) _TempValue_key(ctx context.Context, field graphql.CollectedField, obj *model.TempValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TempValue_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TempValue_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TempValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TempValue_value(ctx context.Context, field graphql.CollectedField, obj *model.TempValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TempValue_value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TempValue_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TempValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TextRange_start(ctx context.Context, field graphql.CollectedField, obj *model.TextRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TextRange_start(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -17412,7 +21975,7 @@ func (ec *executionContext) _TempValue_key(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Start, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -17424,26 +21987,26 @@ func (ec *executionContext) _TempValue_key(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TempValue_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TextRange_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TempValue",
+		Object:     "TextRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TempValue_value(ctx context.Context, field graphql.CollectedField, obj *model.TempValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TempValue_value(ctx, field)
+func (ec *executionContext) _TextRange_length(ctx context.Context, field graphql.CollectedField, obj *model.TextRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TextRange_length(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -17456,7 +22019,7 @@ func (ec *executionContext) _TempValue_value(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Length, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -17468,19 +22031,19 @@ func (ec *executionContext) _TempValue_value(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TempValue_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TextRange_length(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TempValue",
+		Object:     "TextRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
@@ -17892,6 +22455,143 @@ func (ec *executionContext) fieldContext_Video_tags(_ context.Context, field gra
 	return fc, nil
 }
 
+func (ec *executionContext) _Video_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Video) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Video_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Video_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Video",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Video_online(ctx context.Context, field graphql.CollectedField, obj *model.Video) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Video_online(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Online, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Video_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Video",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Video_volume(ctx context.Context, field graphql.CollectedField, obj *model.Video) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Video_volume(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Volume, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.VolumeRef)
+	fc.Result = res
+	return ec.marshalOVolumeRef2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐVolumeRef(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Video_volume(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Video",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VolumeRef_id(ctx, field)
+			case "uuid":
+				return ec.fieldContext_VolumeRef_uuid(ctx, field)
+			case "name":
+				return ec.fieldContext_VolumeRef_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VolumeRef", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _VideoFileInfo_duration(ctx context.Context, field graphql.CollectedField, obj *model.VideoFileInfo) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_VideoFileInfo_duration(ctx, field)
 	if err != nil {
@@ -18062,6 +22762,138 @@ func (ec *executionContext) fieldContext_VideoFileInfo_location(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _VolumeRef_id(ctx context.Context, field graphql.CollectedField, obj *model.VolumeRef) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VolumeRef_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VolumeRef_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VolumeRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VolumeRef_uuid(ctx context.Context, field graphql.CollectedField, obj *model.VolumeRef) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VolumeRef_uuid(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UUID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VolumeRef_uuid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VolumeRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VolumeRef_name(ctx context.Context, field graphql.CollectedField, obj *model.VolumeRef) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VolumeRef_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VolumeRef_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VolumeRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext___Directive_name(ctx, field)
 	if err != nil {
@@ -20368,6 +25200,41 @@ func (ec *executionContext) _Audio(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "album":
+			out.Values[i] = ec._Audio_album(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "albumArtist":
+			out.Values[i] = ec._Audio_albumArtist(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "track":
+			out.Values[i] = ec._Audio_track(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "disc":
+			out.Values[i] = ec._Audio_disc(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "year":
+			out.Values[i] = ec._Audio_year(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "genre":
+			out.Values[i] = ec._Audio_genre(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "composer":
+			out.Values[i] = ec._Audio_composer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "path":
 			out.Values[i] = ec._Audio_path(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -20408,6 +25275,18 @@ func (ec *executionContext) _Audio(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "cursor":
+			out.Values[i] = ec._Audio_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "online":
+			out.Values[i] = ec._Audio_online(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "volume":
+			out.Values[i] = ec._Audio_volume(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -20469,6 +25348,60 @@ func (ec *executionContext) _AudioFileInfo(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var backupResultImplementors = []string{"BackupResult"}
+
+func (ec *executionContext) _BackupResult(ctx context.Context, sel ast.SelectionSet, obj *model.BackupResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, backupResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BackupResult")
+		case "path":
+			out.Values[i] = ec._BackupResult_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "keys":
+			out.Values[i] = ec._BackupResult_keys(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "size":
+			out.Values[i] = ec._BackupResult_size(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "schemaVersion":
+			out.Values[i] = ec._BackupResult_schemaVersion(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var deviceInfoImplementors = []string{"DeviceInfo"}
 
 func (ec *executionContext) _DeviceInfo(ctx context.Context, sel ast.SelectionSet, obj *model.DeviceInfo) graphql.Marshaler {
@@ -20812,6 +25745,19 @@ func (ec *executionContext) _File(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "snippet":
+			out.Values[i] = ec._File_snippet(ctx, field, obj)
+		case "nameMatches":
+			out.Values[i] = ec._File_nameMatches(ctx, field, obj)
+		case "snippetMatches":
+			out.Values[i] = ec._File_snippetMatches(ctx, field, obj)
+		case "online":
+			out.Values[i] = ec._File_online(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "volume":
+			out.Values[i] = ec._File_volume(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -20980,6 +25926,173 @@ func (ec *executionContext) _FileTask(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var fsckCountImplementors = []string{"FsckCount"}
+
+func (ec *executionContext) _FsckCount(ctx context.Context, sel ast.SelectionSet, obj *model.FsckCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fsckCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FsckCount")
+		case "check":
+			out.Values[i] = ec._FsckCount_check(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "problem":
+			out.Values[i] = ec._FsckCount_problem(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._FsckCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repaired":
+			out.Values[i] = ec._FsckCount_repaired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fsckIssueImplementors = []string{"FsckIssue"}
+
+func (ec *executionContext) _FsckIssue(ctx context.Context, sel ast.SelectionSet, obj *model.FsckIssue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fsckIssueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FsckIssue")
+		case "check":
+			out.Values[i] = ec._FsckIssue_check(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "key":
+			out.Values[i] = ec._FsckIssue_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "problem":
+			out.Values[i] = ec._FsckIssue_problem(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repaired":
+			out.Values[i] = ec._FsckIssue_repaired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fsckReportImplementors = []string{"FsckReport"}
+
+func (ec *executionContext) _FsckReport(ctx context.Context, sel ast.SelectionSet, obj *model.FsckReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fsckReportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FsckReport")
+		case "apply":
+			out.Values[i] = ec._FsckReport_apply(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entries":
+			out.Values[i] = ec._FsckReport_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._FsckReport_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "counts":
+			out.Values[i] = ec._FsckReport_counts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "truncated":
+			out.Values[i] = ec._FsckReport_truncated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var geoLocationImplementors = []string{"GeoLocation"}
 
 func (ec *executionContext) _GeoLocation(ctx context.Context, sel ast.SelectionSet, obj *model.GeoLocation) graphql.Marshaler {
@@ -21069,6 +26182,18 @@ func (ec *executionContext) _Image(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "cursor":
+			out.Values[i] = ec._Image_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "online":
+			out.Values[i] = ec._Image_online(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "volume":
+			out.Values[i] = ec._Image_volume(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -21148,8 +26273,62 @@ func (ec *executionContext) _MediaActionResult(ctx context.Context, sel ast.Sele
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "query":
-			out.Values[i] = ec._MediaActionResult_query(ctx, field, obj)
+		case "query":
+			out.Values[i] = ec._MediaActionResult_query(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mediaBucketImplementors = []string{"MediaBucket"}
+
+func (ec *executionContext) _MediaBucket(ctx context.Context, sel ast.SelectionSet, obj *model.MediaBucket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mediaBucketImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MediaBucket")
+		case "id":
+			out.Values[i] = ec._MediaBucket_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._MediaBucket_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "itemCount":
+			out.Values[i] = ec._MediaBucket_itemCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "topItems":
+			out.Values[i] = ec._MediaBucket_topItems(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -21176,37 +26355,66 @@ func (ec *executionContext) _MediaActionResult(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var mediaBucketImplementors = []string{"MediaBucket"}
+var mediaScanJobImplementors = []string{"MediaScanJob"}
 
-func (ec *executionContext) _MediaBucket(ctx context.Context, sel ast.SelectionSet, obj *model.MediaBucket) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mediaBucketImplementors)
+func (ec *executionContext) _MediaScanJob(ctx context.Context, sel ast.SelectionSet, obj *model.MediaScanJob) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mediaScanJobImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MediaBucket")
+			out.Values[i] = graphql.MarshalString("MediaScanJob")
 		case "id":
-			out.Values[i] = ec._MediaBucket_id(ctx, field, obj)
+			out.Values[i] = ec._MediaScanJob_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._MediaBucket_name(ctx, field, obj)
+		case "root":
+			out.Values[i] = ec._MediaScanJob_root(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "itemCount":
-			out.Values[i] = ec._MediaBucket_itemCount(ctx, field, obj)
+		case "incremental":
+			out.Values[i] = ec._MediaScanJob_incremental(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "topItems":
-			out.Values[i] = ec._MediaBucket_topItems(ctx, field, obj)
+		case "state":
+			out.Values[i] = ec._MediaScanJob_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "indexed":
+			out.Values[i] = ec._MediaScanJob_indexed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._MediaScanJob_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "errors":
+			out.Values[i] = ec._MediaScanJob_errors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "errorCount":
+			out.Values[i] = ec._MediaScanJob_errorCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._MediaScanJob_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "startedAt":
+			out.Values[i] = ec._MediaScanJob_startedAt(ctx, field, obj)
+		case "finishedAt":
+			out.Values[i] = ec._MediaScanJob_finishedAt(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -21291,6 +26499,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "setIgnorePatterns":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setIgnorePatterns(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "setSambaSettings":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_setSambaSettings(ctx, field)
@@ -21375,6 +26590,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "createArchive":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createArchive(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelFileTask":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelFileTask(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "deleteFiles":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_deleteFiles(ctx, field)
@@ -21410,6 +26639,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "uploadByHash":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_uploadByHash(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "startMediaScan":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_startMediaScan(ctx, field)
@@ -21438,6 +26674,27 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "pauseMediaScanJob":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_pauseMediaScanJob(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resumeMediaScanJob":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_resumeMediaScanJob(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stopMediaScanJob":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_stopMediaScanJob(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "rebuildMediaIndex":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_rebuildMediaIndex(ctx, field)
@@ -21558,6 +26815,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "fsck":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_fsck(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "backup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_backup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "dlnaCast":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_dlnaCast(ctx, field)
@@ -21978,6 +27249,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "ignorePatterns":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_ignorePatterns(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "mediaScanJobs":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_mediaScanJobs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "sambaSettings":
 			field := field
@@ -22371,6 +27686,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "archiveEntries":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_archiveEntries(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "trashCount":
 			field := field
@@ -22415,6 +27752,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "checkUploadHashes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_checkUploadHashes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "dlnaRenderers":
 			field := field
@@ -22819,6 +28178,8 @@ func (ec *executionContext) _StorageMount(ctx context.Context, sel ast.Selection
 			out.Values[i] = ec._StorageMount_driveType(ctx, field, obj)
 		case "diskID":
 			out.Values[i] = ec._StorageMount_diskID(ctx, field, obj)
+		case "lastSeen":
+			out.Values[i] = ec._StorageMount_lastSeen(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -22940,6 +28301,50 @@ func (ec *executionContext) _TempValue(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var textRangeImplementors = []string{"TextRange"}
+
+func (ec *executionContext) _TextRange(ctx context.Context, sel ast.SelectionSet, obj *model.TextRange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, textRangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TextRange")
+		case "start":
+			out.Values[i] = ec._TextRange_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "length":
+			out.Values[i] = ec._TextRange_length(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var videoImplementors = []string{"Video"}
 
 func (ec *executionContext) _Video(ctx context.Context, sel ast.SelectionSet, obj *model.Video) graphql.Marshaler {
@@ -22996,6 +28401,18 @@ func (ec *executionContext) _Video(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "cursor":
+			out.Values[i] = ec._Video_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "online":
+			out.Values[i] = ec._Video_online(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "volume":
+			out.Values[i] = ec._Video_volume(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -23061,6 +28478,55 @@ func (ec *executionContext) _VideoFileInfo(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var volumeRefImplementors = []string{"VolumeRef"}
+
+func (ec *executionContext) _VolumeRef(ctx context.Context, sel ast.SelectionSet, obj *model.VolumeRef) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, volumeRefImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VolumeRef")
+		case "id":
+			out.Values[i] = ec._VolumeRef_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "uuid":
+			out.Values[i] = ec._VolumeRef_uuid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._VolumeRef_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -23424,6 +28890,16 @@ func (ec *executionContext) marshalNAppUpdate2ᚖismartcodingᚋplainnasᚋinter
 	return ec._AppUpdate(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNArchiveFormat2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐArchiveFormat(ctx context.Context, v any) (model.ArchiveFormat, error) {
+	var res model.ArchiveFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNArchiveFormat2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐArchiveFormat(ctx context.Context, sel ast.SelectionSet, v model.ArchiveFormat) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNAudio2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐAudioᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Audio) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -23478,6 +28954,20 @@ func (ec *executionContext) marshalNAudio2ᚖismartcodingᚋplainnasᚋinternal
 	return ec._Audio(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNBackupResult2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐBackupResult(ctx context.Context, sel ast.SelectionSet, v model.BackupResult) graphql.Marshaler {
+	return ec._BackupResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNBackupResult2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐBackupResult(ctx context.Context, sel ast.SelectionSet, v *model.BackupResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._BackupResult(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -23864,6 +29354,128 @@ func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.S
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) marshalNFsckCount2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FsckCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFsckCount2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNFsckCount2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckCount(ctx context.Context, sel ast.SelectionSet, v *model.FsckCount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FsckCount(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFsckIssue2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckIssueᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FsckIssue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFsckIssue2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckIssue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNFsckIssue2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckIssue(ctx context.Context, sel ast.SelectionSet, v *model.FsckIssue) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FsckIssue(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFsckReport2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckReport(ctx context.Context, sel ast.SelectionSet, v model.FsckReport) graphql.Marshaler {
+	return ec._FsckReport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFsckReport2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐFsckReport(ctx context.Context, sel ast.SelectionSet, v *model.FsckReport) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FsckReport(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -24101,6 +29713,70 @@ func (ec *executionContext) marshalNMediaPlayMode2ismartcodingᚋplainnasᚋinte
 	return v
 }
 
+func (ec *executionContext) marshalNMediaScanJob2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐMediaScanJobᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MediaScanJob) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMediaScanJob2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐMediaScanJob(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMediaScanJob2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐMediaScanJob(ctx context.Context, sel ast.SelectionSet, v *model.MediaScanJob) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MediaScanJob(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNMediaScanJobState2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐMediaScanJobState(ctx context.Context, v any) (model.MediaScanJobState, error) {
+	var res model.MediaScanJobState
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMediaScanJobState2ismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐMediaScanJobState(ctx context.Context, sel ast.SelectionSet, v model.MediaScanJobState) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNNicInfo2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐNicInfoᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.NicInfo) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -24674,6 +30350,16 @@ func (ec *executionContext) marshalNTempValue2ᚖismartcodingᚋplainnasᚋinter
 	return ec._TempValue(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNTextRange2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTextRange(ctx context.Context, sel ast.SelectionSet, v *model.TextRange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TextRange(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
 	res, err := graphql.UnmarshalTime(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -25128,6 +30814,76 @@ func (ec *executionContext) marshalOTag2ᚖismartcodingᚋplainnasᚋinternalᚋ
 	return ec._Tag(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOTextRange2ᚕᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTextRangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TextRange) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTextRange2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐTextRange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalTime(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOVolumeRef2ᚖismartcodingᚋplainnasᚋinternalᚋgraphᚋmodelᚐVolumeRef(ctx context.Context, sel ast.SelectionSet, v *model.VolumeRef) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._VolumeRef(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -46,3 +46,26 @@ func LoadAudioMode() string {
 func SaveAudioMode(mode string) error {
 	return db.GetDefault().Set([]byte(keyAudioMode), []byte(mode), nil)
 }
+
+// RetargetAudioPlaylist points playlist entries and the current track at from to the path to,
+// keeping their order and cached tags.
+func RetargetAudioPlaylist(from, to string) error {
+	from, to = filepath.ToSlash(from), filepath.ToSlash(to)
+	items := LoadAudioPlaylist()
+	changed := false
+	for i := range items {
+		if items[i].Path == from {
+			items[i].Path = to
+			changed = true
+		}
+	}
+	if changed {
+		if err := SaveAudioPlaylist(items); err != nil {
+			return err
+		}
+	}
+	if LoadAudioCurrent() == from {
+		return SaveAudioCurrent(to)
+	}
+	return nil
+}
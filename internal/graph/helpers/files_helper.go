@@ -251,6 +251,7 @@ func FileInfoToModel(path string, info os.FileInfo, isDir bool) *model.File {
 		UpdatedAt:  info.ModTime(),
 		Size:       info.Size(),
 		ChildCount: childCount,
+		Online:     true,
 	}
 }
 
@@ -22,17 +22,42 @@ type FilesQuery struct {
 	TrashOnly    bool
 	FileSizeOp   string
 	FileSizeVal  int64
+	// Content holds the terms of content: fields, matched against extracted document text.
+	Content string
+	// Filters are ext:/type:/modified/is:dir conditions: every group must match,
+	// the fields inside a group are OR alternatives.
+	Filters [][]search.FilterField
+}
+
+// HasFilters reports whether the query narrows results beyond listing a directory.
+func (q FilesQuery) HasFilters() bool {
+	return q.Text != "" || (q.FileSizeOp != "" && q.FileSizeVal > 0) || len(q.Filters) > 0
 }
 
 func ParseFilesQuery(q string) FilesQuery {
 	fields := search.Parse(q)
 	out := FilesQuery{}
+	prevFilter := false
 	for _, f := range fields {
+		if search.IsIndexFilter(f) {
+			// OR only joins adjacent filters; anything else is ANDed.
+			if f.Or && prevFilter {
+				last := len(out.Filters) - 1
+				out.Filters[last] = append(out.Filters[last], f)
+			} else {
+				out.Filters = append(out.Filters, []search.FilterField{f})
+			}
+			prevFilter = true
+			continue
+		}
+		prevFilter = false
 		switch f.Name {
 		case "show_hidden":
 			out.ShowHidden = f.Value == "true"
 		case "text":
 			out.Text = f.Value
+		case "content":
+			out.Content = strings.TrimSpace(out.Content + " " + f.Value)
 		case "root_path":
 			out.RootPath = f.Value
 		case "relative_path":
@@ -140,6 +165,7 @@ func trashItemToModel(it *plainfs.TrashItem) *model.File {
 		UpdatedAt:  t,
 		Size:       sz,
 		ChildCount: childCount,
+		Online:     true,
 	}
 }
 
@@ -296,29 +322,92 @@ func ListTrashFiles(offset, limit int, text string, baseFilter string, sortBy mo
 	return matches, nil
 }
 
-func SearchIndexFiles(text string, base string, offset int, limit int, showHidden bool, sizeOp string, sizeBytes int64) ([]*model.File, error) {
+// SearchContentFiles runs a full-text query over extracted document text. Results carry a
+// snippet of the matching text. When nameText is set, hits must also contain it in their name.
+func SearchContentFiles(content string, nameText string, base string, offset int, limit int, sizeOp string, sizeBytes int64, filters [][]search.FilterField) ([]*model.File, error) {
+	hits, err := search.SearchContent(content, nameText, normalizeSlashDir(base), offset, limit, sizeOp, uint64(sizeBytes), filters)
+	if err != nil {
+		return nil, err
+	}
+	return contentHitsToModel(hits), nil
+}
+
+func contentHitsToModel(hits []search.ContentHit) []*model.File {
+	out := make([]*model.File, 0, len(hits))
+	for _, h := range hits {
+		info, err := os.Stat(h.Path)
+		if err != nil {
+			continue
+		}
+		f := FileInfoToModel(h.Path, info, info.IsDir())
+		if h.Snippet != "" {
+			snippet := h.Snippet
+			f.Snippet = &snippet
+			f.SnippetMatches = textRanges(h.SnippetMatches)
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+func textRanges(ms []search.MatchRange) []*model.TextRange {
+	if len(ms) == 0 {
+		return nil
+	}
+	out := make([]*model.TextRange, len(ms))
+	for i, m := range ms {
+		out[i] = &model.TextRange{Start: m.Start, Length: m.Length}
+	}
+	return out
+}
+
+// fileMetaToModel describes an indexed file of a volume that is not mounted.
+func fileMetaToModel(m *search.FileMeta, volumes *offlineVolumes) *model.File {
+	t := time.Unix(m.MTime, 0)
+	return &model.File{
+		Path:      m.Path,
+		IsDir:     m.IsDir,
+		CreatedAt: t,
+		UpdatedAt: t,
+		Size:      int64(m.Size),
+		Online:    false,
+		Volume:    volumes.ref(false, m.Volume),
+	}
+}
+
+func SearchIndexFiles(text string, base string, offset int, limit int, showHidden bool, sizeOp string, sizeBytes int64, filters [][]search.FilterField) ([]*model.File, error) {
 	parent := normalizeSlashDir(base)
 
-	// Use SearchIndex with size filter params
-	paths, err := search.SearchIndex(text, parent, offset, limit, sizeOp, uint64(sizeBytes))
+	// Use the ranked index search with size and ext/type/mtime filter params
+	hits, err := search.SearchIndexHits(text, parent, offset, limit, sizeOp, uint64(sizeBytes), filters)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if len(paths) > 0 {
-		out := make([]*model.File, 0, len(paths))
-		for _, p := range paths {
-			if info, err := os.Stat(p); err == nil {
-				out = append(out, FileInfoToModel(p, info, info.IsDir()))
+	if len(hits) > 0 {
+		out := make([]*model.File, 0, len(hits))
+		var volumes offlineVolumes
+		for _, h := range hits {
+			if !h.Online && h.Meta != nil {
+				// Offline catalog: the volume is unplugged, describe the file as last indexed.
+				f := fileMetaToModel(h.Meta, &volumes)
+				f.NameMatches = textRanges(h.NameMatches)
+				out = append(out, f)
+				continue
+			}
+			if info, err := os.Stat(h.Path); err == nil {
+				f := FileInfoToModel(h.Path, info, info.IsDir())
+				f.NameMatches = textRanges(h.NameMatches)
+				out = append(out, f)
 			}
 		}
 		return out, nil
 	}
 
-	// If index yields nothing, only fall back to file walk if the user did NOT specify text or file size filter.
-	// If either text or file size filter is set, never do this for a global search (parent == "") to avoid full scans.
-	if strings.TrimSpace(text) != "" || sizeOp != "" {
+	// If index yields nothing, only fall back to file walk if the user did NOT specify text or any filter.
+	// If text or a filter is set, never do this for a global search (parent == "") to avoid full scans.
+	if strings.TrimSpace(text) != "" || sizeOp != "" || len(filters) > 0 {
 		return []*model.File{}, nil
 	}
 
@@ -3,6 +3,7 @@ package helpers
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"hash/fnv"
 	"path/filepath"
 	"sort"
@@ -27,6 +28,14 @@ type mediaFileItem struct {
 	duration int
 	artist   string
 	title    string
+	// audioTags are the album-level tags of audio items.
+	audioTags media.AudioTags
+	// sortKey is the item's position in the type index for the requested sort order, and
+	// cursor its encoded form (see encodeMediaCursor).
+	sortKey string
+	cursor  string
+	online  bool
+	fsUUID  string
 }
 
 type mediaQueryFilters struct {
@@ -127,7 +136,63 @@ func GenerateEncryptedFileID(path string) string {
 	return ""
 }
 
-func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, mediaType string) ([]mediaFileItem, error) {
+// errInvalidMediaCursor is returned for an `after` cursor that is malformed or was issued for
+// another sort order.
+var errInvalidMediaCursor = errors.New("invalid cursor")
+
+// mediaIndexKind maps a sort order to the type index kind that stores it.
+func mediaIndexKind(sortBy model.FileSortBy) string {
+	switch sortBy {
+	case model.FileSortByDateAsc:
+		return "mod"
+	case model.FileSortByNameAsc:
+		return "name"
+	case model.FileSortByNameDesc:
+		return "namedesc"
+	case model.FileSortBySizeAsc:
+		return "size"
+	case model.FileSortBySizeDesc:
+		return "sizedesc"
+	default:
+		return "moddesc"
+	}
+}
+
+// Media cursors are the position of an item in a type index (see media.TypeIndexSortKey),
+// prefixed with the index kind so a cursor cannot be replayed against another sort order.
+func encodeMediaCursor(kind, sortKey string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(kind + ":" + sortKey))
+}
+
+func decodeMediaCursor(cursor, kind string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errInvalidMediaCursor
+	}
+	k, sortKey, ok := strings.Cut(string(b), ":")
+	if !ok || k != kind || sortKey == "" {
+		return "", errInvalidMediaCursor
+	}
+	return sortKey, nil
+}
+
+// MediaPageArgs resolves the optional keyset arguments of the media list queries: first
+// overrides limit, and a non-empty after switches from offset to cursor paging.
+func MediaPageArgs(limit int, after *string, first *int) (int, string) {
+	if first != nil {
+		limit = *first
+	}
+	if after == nil {
+		return limit, ""
+	}
+	return limit, *after
+}
+
+// scanMedia lists media matching query in sortBy order. Pages are addressed either by offset or,
+// when after is set, by the cursor of the last item of the previous page (offset is then
+// ignored). Lists without search text or ids are read straight from the sorted type indexes,
+// so a page costs O(limit) with a cursor instead of sorting the whole library.
+func scanMedia(offset int, limit int, after string, query string, sortBy model.FileSortBy, mediaType string) ([]mediaFileItem, error) {
 	q := parseMediaQueryFilters(query)
 	_ = q.showHidden
 	text := q.text
@@ -136,6 +201,17 @@ func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, med
 	ids := q.ids
 	bucketID := q.bucketID
 
+	kind := mediaIndexKind(sortBy)
+	afterKey := ""
+	if after != "" {
+		k, err := decodeMediaCursor(after, kind)
+		if err != nil {
+			return nil, err
+		}
+		afterKey = k
+		offset = 0
+	}
+
 	var results []media.MediaFile
 	if ids != "" {
 		// Use ids-based search when tag filtering is applied
@@ -151,29 +227,14 @@ func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, med
 		}
 		results, _ = media.Search(searchQuery, filters, 0, 10000)
 	} else if text == "" {
-		// Fast path: empty query, no ids. If we can satisfy ordering via media type indexes,
-		// avoid scanning and unmarshalling the full Pebble media corpus.
-		if limit > 0 && base == "" && mediaType != "" && (sortBy == model.FileSortByDateAsc || sortBy == model.FileSortByDateDesc || sortBy == model.FileSortByNameAsc || sortBy == model.FileSortByNameDesc || sortBy == model.FileSortBySizeAsc || sortBy == model.FileSortBySizeDesc) {
-			idxKind := ""
-			switch sortBy {
-			case model.FileSortByDateAsc:
-				idxKind = "mod"
-			case model.FileSortByDateDesc:
-				idxKind = "moddesc"
-			case model.FileSortByNameAsc:
-				idxKind = "name"
-			case model.FileSortByNameDesc:
-				idxKind = "namedesc"
-			case model.FileSortBySizeAsc:
-				idxKind = "size"
-			case model.FileSortBySizeDesc:
-				idxKind = "sizedesc"
-			}
-			prefix := media.TypeIndexPrefix(mediaType, trashOnly, idxKind)
+		// Fast path: empty query, no ids. Walk the type index in sort order and stop once the
+		// page is full, avoiding scanning and unmarshalling the full Pebble media corpus.
+		if limit > 0 && mediaType != "" {
+			prefix := media.TypeIndexPrefix(mediaType, trashOnly, kind)
 			if len(prefix) > 0 {
 				files := make([]mediaFileItem, 0, limit)
 				skipped := 0
-				iterErr := db.GetDefault().Iterate(prefix, func(key []byte, _ []byte) error {
+				visit := func(key []byte, _ []byte) error {
 					uuid := media.UUIDFromTypeIndexKey(key)
 					if uuid == "" {
 						return nil
@@ -182,18 +243,6 @@ func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, med
 					if err != nil || mf == nil {
 						return nil
 					}
-					// Best-effort: only probe duration for the items we actually return.
-					if (mediaType == "audio" || mediaType == "video") && mf.DurationSec <= 0 {
-						_, _ = media.EnsureDuration(mf)
-					}
-					// Best-effort: only probe artist for the items we actually return.
-					if mediaType == "audio" && mf.Artist == "" {
-						_, _ = media.EnsureArtist(mf)
-					}
-					// Best-effort: only probe title for the items we actually return.
-					if mediaType == "audio" && mf.Title == "" {
-						_, _ = media.EnsureTitle(mf)
-					}
 					// Enforce trash filter defensively (should be implied by index).
 					if trashOnly && !mf.IsTrash {
 						return nil
@@ -201,6 +250,9 @@ func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, med
 					if !trashOnly && mf.IsTrash {
 						return nil
 					}
+					if base != "" && !strings.HasPrefix(filepath.ToSlash(mf.Path), filepath.ToSlash(base)) {
+						return nil
+					}
 					// Bucket mapping uses original path if available (so trash items keep their origin bucket).
 					pathForBucket := mf.Path
 					if mf.OriginalPath != "" {
@@ -214,12 +266,34 @@ func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, med
 						skipped++
 						return nil
 					}
-					files = append(files, mediaFileItem{id: mf.UUID, path: filepath.ToSlash(mf.Path), size: mf.Size, mod: mf.ModifiedAt, name: mf.Name, bucketID: bID, duration: mf.DurationSec, artist: mf.Artist, title: mf.Title})
+					// Best-effort: only probe duration for the items we actually return.
+					if (mediaType == "audio" || mediaType == "video") && mf.DurationSec <= 0 {
+						_, _ = media.EnsureDuration(mf)
+					}
+					// Best-effort: only probe artist for the items we actually return.
+					if mediaType == "audio" && mf.Artist == "" {
+						_, _ = media.EnsureArtist(mf)
+					}
+					// Best-effort: only probe title for the items we actually return.
+					if mediaType == "audio" && mf.Title == "" {
+						_, _ = media.EnsureTitle(mf)
+					}
+					// Best-effort: only probe album tags for the items we actually return.
+					if mediaType == "audio" {
+						_, _ = media.EnsureAudioTags(mf)
+					}
+					files = append(files, mediaFileItem{id: mf.UUID, path: filepath.ToSlash(mf.Path), size: mf.Size, mod: mf.ModifiedAt, name: mf.Name, bucketID: bID, duration: mf.DurationSec, artist: mf.Artist, title: mf.Title, audioTags: mf.AudioTags(), sortKey: string(key[len(prefix):]), cursor: encodeMediaCursor(kind, string(key[len(prefix):])), online: media.VolumeOnline(mf.FSUUID), fsUUID: mf.FSUUID})
 					if len(files) >= limit {
 						return db.ErrIterateStop
 					}
 					return nil
-				})
+				}
+				var iterErr error
+				if afterKey != "" {
+					iterErr = db.GetDefault().IterateAfter(prefix, append(append([]byte{}, prefix...), afterKey...), visit)
+				} else {
+					iterErr = db.GetDefault().Iterate(prefix, visit)
+				}
 				if iterErr == db.ErrIterateStop {
 					iterErr = nil
 				}
@@ -295,23 +369,18 @@ func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, med
 				continue
 			}
 		}
-		files = append(files, mediaFileItem{id: it.UUID, path: filepath.ToSlash(it.Path), size: it.Size, mod: it.ModifiedAt, name: it.Name, bucketID: bID, duration: it.DurationSec, artist: it.Artist, title: it.Title})
+		sortKey := media.TypeIndexSortKey(kind, it.ModifiedAt, it.Size, it.Name, it.UUID)
+		// Keyset pagination: resume after the cursor position.
+		if afterKey != "" && sortKey <= afterKey {
+			continue
+		}
+		files = append(files, mediaFileItem{id: it.UUID, path: filepath.ToSlash(it.Path), size: it.Size, mod: it.ModifiedAt, name: it.Name, bucketID: bID, duration: it.DurationSec, artist: it.Artist, title: it.Title, audioTags: it.AudioTags(), sortKey: sortKey, cursor: encodeMediaCursor(kind, sortKey), online: media.VolumeOnline(it.FSUUID), fsUUID: it.FSUUID})
 	}
 
-	switch sortBy {
-	case model.FileSortByDateAsc:
-		sort.Slice(files, func(i, j int) bool { return files[i].mod < files[j].mod })
-	case model.FileSortByDateDesc:
-		sort.Slice(files, func(i, j int) bool { return files[i].mod > files[j].mod })
-	case model.FileSortBySizeAsc:
-		sort.Slice(files, func(i, j int) bool { return files[i].size < files[j].size })
-	case model.FileSortBySizeDesc:
-		sort.Slice(files, func(i, j int) bool { return files[i].size > files[j].size })
-	case model.FileSortByNameAsc:
-		sort.Slice(files, func(i, j int) bool { return strings.ToLower(files[i].name) < strings.ToLower(files[j].name) })
-	case model.FileSortByNameDesc:
-		sort.Slice(files, func(i, j int) bool { return strings.ToLower(files[i].name) > strings.ToLower(files[j].name) })
-	}
+	// Sort by the type index key so these pages line up with index scans and their cursors.
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].sortKey < files[j].sortKey
+	})
 
 	if offset > 0 && offset < len(files) {
 		files = files[offset:]
@@ -341,6 +410,8 @@ func scanMedia(offset int, limit int, query string, sortBy model.FileSortBy, med
 					_, _ = media.EnsureTitle(mf)
 				}
 				files[i].title = mf.Title
+				_, _ = media.EnsureAudioTags(mf)
+				files[i].audioTags = mf.AudioTags()
 			}
 		}
 	}
@@ -554,15 +625,19 @@ func CountMedia(query string, mediaType string) (int, error) {
 	return count, err
 }
 
-// scanImages walks directories according to query filters and returns Image models.
-func ScanImages(offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Image, error) {
-	files, _ := scanMedia(offset, limit, query, sortBy, "image")
+// ScanImages lists images matching query filters; after resumes from an item's cursor.
+func ScanImages(offset int, limit int, after string, query string, sortBy model.FileSortBy) ([]*model.Image, error) {
+	files, err := scanMedia(offset, limit, after, query, sortBy, "image")
+	if err != nil {
+		return nil, err
+	}
 	ids := make([]string, 0, len(files))
 	for _, f := range files {
 		ids = append(ids, f.id)
 	}
 	tagsByKey, _ := TagHelperInstance.GetTagsByKeys(ids, model.DataTypeImage)
 	out := make([]*model.Image, 0, len(files))
+	var volumes offlineVolumes
 	for _, f := range files {
 		tags := tagsByKey[f.id]
 		if tags == nil {
@@ -578,19 +653,26 @@ func ScanImages(offset int, limit int, query string, sortBy model.FileSortBy) ([
 			CreatedAt: time.Unix(f.mod, 0),
 			UpdatedAt: time.Unix(f.mod, 0),
 			Tags:      tags,
+			Cursor:    f.cursor,
+			Online:    f.online,
+			Volume:    volumes.ref(f.online, f.fsUUID),
 		})
 	}
 	return out, nil
 }
 
-func ScanVideos(offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Video, error) {
-	files, _ := scanMedia(offset, limit, query, sortBy, "video")
+func ScanVideos(offset int, limit int, after string, query string, sortBy model.FileSortBy) ([]*model.Video, error) {
+	files, err := scanMedia(offset, limit, after, query, sortBy, "video")
+	if err != nil {
+		return nil, err
+	}
 	ids := make([]string, 0, len(files))
 	for _, f := range files {
 		ids = append(ids, f.id)
 	}
 	tagsByKey, _ := TagHelperInstance.GetTagsByKeys(ids, model.DataTypeVideo)
 	out := make([]*model.Video, 0, len(files))
+	var volumes offlineVolumes
 	for _, f := range files {
 		tags := tagsByKey[f.id]
 		if tags == nil {
@@ -607,19 +689,26 @@ func ScanVideos(offset int, limit int, query string, sortBy model.FileSortBy) ([
 			CreatedAt: time.Unix(f.mod, 0),
 			UpdatedAt: time.Unix(f.mod, 0),
 			Tags:      tags,
+			Cursor:    f.cursor,
+			Online:    f.online,
+			Volume:    volumes.ref(f.online, f.fsUUID),
 		})
 	}
 	return out, nil
 }
 
-func ScanAudios(offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Audio, error) {
-	files, _ := scanMedia(offset, limit, query, sortBy, "audio")
+func ScanAudios(offset int, limit int, after string, query string, sortBy model.FileSortBy) ([]*model.Audio, error) {
+	files, err := scanMedia(offset, limit, after, query, sortBy, "audio")
+	if err != nil {
+		return nil, err
+	}
 	ids := make([]string, 0, len(files))
 	for _, f := range files {
 		ids = append(ids, f.id)
 	}
 	tagsByKey, _ := TagHelperInstance.GetTagsByKeys(ids, model.DataTypeAudio)
 	out := make([]*model.Audio, 0, len(files))
+	var volumes offlineVolumes
 	for _, f := range files {
 		title := f.title
 		if title == "" {
@@ -646,6 +735,13 @@ func ScanAudios(offset int, limit int, query string, sortBy model.FileSortBy) ([
 			ID:          f.id,
 			Title:       title,
 			Artist:      f.artist,
+			Album:       f.audioTags.Album,
+			AlbumArtist: f.audioTags.AlbumArtist,
+			Track:       f.audioTags.Track,
+			Disc:        f.audioTags.Disc,
+			Year:        f.audioTags.Year,
+			Genre:       f.audioTags.Genre,
+			Composer:    f.audioTags.Composer,
 			Path:        filepath.ToSlash(f.path),
 			Duration:    f.duration,
 			Size:        f.size,
@@ -654,6 +750,9 @@ func ScanAudios(offset int, limit int, query string, sortBy model.FileSortBy) ([
 			CreatedAt:   time.Unix(f.mod, 0),
 			UpdatedAt:   time.Unix(f.mod, 0),
 			Tags:        tags,
+			Cursor:      f.cursor,
+			Online:      f.online,
+			Volume:      volumes.ref(f.online, f.fsUUID),
 		})
 	}
 	return out, nil
@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/graph/model"
+)
+
+// offlineVolumes names the unplugged volumes of offline items. It loads the known volumes and
+// aliases once, on the first offline item of a result list.
+type offlineVolumes struct {
+	loaded  bool
+	known   map[string]db.KnownVolume
+	aliases map[string]string
+}
+
+// ref returns the volume holding an item, or nil when the item is online. Its id and name match
+// the StorageMount listed for the unplugged volume.
+func (v *offlineVolumes) ref(online bool, fsUUID string) *model.VolumeRef {
+	if online || fsUUID == "" {
+		return nil
+	}
+	if !v.loaded {
+		v.known = db.GetKnownVolumes()
+		v.aliases = db.GetVolumeAliasMap()
+		v.loaded = true
+	}
+	r := &model.VolumeRef{ID: "fsuuid:" + fsUUID, UUID: fsUUID, Name: fsUUID}
+	if a := strings.TrimSpace(v.aliases[r.ID]); a != "" {
+		r.Name = a
+	} else if kv, ok := v.known[fsUUID]; ok {
+		r.Name = fmt.Sprintf("usb%d", kv.Slot)
+	}
+	return r
+}
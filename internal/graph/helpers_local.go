@@ -1,11 +1,15 @@
 package graph
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"ismartcoding/plainnas/internal/archive"
+	"ismartcoding/plainnas/internal/fs"
 )
 
 // copyFileContents copies a single file from src to dst.
@@ -29,40 +33,10 @@ func copyFileContents(src, dst string) error {
 	return out.Sync()
 }
 
-type progressReader struct {
-	r      io.Reader
-	onRead func(n int64)
-}
-
-func (pr *progressReader) Read(p []byte) (int, error) {
-	n, err := pr.r.Read(p)
-	if n > 0 && pr.onRead != nil {
-		pr.onRead(int64(n))
-	}
-	return n, err
-}
-
 // copyFileContentsWithProgress copies a single file from src to dst and reports bytes copied.
-// The callback is best-effort and may be called frequently.
-func copyFileContentsWithProgress(src, dst string, onBytes func(n int64)) error {
-	in, err := os.Open(src)
-	if err != nil {
-		return err
-	}
-	defer in.Close()
-	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
-		return err
-	}
-	out, err := os.Create(dst)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = out.Close() }()
-	reader := &progressReader{r: in, onRead: onBytes}
-	if _, err := io.Copy(out, reader); err != nil {
-		return err
-	}
-	return out.Sync()
+// The callback is best-effort and may be called frequently. Canceling ctx stops the copy.
+func copyFileContentsWithProgress(ctx context.Context, src, dst string, onBytes func(n int64)) error {
+	return fs.CopyFile(ctx, src, dst, onBytes)
 }
 
 func makeUniquePathIfExists(dst string, treatAsFile bool) (string, error) {
@@ -81,6 +55,28 @@ func makeUniquePathIfExists(dst string, treatAsFile bool) (string, error) {
 			ext = base[i:]
 		}
 	}
+	return firstFreePath(dir, name, ext)
+}
+
+// makeUniqueArchivePath is makeUniquePathIfExists for an archive of format f: the counter goes
+// before the whole format extension ("backup (1).tar.gz"), so the name still detects as f.
+func makeUniqueArchivePath(dst string, f archive.Format) (string, error) {
+	if _, err := os.Stat(dst); os.IsNotExist(err) {
+		return dst, nil
+	} else if err != nil {
+		return "", err
+	}
+	base := filepath.Base(dst)
+	ext := f.Ext()
+	if ext == "" || len(base) <= len(ext) || !strings.HasSuffix(strings.ToLower(base), ext) {
+		return makeUniquePathIfExists(dst, true)
+	}
+	cut := len(base) - len(ext)
+	return firstFreePath(filepath.Dir(dst), base[:cut], base[cut:])
+}
+
+// firstFreePath returns the first "<name> (<n>)<ext>" in dir that does not exist.
+func firstFreePath(dir, name, ext string) (string, error) {
 	for i := 1; ; i++ {
 		cand := filepath.Join(dir, fmt.Sprintf("%s (%d)%s", name, i, ext))
 		if _, err := os.Stat(cand); os.IsNotExist(err) {
@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"ismartcoding/plainnas/internal/archive"
 )
 
 func TestMakeUniquePathIfExists_Dir(t *testing.T) {
@@ -50,3 +52,22 @@ func TestMakeUniquePathIfExists_File(t *testing.T) {
 		t.Fatalf("got %q want %q", got2, want2)
 	}
 }
+
+func TestMakeUniqueArchivePath_TarGz(t *testing.T) {
+	tmp := t.TempDir()
+	p := filepath.Join(tmp, "backup.tar.gz")
+	if err := os.WriteFile(p, []byte("x"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got, err := makeUniqueArchivePath(p, archive.FormatTarGz)
+	if err != nil {
+		t.Fatalf("makeUniqueArchivePath: %v", err)
+	}
+	want := filepath.Join(tmp, "backup (1).tar.gz")
+	if got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+	if f := archive.DetectFormat(got); f != archive.FormatTarGz {
+		t.Fatalf("DetectFormat(%q) = %q", got, f)
+	}
+}
@@ -0,0 +1,23 @@
+package graph
+
+import (
+	"reflect"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/media"
+)
+
+func setIgnorePatterns(patterns []string) (bool, error) {
+	old := db.GetIgnorePatterns()
+	if err := db.SetIgnorePatterns(patterns); err != nil {
+		return false, err
+	}
+	if !reflect.DeepEqual(old, db.GetIgnorePatterns()) {
+		media.ResetIgnoreMatcher()
+		// Incremental scans skip unchanged directories; let the next ones apply the new patterns everywhere.
+		if err := media.ForgetDirMtimes(); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
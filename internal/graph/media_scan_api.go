@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 
 	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/graph/model"
 	"ismartcoding/plainnas/internal/media"
 	"ismartcoding/plainnas/internal/pkg/eventbus"
 )
@@ -24,6 +25,14 @@ func stopMediaScan() {
 	})
 }
 
+func startMediaScan(root string, incremental bool) {
+	if incremental {
+		media.EnqueueIncrementalScan(root)
+		return
+	}
+	media.EnqueueScan(root)
+}
+
 func rebuildMediaIndex(root string) {
 	media.StopScan()
 	_ = media.ResetAllMediaData()
@@ -35,5 +44,51 @@ func rebuildMediaIndex(root string) {
 		"state":   "running",
 		"root":    filepath.ToSlash(root),
 	})
-	go media.ScanAndSync(root)
+	media.EnqueueScan(root)
+}
+
+func pauseMediaScanJob(id string) (bool, error) {
+	if err := media.PauseScanJob(id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func resumeMediaScanJob(id string) (bool, error) {
+	if err := media.ResumeScanJob(id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func stopMediaScanJob(id string) (bool, error) {
+	if err := media.StopScanJob(id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func mediaScanJobs() []*model.MediaScanJob {
+	jobs := media.ScanJobs()
+	out := make([]*model.MediaScanJob, 0, len(jobs))
+	for _, j := range jobs {
+		errs := j.Errors
+		if errs == nil {
+			errs = []string{}
+		}
+		out = append(out, &model.MediaScanJob{
+			ID:          j.ID,
+			Root:        filepath.ToSlash(j.Root),
+			Incremental: j.Incremental,
+			State:       model.MediaScanJobState(j.State),
+			Indexed:     j.Indexed,
+			Total:       j.Total,
+			Errors:      errs,
+			ErrorCount:  j.ErrorCount,
+			CreatedAt:   j.CreatedAt,
+			StartedAt:   j.StartedAt,
+			FinishedAt:  j.FinishedAt,
+		})
+	}
+	return out
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"reflect"
 
+	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
 )
 
 func setMediaSourceDirsModel(ctx context.Context, dirs []string) (bool, error) {
@@ -14,6 +16,7 @@ func setMediaSourceDirsModel(ctx context.Context, dirs []string) (bool, error) {
 	if !reflect.DeepEqual(old, newDirs) {
 		// Source dirs affect media indexing. If a scan/reindex is in progress, cancel it and restart.
 		rebuildMediaIndex("/")
+		eventbus.GetDefault().Publish(consts.EVENT_MEDIA_SOURCE_DIRS_CHANGED)
 	}
 	return true, nil
 }
@@ -33,17 +33,27 @@ type AppUpdate struct {
 }
 
 type Audio struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Artist      string    `json:"artist"`
-	Path        string    `json:"path"`
-	Duration    int       `json:"duration"`
-	Size        int64     `json:"size"`
-	BucketID    string    `json:"bucketId"`
-	AlbumFileID string    `json:"albumFileId"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
-	Tags        []*Tag    `json:"tags"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Artist      string     `json:"artist"`
+	Album       string     `json:"album"`
+	AlbumArtist string     `json:"albumArtist"`
+	Track       int        `json:"track"`
+	Disc        int        `json:"disc"`
+	Year        int        `json:"year"`
+	Genre       string     `json:"genre"`
+	Composer    string     `json:"composer"`
+	Path        string     `json:"path"`
+	Duration    int        `json:"duration"`
+	Size        int64      `json:"size"`
+	BucketID    string     `json:"bucketId"`
+	AlbumFileID string     `json:"albumFileId"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	Tags        []*Tag     `json:"tags"`
+	Cursor      string     `json:"cursor"`
+	Online      bool       `json:"online"`
+	Volume      *VolumeRef `json:"volume,omitempty"`
 }
 
 type AudioFileInfo struct {
@@ -53,6 +63,13 @@ type AudioFileInfo struct {
 
 func (AudioFileInfo) IsFileInfoData() {}
 
+type BackupResult struct {
+	Path          string `json:"path"`
+	Keys          int    `json:"keys"`
+	Size          int64  `json:"size"`
+	SchemaVersion int    `json:"schemaVersion"`
+}
+
 type DeviceInfo struct {
 	Hostname         string     `json:"hostname"`
 	Os               string     `json:"os"`
@@ -101,12 +118,17 @@ type FavoriteFolder struct {
 }
 
 type File struct {
-	Path       string    `json:"path"`
-	IsDir      bool      `json:"isDir"`
-	CreatedAt  time.Time `json:"createdAt"`
-	UpdatedAt  time.Time `json:"updatedAt"`
-	Size       int64     `json:"size"`
-	ChildCount int       `json:"childCount"`
+	Path           string       `json:"path"`
+	IsDir          bool         `json:"isDir"`
+	CreatedAt      time.Time    `json:"createdAt"`
+	UpdatedAt      time.Time    `json:"updatedAt"`
+	Size           int64        `json:"size"`
+	ChildCount     int          `json:"childCount"`
+	Snippet        *string      `json:"snippet,omitempty"`
+	NameMatches    []*TextRange `json:"nameMatches,omitempty"`
+	SnippetMatches []*TextRange `json:"snippetMatches,omitempty"`
+	Online         bool         `json:"online"`
+	Volume         *VolumeRef   `json:"volume,omitempty"`
 }
 
 // Detailed info for a single media file used by the lightbox UI.
@@ -138,20 +160,45 @@ type FileTaskOpInput struct {
 	Overwrite bool   `json:"overwrite"`
 }
 
+type FsckCount struct {
+	Check    string `json:"check"`
+	Problem  string `json:"problem"`
+	Count    int    `json:"count"`
+	Repaired int    `json:"repaired"`
+}
+
+type FsckIssue struct {
+	Check    string `json:"check"`
+	Key      string `json:"key"`
+	Problem  string `json:"problem"`
+	Repaired bool   `json:"repaired"`
+}
+
+type FsckReport struct {
+	Apply     bool         `json:"apply"`
+	Entries   int          `json:"entries"`
+	Issues    []*FsckIssue `json:"issues"`
+	Counts    []*FsckCount `json:"counts"`
+	Truncated bool         `json:"truncated"`
+}
+
 type GeoLocation struct {
 	Latitude  *float64 `json:"latitude,omitempty"`
 	Longitude *float64 `json:"longitude,omitempty"`
 }
 
 type Image struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	Path      string    `json:"path"`
-	Size      int64     `json:"size"`
-	BucketID  string    `json:"bucketId"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
-	Tags      []*Tag    `json:"tags"`
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Path      string     `json:"path"`
+	Size      int64      `json:"size"`
+	BucketID  string     `json:"bucketId"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	Tags      []*Tag     `json:"tags"`
+	Cursor    string     `json:"cursor"`
+	Online    bool       `json:"online"`
+	Volume    *VolumeRef `json:"volume,omitempty"`
 }
 
 type ImageFileInfo struct {
@@ -174,6 +221,20 @@ type MediaBucket struct {
 	TopItems  []string `json:"topItems"`
 }
 
+type MediaScanJob struct {
+	ID          string            `json:"id"`
+	Root        string            `json:"root"`
+	Incremental bool              `json:"incremental"`
+	State       MediaScanJobState `json:"state"`
+	Indexed     int64             `json:"indexed"`
+	Total       int64             `json:"total"`
+	Errors      []string          `json:"errors"`
+	ErrorCount  int               `json:"errorCount"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	StartedAt   *time.Time        `json:"startedAt,omitempty"`
+	FinishedAt  *time.Time        `json:"finishedAt,omitempty"`
+}
+
 type Mutation struct {
 }
 
@@ -258,21 +319,22 @@ type StorageDisk struct {
 }
 
 type StorageMount struct {
-	ID           string  `json:"id"`
-	Name         string  `json:"name"`
-	Path         *string `json:"path,omitempty"`
-	PartitionNum *int    `json:"partitionNum,omitempty"`
-	Label        *string `json:"label,omitempty"`
-	UUID         *string `json:"uuid,omitempty"`
-	MountPoint   *string `json:"mountPoint,omitempty"`
-	FsType       *string `json:"fsType,omitempty"`
-	TotalBytes   int64   `json:"totalBytes"`
-	UsedBytes    *int64  `json:"usedBytes,omitempty"`
-	FreeBytes    *int64  `json:"freeBytes,omitempty"`
-	Alias        *string `json:"alias,omitempty"`
-	Remote       bool    `json:"remote"`
-	DriveType    *string `json:"driveType,omitempty"`
-	DiskID       *string `json:"diskID,omitempty"`
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Path         *string    `json:"path,omitempty"`
+	PartitionNum *int       `json:"partitionNum,omitempty"`
+	Label        *string    `json:"label,omitempty"`
+	UUID         *string    `json:"uuid,omitempty"`
+	MountPoint   *string    `json:"mountPoint,omitempty"`
+	FsType       *string    `json:"fsType,omitempty"`
+	TotalBytes   int64      `json:"totalBytes"`
+	UsedBytes    *int64     `json:"usedBytes,omitempty"`
+	FreeBytes    *int64     `json:"freeBytes,omitempty"`
+	Alias        *string    `json:"alias,omitempty"`
+	Remote       bool       `json:"remote"`
+	DriveType    *string    `json:"driveType,omitempty"`
+	DiskID       *string    `json:"diskID,omitempty"`
+	LastSeen     *time.Time `json:"lastSeen,omitempty"`
 }
 
 type Tag struct {
@@ -293,16 +355,24 @@ type TempValue struct {
 	Value string `json:"value"`
 }
 
+type TextRange struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
 type Video struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	Path      string    `json:"path"`
-	Duration  int       `json:"duration"`
-	Size      int64     `json:"size"`
-	BucketID  string    `json:"bucketId"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
-	Tags      []*Tag    `json:"tags"`
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Path      string     `json:"path"`
+	Duration  int        `json:"duration"`
+	Size      int64      `json:"size"`
+	BucketID  string     `json:"bucketId"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	Tags      []*Tag     `json:"tags"`
+	Cursor    string     `json:"cursor"`
+	Online    bool       `json:"online"`
+	Volume    *VolumeRef `json:"volume,omitempty"`
 }
 
 type VideoFileInfo struct {
@@ -314,6 +384,57 @@ type VideoFileInfo struct {
 
 func (VideoFileInfo) IsFileInfoData() {}
 
+type VolumeRef struct {
+	ID   string `json:"id"`
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+type ArchiveFormat string
+
+const (
+	ArchiveFormatZip    ArchiveFormat = "ZIP"
+	ArchiveFormatTar    ArchiveFormat = "TAR"
+	ArchiveFormatTarGz  ArchiveFormat = "TAR_GZ"
+	ArchiveFormatTarZst ArchiveFormat = "TAR_ZST"
+)
+
+var AllArchiveFormat = []ArchiveFormat{
+	ArchiveFormatZip,
+	ArchiveFormatTar,
+	ArchiveFormatTarGz,
+	ArchiveFormatTarZst,
+}
+
+func (e ArchiveFormat) IsValid() bool {
+	switch e {
+	case ArchiveFormatZip, ArchiveFormatTar, ArchiveFormatTarGz, ArchiveFormatTarZst:
+		return true
+	}
+	return false
+}
+
+func (e ArchiveFormat) String() string {
+	return string(e)
+}
+
+func (e *ArchiveFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ArchiveFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ArchiveFormat", str)
+	}
+	return nil
+}
+
+func (e ArchiveFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type DataType string
 
 const (
@@ -411,10 +532,11 @@ func (e FileSortBy) MarshalGQL(w io.Writer) {
 type FileTaskStatus string
 
 const (
-	FileTaskStatusQueued  FileTaskStatus = "QUEUED"
-	FileTaskStatusRunning FileTaskStatus = "RUNNING"
-	FileTaskStatusDone    FileTaskStatus = "DONE"
-	FileTaskStatusError   FileTaskStatus = "ERROR"
+	FileTaskStatusQueued   FileTaskStatus = "QUEUED"
+	FileTaskStatusRunning  FileTaskStatus = "RUNNING"
+	FileTaskStatusDone     FileTaskStatus = "DONE"
+	FileTaskStatusError    FileTaskStatus = "ERROR"
+	FileTaskStatusCanceled FileTaskStatus = "CANCELED"
 )
 
 var AllFileTaskStatus = []FileTaskStatus{
@@ -422,11 +544,12 @@ var AllFileTaskStatus = []FileTaskStatus{
 	FileTaskStatusRunning,
 	FileTaskStatusDone,
 	FileTaskStatusError,
+	FileTaskStatusCanceled,
 }
 
 func (e FileTaskStatus) IsValid() bool {
 	switch e {
-	case FileTaskStatusQueued, FileTaskStatusRunning, FileTaskStatusDone, FileTaskStatusError:
+	case FileTaskStatusQueued, FileTaskStatusRunning, FileTaskStatusDone, FileTaskStatusError, FileTaskStatusCanceled:
 		return true
 	}
 	return false
@@ -456,18 +579,20 @@ func (e FileTaskStatus) MarshalGQL(w io.Writer) {
 type FileTaskType string
 
 const (
-	FileTaskTypeCopy FileTaskType = "COPY"
-	FileTaskTypeMove FileTaskType = "MOVE"
+	FileTaskTypeCopy    FileTaskType = "COPY"
+	FileTaskTypeMove    FileTaskType = "MOVE"
+	FileTaskTypeArchive FileTaskType = "ARCHIVE"
 )
 
 var AllFileTaskType = []FileTaskType{
 	FileTaskTypeCopy,
 	FileTaskTypeMove,
+	FileTaskTypeArchive,
 }
 
 func (e FileTaskType) IsValid() bool {
 	switch e {
-	case FileTaskTypeCopy, FileTaskTypeMove:
+	case FileTaskTypeCopy, FileTaskTypeMove, FileTaskTypeArchive:
 		return true
 	}
 	return false
@@ -537,6 +662,55 @@ func (e MediaPlayMode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type MediaScanJobState string
+
+const (
+	MediaScanJobStateQueued  MediaScanJobState = "QUEUED"
+	MediaScanJobStateRunning MediaScanJobState = "RUNNING"
+	MediaScanJobStatePaused  MediaScanJobState = "PAUSED"
+	MediaScanJobStateStopped MediaScanJobState = "STOPPED"
+	MediaScanJobStateDone    MediaScanJobState = "DONE"
+	MediaScanJobStateError   MediaScanJobState = "ERROR"
+)
+
+var AllMediaScanJobState = []MediaScanJobState{
+	MediaScanJobStateQueued,
+	MediaScanJobStateRunning,
+	MediaScanJobStatePaused,
+	MediaScanJobStateStopped,
+	MediaScanJobStateDone,
+	MediaScanJobStateError,
+}
+
+func (e MediaScanJobState) IsValid() bool {
+	switch e {
+	case MediaScanJobStateQueued, MediaScanJobStateRunning, MediaScanJobStatePaused, MediaScanJobStateStopped, MediaScanJobStateDone, MediaScanJobStateError:
+		return true
+	}
+	return false
+}
+
+func (e MediaScanJobState) String() string {
+	return string(e)
+}
+
+func (e *MediaScanJobState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MediaScanJobState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MediaScanJobState", str)
+	}
+	return nil
+}
+
+func (e MediaScanJobState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SambaShareAuth string
 
 const (
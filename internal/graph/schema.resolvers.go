@@ -47,6 +47,11 @@ func (r *mutationResolver) SetMediaSourceDirs(ctx context.Context, dirs []string
 	return setMediaSourceDirsModel(ctx, dirs)
 }
 
+// SetIgnorePatterns is the resolver for the setIgnorePatterns field.
+func (r *mutationResolver) SetIgnorePatterns(ctx context.Context, patterns []string) (bool, error) {
+	return setIgnorePatterns(patterns)
+}
+
 // SetSambaSettings is the resolver for the setSambaSettings field.
 func (r *mutationResolver) SetSambaSettings(ctx context.Context, input model.SambaSettingsInput) (bool, error) {
 	return setSambaSettings(ctx, input)
@@ -107,6 +112,16 @@ func (r *mutationResolver) CreateMoveTask(ctx context.Context, ops []*model.File
 	return createMoveTaskModel(ctx, ops)
 }
 
+// CreateArchive is the resolver for the createArchive field.
+func (r *mutationResolver) CreateArchive(ctx context.Context, paths []string, dst string, format model.ArchiveFormat, level int) (*model.FileTask, error) {
+	return createArchiveTaskModel(ctx, paths, dst, format, level)
+}
+
+// CancelFileTask is the resolver for the cancelFileTask field.
+func (r *mutationResolver) CancelFileTask(ctx context.Context, id string) (bool, error) {
+	return cancelFileTaskModel(ctx, id)
+}
+
 // DeleteFiles is the resolver for the deleteFiles field.
 func (r *mutationResolver) DeleteFiles(ctx context.Context, paths []string) (bool, error) {
 	return deleteFiles(paths)
@@ -135,13 +150,18 @@ func (r *mutationResolver) SetTempValue(ctx context.Context, key string, value s
 }
 
 // MergeChunks is the resolver for the mergeChunks field.
-func (r *mutationResolver) MergeChunks(ctx context.Context, fileID string, totalChunks int, path string, replace bool) (string, error) {
-	return mergeChunks(fileID, totalChunks, path, replace)
+func (r *mutationResolver) MergeChunks(ctx context.Context, fileID string, totalChunks int, path string, replace bool, batchID string, batchTotal int, batchDir string) (string, error) {
+	return mergeChunks(ctx, fileID, totalChunks, path, replace, batchID, batchTotal, batchDir)
+}
+
+// UploadByHash is the resolver for the uploadByHash field.
+func (r *mutationResolver) UploadByHash(ctx context.Context, hash string, size int64, path string, replace bool) (string, error) {
+	return uploadByHash(hash, size, path, replace)
 }
 
 // StartMediaScan is the resolver for the startMediaScan field.
-func (r *mutationResolver) StartMediaScan(ctx context.Context, root string) (bool, error) {
-	go media.ScanAndSync(root)
+func (r *mutationResolver) StartMediaScan(ctx context.Context, root string, incremental bool) (bool, error) {
+	startMediaScan(root, incremental)
 	return true, nil
 }
 
@@ -163,6 +183,21 @@ func (r *mutationResolver) StopMediaScan(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// PauseMediaScanJob is the resolver for the pauseMediaScanJob field.
+func (r *mutationResolver) PauseMediaScanJob(ctx context.Context, id string) (bool, error) {
+	return pauseMediaScanJob(id)
+}
+
+// ResumeMediaScanJob is the resolver for the resumeMediaScanJob field.
+func (r *mutationResolver) ResumeMediaScanJob(ctx context.Context, id string) (bool, error) {
+	return resumeMediaScanJob(id)
+}
+
+// StopMediaScanJob is the resolver for the stopMediaScanJob field.
+func (r *mutationResolver) StopMediaScanJob(ctx context.Context, id string) (bool, error) {
+	return stopMediaScanJob(id)
+}
+
 // RebuildMediaIndex is the resolver for the rebuildMediaIndex field.
 func (r *mutationResolver) RebuildMediaIndex(ctx context.Context, root string) (bool, error) {
 	rebuildMediaIndex(root)
@@ -254,6 +289,16 @@ func (r *mutationResolver) FormatDisk(ctx context.Context, path string) (bool, e
 	return formatDisk(ctx, path)
 }
 
+// Fsck is the resolver for the fsck field.
+func (r *mutationResolver) Fsck(ctx context.Context, apply bool) (*model.FsckReport, error) {
+	return runFsck(ctx, apply)
+}
+
+// Backup is the resolver for the backup field.
+func (r *mutationResolver) Backup(ctx context.Context, path string, excludeCaches bool) (*model.BackupResult, error) {
+	return createBackup(ctx, path, excludeCaches)
+}
+
 // DlnaCast is the resolver for the dlnaCast field.
 func (r *mutationResolver) DlnaCast(ctx context.Context, rendererUdn string, url string, title string, mime string, typeArg model.DataType) (bool, error) {
 	return dlnaCastModel(ctx, rendererUdn, url, title, mime, typeArg)
@@ -299,15 +344,25 @@ func (r *queryResolver) MediaSourceDirs(ctx context.Context) ([]string, error) {
 	return db.GetMediaSourceDirs(), nil
 }
 
+// IgnorePatterns is the resolver for the ignorePatterns field.
+func (r *queryResolver) IgnorePatterns(ctx context.Context) ([]string, error) {
+	return db.GetIgnorePatterns(), nil
+}
+
+// MediaScanJobs is the resolver for the mediaScanJobs field.
+func (r *queryResolver) MediaScanJobs(ctx context.Context) ([]*model.MediaScanJob, error) {
+	return mediaScanJobs(), nil
+}
+
 // SambaSettings is the resolver for the sambaSettings field.
 func (r *queryResolver) SambaSettings(ctx context.Context) (*model.SambaSettings, error) {
 	return sambaSettings(ctx)
 }
 
 // Images is the resolver for the images field.
-func (r *queryResolver) Images(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Image, error) {
-	items, _ := helpers.ScanImages(offset, limit, query, sortBy)
-	return items, nil
+func (r *queryResolver) Images(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) ([]*model.Image, error) {
+	limit, cursor := helpers.MediaPageArgs(limit, after, first)
+	return helpers.ScanImages(offset, limit, cursor, query, sortBy)
 }
 
 // ImageCount is the resolver for the imageCount field.
@@ -331,15 +386,15 @@ func (r *queryResolver) MediaBuckets(ctx context.Context, typeArg model.DataType
 }
 
 // Videos is the resolver for the videos field.
-func (r *queryResolver) Videos(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Video, error) {
-	items, _ := helpers.ScanVideos(offset, limit, query, sortBy)
-	return items, nil
+func (r *queryResolver) Videos(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) ([]*model.Video, error) {
+	limit, cursor := helpers.MediaPageArgs(limit, after, first)
+	return helpers.ScanVideos(offset, limit, cursor, query, sortBy)
 }
 
 // Audios is the resolver for the audios field.
-func (r *queryResolver) Audios(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy) ([]*model.Audio, error) {
-	items, _ := helpers.ScanAudios(offset, limit, query, sortBy)
-	return items, nil
+func (r *queryResolver) Audios(ctx context.Context, offset int, limit int, query string, sortBy model.FileSortBy, after *string, first *int) ([]*model.Audio, error) {
+	limit, cursor := helpers.MediaPageArgs(limit, after, first)
+	return helpers.ScanAudios(offset, limit, cursor, query, sortBy)
 }
 
 // AudioCount is the resolver for the audioCount field.
@@ -392,6 +447,11 @@ func (r *queryResolver) RecentFiles(ctx context.Context) ([]*model.File, error)
 	return recentFiles()
 }
 
+// ArchiveEntries is the resolver for the archiveEntries field.
+func (r *queryResolver) ArchiveEntries(ctx context.Context, path string, prefix string) ([]*model.File, error) {
+	return archiveEntries(path, prefix)
+}
+
 // TrashCount is the resolver for the trashCount field.
 func (r *queryResolver) TrashCount(ctx context.Context) (int, error) {
 	return trashCount()
@@ -402,6 +462,11 @@ func (r *queryResolver) UploadedChunks(ctx context.Context, fileID string) ([]in
 	return uploadedChunks(fileID)
 }
 
+// CheckUploadHashes is the resolver for the checkUploadHashes field.
+func (r *queryResolver) CheckUploadHashes(ctx context.Context, hashes []string, dir string) ([]string, error) {
+	return checkUploadHashes(hashes, dir), nil
+}
+
 // DlnaRenderers is the resolver for the dlnaRenderers field.
 func (r *queryResolver) DlnaRenderers(ctx context.Context) ([]*model.DlnaRenderer, error) {
 	return dlnaRenderersModel(ctx)
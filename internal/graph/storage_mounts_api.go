@@ -13,7 +13,8 @@ const volumeIDFSUUIDPrefix = "fsuuid:"
 const volumeIDDevPrefix = "dev:"
 const mountIDPartitionPrefix = "part:"
 
-// ListMounts returns both mounted volumes and disk partitions.
+// ListMounts returns mounted volumes, disk partitions and known volumes that are
+// currently unplugged (see withKnownVolumes).
 //
 // The list is intentionally "flat"; the UI is expected to correlate mounts with
 // disks via StorageMount.diskID and StorageMount.path.
@@ -25,9 +26,9 @@ func ListMounts() ([]*model.StorageMount, error) {
 	parts, perr := listDiskPartitions()
 	if perr != nil {
 		// Best-effort: still return mounted volumes.
-		return mounted, nil
+		return withKnownVolumes(mounted), nil
 	}
-	return append(mounted, parts...), nil
+	return withKnownVolumes(append(mounted, parts...)), nil
 }
 
 func setMountAlias(id string, alias string) (bool, error) {
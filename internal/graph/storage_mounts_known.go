@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/graph/model"
+)
+
+// withKnownVolumes sets lastSeen on the listed volumes that are known (see db.KnownVolume) and
+// appends the known volumes that are unplugged. Those have no mountPoint or path; their files
+// stay searchable as offline catalog.
+func withKnownVolumes(list []*model.StorageMount) []*model.StorageMount {
+	known := db.GetKnownVolumes()
+	if len(known) == 0 {
+		return list
+	}
+	now := time.Now()
+	present := map[string]struct{}{}
+	for _, v := range list {
+		if v.UUID == nil {
+			continue
+		}
+		id := strings.TrimSpace(*v.UUID)
+		present[id] = struct{}{}
+		if _, ok := known[id]; ok && v.MountPoint != nil {
+			t := now
+			v.LastSeen = &t
+		}
+	}
+
+	ids := make([]string, 0, len(known))
+	for id := range known {
+		if _, ok := present[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return known[ids[i]].Slot < known[ids[j]].Slot })
+
+	aliasMap := db.GetVolumeAliasMap()
+	for _, id := range ids {
+		kv := known[id]
+		uuid := id
+		lastSeen := time.Unix(kv.LastSeen, 0)
+		v := &model.StorageMount{
+			ID:         volumeIDFSUUIDPrefix + id,
+			Name:       fmt.Sprintf("usb%d", kv.Slot),
+			UUID:       &uuid,
+			TotalBytes: int64(kv.SizeBytes),
+			LastSeen:   &lastSeen,
+		}
+		if kv.FSType != "" {
+			ft := kv.FSType
+			v.FsType = &ft
+		}
+		if a, ok := aliasMap[v.ID]; ok && strings.TrimSpace(a) != "" {
+			val := a
+			v.Alias = &val
+		}
+		list = append(list, v)
+	}
+	return list
+}
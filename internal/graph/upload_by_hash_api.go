@@ -0,0 +1,13 @@
+package graph
+
+import (
+	"ismartcoding/plainnas/internal/upload"
+)
+
+func uploadByHash(hash string, size int64, path string, replace bool) (string, error) {
+	return upload.InstantUpload(hash, size, path, replace)
+}
+
+func checkUploadHashes(hashes []string, dir string) []string {
+	return upload.CheckHashes(hashes, dir)
+}
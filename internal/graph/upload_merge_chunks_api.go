@@ -1,69 +1,28 @@
 package graph
 
 import (
-	"fmt"
-	"io"
+	"context"
 	"os"
 	"path/filepath"
-	"strings"
 
-	"ismartcoding/plainnas/internal/consts"
-	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/upload"
 )
 
-func mergeChunks(fileID string, totalChunks int, path string, replace bool) (string, error) {
-	if strings.TrimSpace(fileID) == "" || strings.TrimSpace(path) == "" || totalChunks <= 0 {
-		return "", fmt.Errorf("invalid arguments")
-	}
-
-	base := filepath.Join(consts.DATA_DIR, "upload_tmp", fileID)
-	// ensure destination path
-	dest := filepath.Clean(path)
-	if !replace {
-		if _, err := os.Stat(dest); err == nil {
-			dir := filepath.Dir(dest)
-			baseName := filepath.Base(dest)
-			name := baseName
-			ext := ""
-			if i := strings.LastIndex(baseName, "."); i > 0 {
-				name = baseName[:i]
-				ext = baseName[i:]
-			}
-			for i := 1; ; i++ {
-				cand := filepath.Join(dir, fmt.Sprintf("%s (%d)%s", name, i, ext))
-				if _, e := os.Stat(cand); os.IsNotExist(e) {
-					dest = cand
-					break
-				}
-			}
-		}
-	}
-
-	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
-		return "", err
-	}
-	out, err := os.Create(dest)
+func mergeChunks(ctx context.Context, fileID string, totalChunks int, path string, replace bool, batchID string, batchTotal int, batchDir string) (string, error) {
+	clientID, err := getClientIDFromContext(ctx)
 	if err != nil {
 		return "", err
 	}
-	defer out.Close()
-
-	for i := 0; i < totalChunks; i++ {
-		chunkPath := filepath.Join(base, fmt.Sprintf("chunk_%d", i))
-		f, e := os.Open(chunkPath)
-		if e != nil {
-			return "", fmt.Errorf("missing chunk %d", i)
+	name, replaced, err := upload.MergeChunks(fileID, totalChunks, path, replace)
+	result := upload.BatchFile{Path: upload.BatchPath(batchDir, path), Err: err}
+	if err == nil {
+		dest := filepath.Join(filepath.Dir(filepath.Clean(path)), name)
+		if fi, err := os.Stat(dest); err == nil {
+			result.Size = fi.Size()
 		}
-		if _, e = io.Copy(out, f); e != nil {
-			f.Close()
-			return "", e
-		}
-		f.Close()
+		result.Replaced = replaced
+		result.Renamed = name != filepath.Base(path)
 	}
-
-	// cleanup chunk dir
-	_ = os.RemoveAll(base)
-	// index media
-	_ = media.ScanFile(dest)
-	return filepath.Base(dest), nil
+	upload.RecordBatchFile(clientID, batchID, batchTotal, batchDir, result)
+	return name, err
 }
@@ -0,0 +1,214 @@
+// Package ignore implements .plainnasignore files: gitignore-syntax rules that keep paths out of
+// media scans and the file search index.
+package ignore
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// FileName is the name of per-directory ignore files. Their rules apply to the directory they are
+// in and everything below it.
+const FileName = ".plainnasignore"
+
+type rule struct {
+	base     string   // directory the rule is relative to
+	segments []string // pattern split at "/"
+	negate   bool     // "!pattern" re-includes
+	dirOnly  bool     // "pattern/" only matches directories
+	anchored bool     // a pattern with a "/" is matched from base, otherwise by name at any depth
+}
+
+// Matcher decides whether paths are ignored by the global patterns and the .plainnasignore files
+// of their ancestor directories. Ignore files are read once per directory and cached, so a
+// Matcher from New lives for one walk; one from NewLive may be kept. It is safe for concurrent
+// use.
+type Matcher struct {
+	global []rule
+	// live matchers check that a cached ignore file is unchanged before using its rules.
+	live bool
+	mu   sync.Mutex
+	dirs map[string]dirRules
+}
+
+type dirRules struct {
+	rules []rule
+	// mod and size of the ignore file when it was read; zero when there was none.
+	mod  int64
+	size int64
+}
+
+// maxLiveDirs bounds the directories a live Matcher caches; it starts over when exceeded.
+const maxLiveDirs = 4096
+
+// New returns a Matcher with the given global patterns, which are relative to "/" and so match
+// by name anywhere unless they contain a "/".
+func New(global []string) *Matcher {
+	return &Matcher{global: parse(string(filepath.Separator), global), dirs: map[string]dirRules{}}
+}
+
+// NewLive returns a Matcher like New for long-lived use: it stats the ignore files it has read
+// on every match and re-reads those whose mtime or size changed.
+func NewLive(global []string) *Matcher {
+	m := New(global)
+	m.live = true
+	return m
+}
+
+// Match reports whether p is ignored by the rules of its ancestors, assuming none of its
+// ancestor directories is ignored. Walks call it for each entry and skip ignored directories.
+func (m *Matcher) Match(p string, isDir bool) bool {
+	p = filepath.Clean(p)
+	ignored := false
+	check := func(rules []rule) {
+		for i := range rules {
+			if rules[i].matches(p, isDir) {
+				ignored = !rules[i].negate
+			}
+		}
+	}
+	check(m.global)
+	for _, dir := range ancestors(filepath.Dir(p)) {
+		check(m.rulesOf(dir))
+	}
+	return ignored
+}
+
+// Ignored reports whether p or one of its ancestor directories is ignored.
+func (m *Matcher) Ignored(p string, isDir bool) bool {
+	p = filepath.Clean(p)
+	for _, dir := range ancestors(filepath.Dir(p)) {
+		if dir != string(filepath.Separator) && m.Match(dir, true) {
+			return true
+		}
+	}
+	return m.Match(p, isDir)
+}
+
+func (m *Matcher) rulesOf(dir string) []rule {
+	var mod, size int64
+	if m.live {
+		if fi, err := os.Stat(filepath.Join(dir, FileName)); err == nil {
+			mod, size = fi.ModTime().UnixNano(), fi.Size()
+		}
+	}
+	m.mu.Lock()
+	e, ok := m.dirs[dir]
+	m.mu.Unlock()
+	if ok && e.mod == mod && e.size == size {
+		return e.rules
+	}
+	e = dirRules{rules: readFile(dir), mod: mod, size: size}
+	m.mu.Lock()
+	if m.live && len(m.dirs) >= maxLiveDirs {
+		m.dirs = map[string]dirRules{}
+	}
+	m.dirs[dir] = e
+	m.mu.Unlock()
+	return e.rules
+}
+
+// ancestors lists dir and its parents, outermost first.
+func ancestors(dir string) []string {
+	var out []string
+	for {
+		out = append(out, dir)
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
+
+func readFile(dir string) []rule {
+	f, err := os.Open(filepath.Join(dir, FileName))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	var lines []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		lines = append(lines, s.Text())
+	}
+	return parse(dir, lines)
+}
+
+func parse(base string, lines []string) []rule {
+	var rules []rule
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r := rule{base: base}
+		if strings.HasPrefix(line, "!") {
+			r.negate = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			r.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if strings.Contains(line, "/") {
+			r.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		r.segments = strings.Split(line, "/")
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+func (r *rule) matches(p string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+	rel, err := filepath.Rel(r.base, p)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	if !r.anchored {
+		ok, _ := path.Match(r.segments[0], path.Base(rel))
+		return ok
+	}
+	return matchSegments(r.segments, strings.Split(rel, "/"))
+}
+
+// matchSegments matches path segments against pattern segments, where "**" matches any number
+// of segments.
+func matchSegments(pattern, segs []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segs); i++ {
+				if matchSegments(pattern[1:], segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segs[0]); !ok {
+			return false
+		}
+		pattern, segs = pattern[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
@@ -0,0 +1,75 @@
+package ignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMatcher(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		p := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(FileName, "# build output\n*.tmp\n/cache/\ndocs/**/draft-*\nlogs/\n!keep.tmp\n")
+	write("photos/"+FileName, "raw/\n!important.tmp\n")
+
+	m := New([]string{"node_modules/", "*.vmdk"})
+	cases := []struct {
+		rel   string
+		isDir bool
+		want  bool
+	}{
+		{"a.tmp", false, true},
+		{"sub/deep/b.tmp", false, true},
+		{"keep.tmp", false, false},
+		{"cache", true, true},
+		{"sub/cache", true, false}, // anchored to the ignore file's directory
+		{"cache", false, false},    // directories only
+		{"docs/x/y/draft-1.md", false, true},
+		{"docs/draft-2.md", false, true},
+		{"docs/final.md", false, false},
+		{"logs", true, true},
+		{"photos/raw", true, true},
+		{"photos/important.tmp", false, false},
+		{"raw", true, false},
+		{"app/node_modules", true, true},
+		{"vm/disk.vmdk", false, true},
+		{"photo.jpg", false, false},
+	}
+	for _, c := range cases {
+		if got := m.Match(filepath.Join(root, c.rel), c.isDir); got != c.want {
+			t.Errorf("Match(%s, dir=%v) = %v, want %v", c.rel, c.isDir, got, c.want)
+		}
+	}
+	if !m.Ignored(filepath.Join(root, "app/node_modules/pkg/index.js"), false) {
+		t.Error("file below an ignored directory is not ignored")
+	}
+	if m.Ignored(filepath.Join(root, "photos/img.jpg"), false) {
+		t.Error("photos/img.jpg is ignored")
+	}
+}
+
+func TestNewLiveRereadsChangedFiles(t *testing.T) {
+	root := t.TempDir()
+	p := filepath.Join(root, FileName)
+	if err := os.WriteFile(p, []byte("*.tmp\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m := NewLive(nil)
+	if !m.Match(filepath.Join(root, "a.tmp"), false) {
+		t.Fatal("a.tmp is not ignored")
+	}
+	if err := os.WriteFile(p, []byte("*.log\n*.bak\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if m.Match(filepath.Join(root, "a.tmp"), false) || !m.Match(filepath.Join(root, "a.log"), false) {
+		t.Fatal("changed ignore file was not re-read")
+	}
+}
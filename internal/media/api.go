@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"syscall"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/ignore"
 )
 
 // Public API
@@ -17,12 +21,41 @@ func SearchFiles(query string, filters map[string]string, offset int, limit int)
 
 func GetFileByUUID(uuid string) (*MediaFile, error) { return GetFile(uuid) }
 
-// UpsertPath creates/updates a single file entry (used by watcher or uploads)
+var (
+	ignoreMu sync.Mutex
+	// pathIgnore is the matcher of single-file upserts; nil until first used or after
+	// ResetIgnoreMatcher.
+	pathIgnore *ignore.Matcher
+)
+
+// upsertIgnore returns the matcher UpsertPath checks paths against. It follows edits of
+// .plainnasignore files by itself; global pattern changes go through ResetIgnoreMatcher.
+func upsertIgnore() *ignore.Matcher {
+	ignoreMu.Lock()
+	defer ignoreMu.Unlock()
+	if pathIgnore == nil {
+		pathIgnore = ignore.NewLive(db.GetIgnorePatterns())
+	}
+	return pathIgnore
+}
+
+// ResetIgnoreMatcher makes the next single-file upserts use the current global ignore patterns.
+func ResetIgnoreMatcher() {
+	ignoreMu.Lock()
+	pathIgnore = nil
+	ignoreMu.Unlock()
+}
+
+// UpsertPath creates/updates a single file entry (used by watcher or uploads). Ignored paths
+// (see internal/ignore) are not added.
 func UpsertPath(path string) error {
 	info, err := os.Stat(path)
 	if err != nil {
 		return err
 	}
+	if upsertIgnore().Ignored(path, info.IsDir()) {
+		return nil
+	}
 	st, ok := info.Sys().(*syscall.Stat_t)
 	if !ok || st == nil {
 		return fmt.Errorf("unsupported fileinfo stat on %s", path)
@@ -0,0 +1,216 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/log"
+)
+
+func (m *MediaFile) hashValid() bool {
+	return m.SHA256 != "" && m.HashRefMod == m.ModifiedAt && m.HashRefSize == m.Size
+}
+
+// ValidContentHash reports whether sum looks like a hex SHA-256 digest.
+func ValidContentHash(sum string) bool {
+	if len(sum) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(sum)
+	return err == nil
+}
+
+// HashFile returns the hex SHA-256 of the file at path.
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+const (
+	// defaultHashMaxFileSize skips files too large to be worth hashing in the background.
+	defaultHashMaxFileSize = 2 << 30
+	// defaultHashThrottle is the pause between two hashed files.
+	defaultHashThrottle = 50 * time.Millisecond
+)
+
+func hashMaxFileSize() int64 {
+	if n := config.GetDefault().GetInt("media.hash_max_file_size_mb"); n > 0 {
+		return int64(n) << 20
+	}
+	return defaultHashMaxFileSize
+}
+
+func hashThrottle() time.Duration {
+	if v := config.GetDefault().GetString("media.hash_throttle_ms"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			return time.Duration(n) * time.Millisecond
+		}
+	}
+	return defaultHashThrottle
+}
+
+// hashWake is signalled when a scan finished, so the hasher picks up the new files.
+var hashWake = make(chan struct{}, 1)
+
+func wakeHasher() {
+	select {
+	case hashWake <- struct{}{}:
+	default:
+	}
+}
+
+var hashMu sync.Mutex
+
+// HashPendingMedia computes the content hash of indexed images, videos and audio that have no
+// valid one, so re-uploads of a camera roll can be deduplicated. Scans only record stat data;
+// files are hashed here one at a time with a pause in between, and files larger than
+// media.hash_max_file_size_mb are left to upload-time hashing. It returns ctx.Err() when
+// cancelled; hashes stored so far are kept.
+func HashPendingMedia(ctx context.Context) error {
+	hashMu.Lock()
+	defer hashMu.Unlock()
+
+	maxSize := hashMaxFileSize()
+	throttle := hashThrottle()
+	var pending []string
+	if err := db.GetDefault().Iterate([]byte("media:uuid:"), func(key []byte, value []byte) error {
+		var m MediaFile
+		if json.Unmarshal(value, &m) != nil {
+			return nil
+		}
+		if m.Type == "other" || m.IsTrash || m.Size <= 0 || m.Size > maxSize || m.hashValid() {
+			return nil
+		}
+		pending = append(pending, m.UUID)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	hashed := 0
+	for _, uuid := range pending {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m, _ := GetFile(uuid)
+		if m == nil || m.hashValid() || !fileMatches(m) {
+			continue
+		}
+		sum, err := HashFile(m.Path)
+		// A file that changed while it was read gets hashed by a later pass.
+		if err != nil || !fileMatches(m) {
+			continue
+		}
+		m.SHA256 = sum
+		m.HashRefMod = m.ModifiedAt
+		m.HashRefSize = m.Size
+		if err := UpsertMedia(m); err != nil {
+			return err
+		}
+		hashed++
+		if throttle > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(throttle):
+			}
+		}
+	}
+	if hashed > 0 {
+		log.Infof("[media] hashed %d files", hashed)
+	}
+	return nil
+}
+
+// fileMatches reports whether the file of m still has the recorded size and mtime.
+func fileMatches(m *MediaFile) bool {
+	fi, err := os.Stat(m.Path)
+	return err == nil && fi.Mode().IsRegular() && fi.Size() == m.Size && fi.ModTime().Unix() == m.ModifiedAt
+}
+
+// RunHasher runs HashPendingMedia once immediately and again after every finished media scan.
+// It returns when ctx is done.
+func RunHasher(ctx context.Context) {
+	for {
+		if err := HashPendingMedia(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Errorf("[media] content hashing failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-hashWake:
+		}
+	}
+}
+
+// RecordContentHash stores sum as the content hash of the indexed file at path.
+// Uploads call it after ScanFile with the hash computed while receiving the bytes.
+func RecordContentHash(path string, sum string) error {
+	sum = strings.ToLower(sum)
+	if !ValidContentHash(sum) {
+		return fmt.Errorf("invalid content hash")
+	}
+	uuid, _ := FindByPath(path)
+	if uuid == "" {
+		return fmt.Errorf("not indexed: %s", path)
+	}
+	m, err := GetFile(uuid)
+	if err != nil || m == nil {
+		return fmt.Errorf("not indexed: %s", path)
+	}
+	m.SHA256 = sum
+	m.HashRefMod = m.ModifiedAt
+	m.HashRefSize = m.Size
+	return UpsertMedia(m)
+}
+
+// FindByContentHash returns indexed files whose content hash is sum and whose size is
+// size (any size when size < 0). Files that changed since they were hashed, were trashed
+// or no longer exist are skipped.
+func FindByContentHash(sum string, size int64) []MediaFile {
+	sum = strings.ToLower(sum)
+	if !ValidContentHash(sum) {
+		return nil
+	}
+	var out []MediaFile
+	_ = db.GetDefault().Iterate([]byte("media:hash:"+sum+":"), func(key []byte, _ []byte) error {
+		uuid := strings.TrimPrefix(string(key), "media:hash:"+sum+":")
+		b, err := db.GetDefault().Get(keyByUUID(uuid))
+		if err != nil || b == nil {
+			return nil
+		}
+		var m MediaFile
+		if json.Unmarshal(b, &m) != nil || m.IsTrash || !m.hashValid() || m.SHA256 != sum {
+			return nil
+		}
+		if size >= 0 && m.Size != size {
+			return nil
+		}
+		fi, err := os.Stat(m.Path)
+		if err != nil || !fi.Mode().IsRegular() || fi.Size() != m.Size || fi.ModTime().Unix() != m.ModifiedAt {
+			return nil
+		}
+		out = append(out, m)
+		return nil
+	})
+	return out
+}
@@ -2,22 +2,85 @@ package media
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"sync/atomic"
+	"time"
+
+	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
+	"ismartcoding/plainnas/internal/pkg/shortid"
+)
+
+// ScanJobState is the state of a media scan job.
+type ScanJobState string
+
+const (
+	ScanJobQueued  ScanJobState = "QUEUED"
+	ScanJobRunning ScanJobState = "RUNNING"
+	ScanJobPaused  ScanJobState = "PAUSED"
+	ScanJobStopped ScanJobState = "STOPPED"
+	ScanJobDone    ScanJobState = "DONE"
+	ScanJobError   ScanJobState = "ERROR"
 )
 
 const (
-	stateIdle    int32 = 0
-	stateRunning int32 = 1
-	statePaused  int32 = 2
-	stateStopped int32 = 3
+	// maxScanJobErrors caps the errors kept per job; ErrorCount still counts all of them.
+	maxScanJobErrors = 100
+	// maxFinishedScanJobs is how many finished jobs are kept for listing.
+	maxFinishedScanJobs = 20
 )
 
+// ScanJob is one media scan of a root directory. Jobs run one at a time in queue order.
+type ScanJob struct {
+	mu sync.Mutex
+
+	ID   string
+	Root string
+	// Incremental scans only look at files of directories whose mtime changed since the last
+	// scan; see dirChanged.
+	Incremental bool
+	State       ScanJobState
+	Errors      []string
+	ErrorCount  int
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+
+	failure string
+	indexed int64
+	total   int64
+	pause   int32
+	stop    int32
+	done    chan struct{}
+}
+
+// ScanJobInfo is a snapshot of a ScanJob.
+type ScanJobInfo struct {
+	ID          string
+	Root        string
+	Incremental bool
+	State       ScanJobState
+	Indexed     int64
+	Total       int64
+	Errors      []string
+	ErrorCount  int
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+type scanQueue struct {
+	mu   sync.Mutex
+	jobs []*ScanJob // in creation order; the worker runs the oldest queued one
+	wake chan struct{}
+}
+
 var (
-	scanState     int32
-	pauseFlag     int32
-	stopFlag      int32
-	lastIndexed   int64
-	lastTotal     int64
+	scanQueueOnce sync.Once
+	scans         *scanQueue
+
 	cleanupCancel context.CancelFunc // Cancel previous cleanup operations
 	cleanupID     int64              // ID to track current cleanup operation
 	// When ResetAllMediaData is used, tag relations may become stale (e.g. UUIDs
@@ -25,54 +88,368 @@ var (
 	tagRelationCleanupNeeded int32
 )
 
-func getStateString() string {
-	switch atomic.LoadInt32(&scanState) {
-	case stateRunning:
-		if atomic.LoadInt32(&pauseFlag) == 1 {
-			return "paused"
+func getScanQueue() *scanQueue {
+	scanQueueOnce.Do(func() {
+		scans = &scanQueue{wake: make(chan struct{}, 1)}
+		go scans.worker()
+	})
+	return scans
+}
+
+func (q *scanQueue) worker() {
+	for range q.wake {
+		for j := q.next(); j != nil; j = q.next() {
+			if !j.stopping() {
+				if err := scanRoot(j); err != nil {
+					j.fail(err)
+				}
+			}
+			j.finish()
+			q.prune()
+			wakeHasher()
 		}
-		return "running"
-	case statePaused:
-		return "paused"
-	case stateStopped:
-		return "stopped"
+	}
+}
+
+// next returns the oldest job that is still queued, or nil.
+func (q *scanQueue) next() *ScanJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, j := range q.jobs {
+		if !j.finished() && j.snapshot().State == ScanJobQueued {
+			return j
+		}
+	}
+	return nil
+}
+
+// prune drops the oldest finished jobs beyond maxFinishedScanJobs.
+func (q *scanQueue) prune() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	finished := 0
+	for _, j := range q.jobs {
+		if j.finished() {
+			finished++
+		}
+	}
+	kept := q.jobs[:0]
+	for _, j := range q.jobs {
+		if finished > maxFinishedScanJobs && j.finished() {
+			finished--
+			continue
+		}
+		kept = append(kept, j)
+	}
+	q.jobs = kept
+}
+
+func (q *scanQueue) get(id string) *ScanJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, j := range q.jobs {
+		if j.ID == id {
+			return j
+		}
+	}
+	return nil
+}
+
+// EnqueueScan queues a full scan of root. A full scan of the same root that has not started
+// yet is returned instead of queuing another one.
+func EnqueueScan(root string) *ScanJob {
+	return enqueueScan(root, false)
+}
+
+// EnqueueIncrementalScan queues an incremental scan of root. Any scan of the same root that has
+// not started yet is returned instead of queuing another one.
+func EnqueueIncrementalScan(root string) *ScanJob {
+	return enqueueScan(root, true)
+}
+
+func enqueueScan(root string, incremental bool) *ScanJob {
+	root = filepath.Clean(root)
+	q := getScanQueue()
+	q.mu.Lock()
+	for _, j := range q.jobs {
+		if j.Root == root && (incremental || !j.Incremental) && j.snapshot().State == ScanJobQueued {
+			q.mu.Unlock()
+			return j
+		}
+	}
+	j := &ScanJob{ID: shortid.New(), Root: root, Incremental: incremental, State: ScanJobQueued, CreatedAt: time.Now().UTC(), done: make(chan struct{})}
+	q.jobs = append(q.jobs, j)
+	q.mu.Unlock()
+	j.publish()
+	select {
+	case q.wake <- struct{}{}:
 	default:
-		return "idle"
 	}
+	return j
 }
 
-func setStateRunning() { atomic.StoreInt32(&scanState, stateRunning) }
-func setStateIdle()    { atomic.StoreInt32(&scanState, stateIdle) }
+// ScanAndSync scans root to update the media DB and index, and waits for the scan to finish.
+func ScanAndSync(root string) error {
+	return EnqueueScan(root).Wait()
+}
 
-func PauseScan()  { atomic.StoreInt32(&pauseFlag, 1); atomic.StoreInt32(&scanState, statePaused) }
-func ResumeScan() { atomic.StoreInt32(&pauseFlag, 0); atomic.StoreInt32(&scanState, stateRunning) }
+// ScanJobs lists the queued, running and recently finished scan jobs, oldest first.
+func ScanJobs() []ScanJobInfo {
+	q := getScanQueue()
+	q.mu.Lock()
+	jobs := append([]*ScanJob(nil), q.jobs...)
+	q.mu.Unlock()
+	out := make([]ScanJobInfo, 0, len(jobs))
+	for _, j := range jobs {
+		out = append(out, j.snapshot())
+	}
+	return out
+}
+
+// PauseScanJob pauses a queued or running job; a paused queued job waits when its turn comes.
+func PauseScanJob(id string) error { return withScanJob(id, (*ScanJob).Pause) }
+
+// ResumeScanJob resumes a paused job.
+func ResumeScanJob(id string) error { return withScanJob(id, (*ScanJob).Resume) }
+
+// StopScanJob stops a job. A running job stops at the next file and skips the cleanup of
+// entries it did not reach.
+func StopScanJob(id string) error { return withScanJob(id, (*ScanJob).Stop) }
+
+func withScanJob(id string, fn func(*ScanJob)) error {
+	j := getScanQueue().get(id)
+	if j == nil {
+		return fmt.Errorf("scan job not found")
+	}
+	fn(j)
+	return nil
+}
+
+// PauseScan pauses all unfinished scan jobs.
+func PauseScan() { forEachActiveScan((*ScanJob).Pause) }
+
+// ResumeScan resumes all paused scan jobs.
+func ResumeScan() { forEachActiveScan((*ScanJob).Resume) }
+
+// StopScan stops all unfinished scan jobs and cancels a running cleanup.
 func StopScan() {
-	atomic.StoreInt32(&stopFlag, 1)
-	atomic.StoreInt32(&scanState, stateStopped)
+	forEachActiveScan((*ScanJob).Stop)
 	// Cancel any running cleanup operations
 	if cleanupCancel != nil {
 		cleanupCancel()
 	}
 }
 
-func markTagRelationCleanupNeeded() {
-	atomic.StoreInt32(&tagRelationCleanupNeeded, 1)
+func forEachActiveScan(fn func(*ScanJob)) {
+	q := getScanQueue()
+	q.mu.Lock()
+	jobs := append([]*ScanJob(nil), q.jobs...)
+	q.mu.Unlock()
+	for _, j := range jobs {
+		if !j.finished() {
+			fn(j)
+		}
+	}
 }
 
-func consumeTagRelationCleanupNeeded() bool {
-	return atomic.SwapInt32(&tagRelationCleanupNeeded, 0) == 1
+// GetProgress returns the counters of the running scan job, or of the last one, and the state
+// reported in media scan progress events (idle, running, paused or stopped).
+func GetProgress() (indexed int64, total int64, state string) {
+	q := getScanQueue()
+	q.mu.Lock()
+	jobs := append([]*ScanJob(nil), q.jobs...)
+	q.mu.Unlock()
+	var cur *ScanJob
+	for _, j := range jobs {
+		s := j.snapshot().State
+		if s == ScanJobRunning || s == ScanJobPaused {
+			cur = j
+			break
+		}
+		if s != ScanJobQueued {
+			cur = j
+		}
+	}
+	if cur == nil {
+		for _, j := range jobs {
+			if j.snapshot().State == ScanJobQueued {
+				return 0, 0, "running"
+			}
+		}
+		return 0, 0, "idle"
+	}
+	s := cur.snapshot()
+	return s.Indexed, s.Total, progressState(s.State)
 }
 
-func IsPaused() bool   { return atomic.LoadInt32(&pauseFlag) == 1 }
-func IsStopping() bool { return atomic.LoadInt32(&stopFlag) == 1 }
+func progressState(s ScanJobState) string {
+	switch s {
+	case ScanJobQueued, ScanJobRunning:
+		return "running"
+	case ScanJobPaused:
+		return "paused"
+	case ScanJobStopped:
+		return "stopped"
+	default:
+		return "idle"
+	}
+}
 
-// UpdateProgress is called by scanner to store last values
-func UpdateProgress(indexed int64, total int64) {
-	atomic.StoreInt64(&lastIndexed, indexed)
-	atomic.StoreInt64(&lastTotal, total)
+// Pause pauses the job at the next file.
+func (j *ScanJob) Pause() {
+	j.mu.Lock()
+	atomic.StoreInt32(&j.pause, 1)
+	if j.State == ScanJobRunning {
+		j.State = ScanJobPaused
+	}
+	j.mu.Unlock()
+	j.publish()
 }
 
-// GetProgress returns last known values
-func GetProgress() (indexed int64, total int64, state string) {
-	return atomic.LoadInt64(&lastIndexed), atomic.LoadInt64(&lastTotal), getStateString()
+// Resume continues a paused job.
+func (j *ScanJob) Resume() {
+	j.mu.Lock()
+	atomic.StoreInt32(&j.pause, 0)
+	if j.State == ScanJobPaused {
+		j.State = ScanJobRunning
+	}
+	j.mu.Unlock()
+	j.publish()
+}
+
+// Stop stops the job at the next file; a queued job does not run.
+func (j *ScanJob) Stop() {
+	j.mu.Lock()
+	atomic.StoreInt32(&j.stop, 1)
+	queued := j.State == ScanJobQueued
+	j.mu.Unlock()
+	// The worker skips stopped queued jobs; finish them now for waiters.
+	if queued {
+		j.finish()
+	}
+}
+
+// Wait blocks until the job finished and returns its first error.
+func (j *ScanJob) Wait() error {
+	<-j.done
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.failure != "" {
+		return fmt.Errorf("%s", j.failure)
+	}
+	return nil
+}
+
+func (j *ScanJob) paused() bool   { return atomic.LoadInt32(&j.pause) == 1 }
+func (j *ScanJob) stopping() bool { return atomic.LoadInt32(&j.stop) == 1 }
+
+func (j *ScanJob) finished() bool {
+	select {
+	case <-j.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// start marks the job running; it reports false when the job was stopped meanwhile.
+func (j *ScanJob) start() bool {
+	now := time.Now().UTC()
+	j.mu.Lock()
+	if j.stopping() {
+		j.mu.Unlock()
+		return false
+	}
+	j.StartedAt = &now
+	j.State = ScanJobRunning
+	if j.paused() {
+		j.State = ScanJobPaused
+	}
+	j.mu.Unlock()
+	j.publish()
+	return true
+}
+
+// finish sets the final state: stopped, error when the scan itself failed, or done. Errors of
+// single files do not fail the job.
+func (j *ScanJob) finish() {
+	j.mu.Lock()
+	if j.FinishedAt != nil {
+		j.mu.Unlock()
+		return
+	}
+	now := time.Now().UTC()
+	j.FinishedAt = &now
+	switch {
+	case j.stopping():
+		j.State = ScanJobStopped
+	case j.State != ScanJobError:
+		j.State = ScanJobDone
+	}
+	j.mu.Unlock()
+	close(j.done)
+	j.publish()
+}
+
+// addError records an error of a single file.
+func (j *ScanJob) addError(msg string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.ErrorCount++
+	if len(j.Errors) < maxScanJobErrors {
+		j.Errors = append(j.Errors, msg)
+	}
+}
+
+// fail records an error that ended the scan.
+func (j *ScanJob) fail(err error) {
+	j.mu.Lock()
+	j.State = ScanJobError
+	j.failure = err.Error()
+	j.mu.Unlock()
+	j.addError(err.Error())
+}
+
+// Info returns a snapshot of the job.
+func (j *ScanJob) Info() ScanJobInfo { return j.snapshot() }
+
+func (j *ScanJob) snapshot() ScanJobInfo {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return ScanJobInfo{
+		ID:          j.ID,
+		Root:        j.Root,
+		Incremental: j.Incremental,
+		State:       j.State,
+		Indexed:     atomic.LoadInt64(&j.indexed),
+		Total:       atomic.LoadInt64(&j.total),
+		Errors:      append([]string(nil), j.Errors...),
+		ErrorCount:  j.ErrorCount,
+		CreatedAt:   j.CreatedAt,
+		StartedAt:   j.StartedAt,
+		FinishedAt:  j.FinishedAt,
+	}
+}
+
+// publish sends the job's progress as a media scan progress event.
+func (j *ScanJob) publish() {
+	s := j.snapshot()
+	eventbus.GetDefault().Publish(consts.EVENT_MEDIA_SCAN_PROGRESS, map[string]any{
+		"id":       s.ID,
+		"indexed":  s.Indexed,
+		"pending":  max64(s.Total-s.Indexed, 0),
+		"total":    s.Total,
+		"state":    progressState(s.State),
+		"jobState": string(s.State),
+		"errors":   s.ErrorCount,
+		"root":     filepath.ToSlash(s.Root),
+	})
+}
+
+func markTagRelationCleanupNeeded() {
+	atomic.StoreInt32(&tagRelationCleanupNeeded, 1)
+}
+
+func consumeTagRelationCleanupNeeded() bool {
+	return atomic.SwapInt32(&tagRelationCleanupNeeded, 0) == 1
 }
@@ -0,0 +1,42 @@
+package media
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestScanJobOfMissingRootFails(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "missing")
+	j := EnqueueScan(root)
+	if err := j.Wait(); err == nil {
+		t.Fatal("scan of a missing root succeeded")
+	}
+	var found bool
+	for _, s := range ScanJobs() {
+		if s.ID != j.ID {
+			continue
+		}
+		found = true
+		if s.State != ScanJobError || s.ErrorCount != 1 || s.StartedAt != nil || s.FinishedAt == nil {
+			t.Fatalf("job = %+v", s)
+		}
+	}
+	if !found {
+		t.Fatal("job not listed")
+	}
+	if err := StopScanJob("nope"); err == nil {
+		t.Fatal("stopping an unknown job succeeded")
+	}
+}
+
+func TestEnqueueScanDoesNotBlock(t *testing.T) {
+	base := t.TempDir()
+	var jobs []*ScanJob
+	for i := 0; i < 300; i++ {
+		jobs = append(jobs, EnqueueScan(filepath.Join(base, "missing", strconv.Itoa(i))))
+	}
+	for _, j := range jobs {
+		_ = j.Wait()
+	}
+}
@@ -1,9 +1,13 @@
 package media
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+
+	"ismartcoding/plainnas/internal/db"
 )
 
 // maybeExtractCoverToTempImage attempts to extract an embedded cover image suitable
@@ -54,6 +58,50 @@ func ThumbnailCacheRefPath(path string) string {
 	return path
 }
 
+// ThumbnailCacheRef returns the mtime/size that invalidate thumbnail caches for `path`, whose
+// stat is fi: those of its sidecar cover when one exists, else its own.
+//
+// A sidecar ref is remembered (prefix `thumbref:`) so that OfflineThumbnailCacheRef finds the
+// same cache keys once the volume is unplugged and the sidecar cannot be stat'd.
+func ThumbnailCacheRef(path string, fi os.FileInfo) (modUnix int64, size int64) {
+	modUnix, size = fi.ModTime().Unix(), fi.Size()
+	refPath := ThumbnailCacheRefPath(path)
+	if refPath != path {
+		if sfi, err := os.Stat(refPath); err == nil && !sfi.IsDir() {
+			modUnix, size = sfi.ModTime().Unix(), sfi.Size()
+		}
+	}
+	key := []byte("thumbref:" + filepath.ToSlash(path))
+	peb := db.GetDefault()
+	old, _ := peb.Get(key)
+	if refPath == path {
+		if old != nil {
+			_ = peb.Delete(key)
+		}
+		return modUnix, size
+	}
+	val := []byte(strconv.FormatInt(modUnix, 10) + "|" + strconv.FormatInt(size, 10))
+	if !bytes.Equal(old, val) {
+		_ = peb.Set(key, val, nil)
+	}
+	return modUnix, size
+}
+
+// OfflineThumbnailCacheRef is ThumbnailCacheRef for a media file whose volume is not mounted.
+// It uses the sidecar ref remembered while the volume was online, else the recorded mtime/size.
+func OfflineThumbnailCacheRef(mf *MediaFile) (modUnix int64, size int64) {
+	if b, _ := db.GetDefault().Get([]byte("thumbref:" + filepath.ToSlash(mf.Path))); b != nil {
+		if m, s, ok := strings.Cut(string(b), "|"); ok {
+			mod, err1 := strconv.ParseInt(m, 10, 64)
+			sz, err2 := strconv.ParseInt(s, 10, 64)
+			if err1 == nil && err2 == nil {
+				return mod, sz
+			}
+		}
+	}
+	return mf.ModifiedAt, mf.Size
+}
+
 func findSidecarCoverPath(path string) (coverPath string, ok bool) {
 	dir := filepath.Dir(path)
 	base := filepath.Base(path)
@@ -0,0 +1,51 @@
+package media
+
+import (
+	"strconv"
+
+	"ismartcoding/plainnas/internal/db"
+)
+
+// Incremental scans skip the files of a directory whose mtime is the one recorded by the last
+// completed scan. Creating, deleting or renaming an entry changes the mtime of its directory;
+// editing a file in place does not, so such edits wait for the next full scan.
+
+// dirUnchanged reports whether dir still has the mtime recorded by the last completed scan.
+func dirUnchanged(dir string, mtime int64) bool {
+	v, _ := db.GetDefault().Get(keyByDirMtime(dir))
+	return v != nil && string(v) == strconv.FormatInt(mtime, 10)
+}
+
+// storeDirMtimes records the mtimes of the directories a completed scan looked into.
+func storeDirMtimes(mtimes map[string]int64) error {
+	const batchSize = 1000
+	keys := make([][]byte, 0, batchSize)
+	values := make([][]byte, 0, batchSize)
+	for dir, mt := range mtimes {
+		keys = append(keys, keyByDirMtime(dir))
+		values = append(values, []byte(strconv.FormatInt(mt, 10)))
+		if len(keys) == batchSize {
+			if err := db.GetDefault().BatchSet(keys, values); err != nil {
+				return err
+			}
+			keys, values = keys[:0], values[:0]
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return db.GetDefault().BatchSet(keys, values)
+}
+
+// ForgetDirMtimes drops the recorded directory mtimes, so the next incremental scans look at the
+// files of every directory, e.g. after the ignore patterns changed.
+func ForgetDirMtimes() error {
+	var keys [][]byte
+	if err := db.GetDefault().Iterate([]byte("media:dirmtime:"), func(key []byte, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return db.GetDefault().BatchDelete(keys)
+}
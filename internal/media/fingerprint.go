@@ -0,0 +1,141 @@
+package media
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
+	"ismartcoding/plainnas/internal/pkg/log"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// Media UUIDs follow the filesystem identity (FSUUID+inode+ctime), so a photo copied or moved to
+// another disk is a new item. A sampled fingerprint recognizes it by content instead: reading only
+// three blocks keeps scans of large videos cheap, at the price of not being a proof of equality,
+// which is why it is not used for upload deduplication (see SHA256).
+
+// fingerprintBlock is the size of each sampled block.
+const fingerprintBlock = 64 << 10
+
+func fingerprintEnabled() bool {
+	return config.GetDefault().GetString("media.fingerprint") != "false"
+}
+
+func (m *MediaFile) fingerprintValid() bool {
+	return m.Fingerprint != "" && m.FingerprintRefMod == m.ModifiedAt && m.FingerprintRefSize == m.Size
+}
+
+// SampleFingerprint returns the fingerprint of the file at path, which has size bytes: the size
+// and the xxhash of its head, middle and tail blocks. Small files are hashed whole.
+func SampleFingerprint(path string, size int64) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	offsets := []int64{0, (size - fingerprintBlock) / 2, size - fingerprintBlock}
+	if size <= 3*fingerprintBlock {
+		offsets = []int64{0, fingerprintBlock, 2 * fingerprintBlock}
+	}
+	h := xxhash.New()
+	var sz [8]byte
+	binary.LittleEndian.PutUint64(sz[:], uint64(size))
+	_, _ = h.Write(sz[:])
+	buf := make([]byte, fingerprintBlock)
+	for _, off := range offsets {
+		n, err := f.ReadAt(buf, off)
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+		_, _ = h.Write(buf[:n])
+	}
+	return fmt.Sprintf("%x-%016x", size, h.Sum64()), nil
+}
+
+// ensureFingerprint fingerprints media files as UpsertMedia stores them, after it carried over a
+// fingerprint that is still valid. A file seen for the first time (isNew) whose fingerprint matches
+// media on another filesystem takes over that item's tags and cached metadata (see
+// adoptFingerprintMatch).
+func ensureFingerprint(m *MediaFile, isNew bool) {
+	if m.Type == "other" || m.Size <= 0 || m.fingerprintValid() || !fingerprintEnabled() {
+		return
+	}
+	fp, err := SampleFingerprint(m.Path, m.Size)
+	if err != nil {
+		return
+	}
+	m.Fingerprint = fp
+	m.FingerprintRefMod = m.ModifiedAt
+	m.FingerprintRefSize = m.Size
+	if isNew {
+		if src := findFingerprintMatch(m); src != nil {
+			adoptFingerprintMatch(m, src)
+		}
+	}
+}
+
+// findFingerprintMatch returns a media item on another filesystem with the fingerprint of m.
+// Items of unmounted volumes are included: copying from a disk that is later unplugged is the
+// common case.
+func findFingerprintMatch(m *MediaFile) *MediaFile {
+	prefix := "media:fp:" + m.Fingerprint + ":"
+	var found *MediaFile
+	_ = db.GetDefault().Iterate([]byte(prefix), func(key []byte, _ []byte) error {
+		uuid := strings.TrimPrefix(string(key), prefix)
+		if uuid == m.UUID {
+			return nil
+		}
+		b, err := db.GetDefault().Get(keyByUUID(uuid))
+		if err != nil || b == nil {
+			return nil
+		}
+		var c MediaFile
+		if json.Unmarshal(b, &c) != nil || c.FSUUID == m.FSUUID || !c.fingerprintValid() || c.Fingerprint != m.Fingerprint {
+			return nil
+		}
+		found = &c
+		return db.ErrIterateStop
+	})
+	return found
+}
+
+// adoptFingerprintMatch copies the tags and cached metadata of src to the new item m and reports
+// the match. Cached values are only taken while they are still valid for src.
+func adoptFingerprintMatch(m *MediaFile, src *MediaFile) {
+	if rels, err := db.GetTagRelationsByKey(src.UUID); err == nil && len(rels) > 0 {
+		out := make([]*db.TagRelationRef, 0, len(rels))
+		for _, r := range rels {
+			out = append(out, &db.TagRelationRef{TagID: r.TagID, Key: m.UUID})
+		}
+		_ = db.SaveTagRelations(out)
+	}
+	if m.DurationSec == 0 && src.DurationSec > 0 && src.DurationRefMod == src.ModifiedAt && src.DurationRefSize == src.Size {
+		m.DurationSec, m.DurationRefMod, m.DurationRefSize = src.DurationSec, m.ModifiedAt, m.Size
+	}
+	if m.Artist == "" && src.Artist != "" && src.ArtistRefMod == src.ModifiedAt && src.ArtistRefSize == src.Size {
+		m.Artist, m.ArtistRefMod, m.ArtistRefSize = src.Artist, m.ModifiedAt, m.Size
+	}
+	if m.Title == "" && src.Title != "" && src.TitleRefMod == src.ModifiedAt && src.TitleRefSize == src.Size {
+		m.Title, m.TitleRefMod, m.TitleRefSize = src.Title, m.ModifiedAt, m.Size
+	}
+	if m.TagsRefMod == 0 && src.TagsRefMod != 0 && src.TagsRefMod == src.ModifiedAt && src.TagsRefSize == src.Size {
+		m.setAudioTags(src.AudioTags())
+		m.TagsRefMod, m.TagsRefSize = m.ModifiedAt, m.Size
+	}
+	log.Infof("Media %s matches %s by fingerprint", m.Path, src.Path)
+	db.AddEvent("media_match", fmt.Sprintf("%s -> %s", src.Path, m.Path), "")
+	eventbus.GetDefault().Publish(consts.EVENT_MEDIA_FINGERPRINT_MATCHED, map[string]any{
+		"uuid":       m.UUID,
+		"path":       m.Path,
+		"sourceUUID": src.UUID,
+		"sourcePath": src.Path,
+	})
+}
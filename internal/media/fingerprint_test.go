@@ -0,0 +1,53 @@
+package media
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSampleFingerprint(t *testing.T) {
+	dir := t.TempDir()
+	data := make([]byte, 1<<20)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	write := func(name string, b []byte) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, b, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	fp := func(p string) string {
+		fi, err := os.Stat(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := SampleFingerprint(p, fi.Size())
+		if err != nil {
+			t.Fatalf("SampleFingerprint err: %v", err)
+		}
+		return s
+	}
+
+	orig := fp(write("a.jpg", data))
+	if got := fp(write("copy.jpg", data)); got != orig {
+		t.Fatalf("copy fingerprint = %q, want %q", got, orig)
+	}
+
+	// A change in the sampled middle block changes the fingerprint.
+	mid := append([]byte{}, data...)
+	mid[len(mid)/2] ^= 0xff
+	if got := fp(write("mid.jpg", mid)); got == orig {
+		t.Fatalf("middle change kept fingerprint %q", got)
+	}
+
+	// Small files are hashed whole.
+	small := data[:1000]
+	other := append([]byte{}, small...)
+	other[500] ^= 0xff
+	if fp(write("s1.jpg", small)) == fp(write("s2.jpg", other)) {
+		t.Fatalf("small files with different content share a fingerprint")
+	}
+}
@@ -0,0 +1,139 @@
+package media
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"ismartcoding/plainnas/internal/db"
+)
+
+func (m *MediaFile) typeIndexKeys() [][]byte {
+	return [][]byte{
+		keyTypeTrashUUID(m.Type, m.IsTrash, m.UUID),
+		keyTypeTrashMod(m.Type, m.IsTrash, m.ModifiedAt, m.UUID),
+		keyTypeTrashModDesc(m.Type, m.IsTrash, m.ModifiedAt, m.UUID),
+		keyTypeTrashName(m.Type, m.IsTrash, m.Name, m.UUID),
+		keyTypeTrashNameDesc(m.Type, m.IsTrash, m.Name, m.UUID),
+		keyTypeTrashSize(m.Type, m.IsTrash, m.Size, m.UUID),
+		keyTypeTrashSizeDesc(m.Type, m.IsTrash, m.Size, m.UUID),
+	}
+}
+
+// CheckCatalog cross-checks the media:uuid: records with the disk, their lookup keys
+// (media:path:, media:fid:, media:hash:, media:fp:, media:docid:) and the media:type: indexes.
+// Records of files gone from a mounted volume are removed; the offline catalog of unmounted
+// volumes is kept. Lookup keys owned by another record are only reported: which record is
+// stale is decided by the next scan.
+func CheckCatalog(r *db.FsckReport) error {
+	const check = "media"
+	peb := db.GetDefault()
+	records := map[string]*MediaFile{}
+
+	if err := peb.Iterate([]byte("media:uuid:"), func(key []byte, value []byte) error {
+		r.Entry()
+		k := string(key)
+		var m MediaFile
+		if err := json.Unmarshal(value, &m); err != nil || m.UUID == "" || k != string(keyByUUID(m.UUID)) {
+			r.Report(check, k, "corrupt record", func() error { return peb.Delete([]byte(k)) })
+			return nil
+		}
+		records[m.UUID] = &m
+		if VolumeOnline(m.FSUUID) {
+			if _, err := os.Lstat(m.Path); os.IsNotExist(err) {
+				uuid := m.UUID
+				r.Report(check, k, "file missing on disk", func() error {
+					delete(records, uuid)
+					return DeleteMedia(uuid)
+				})
+				return nil
+			}
+		}
+
+		owned := func(key []byte, what string) {
+			v, _ := peb.Get(key)
+			if v == nil {
+				r.Report(check, string(key), "missing "+what, func() error { return peb.Set(key, []byte(m.UUID), nil) })
+			} else if string(v) != m.UUID {
+				r.Report(check, string(key), what+" owned by another record", nil)
+			}
+		}
+		owned(keyByPath(m.Path), "path key")
+		owned(keyByFID(m.FSUUID, m.Ino, m.Ctime), "fid key")
+
+		marker := func(key []byte, problem string) {
+			if v, _ := peb.Get(key); v == nil {
+				r.Report(check, string(key), problem, func() error { return peb.Set(key, []byte{}, nil) })
+			}
+		}
+		for _, tk := range m.typeIndexKeys() {
+			marker(tk, "missing type index")
+		}
+		if m.hashValid() {
+			marker(keyByHash(m.SHA256, m.UUID), "missing hash key")
+		}
+		if m.fingerprintValid() {
+			marker(keyByFingerprint(m.Fingerprint, m.UUID), "missing fingerprint key")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Reverse sweeps: every key must belong to a live record.
+	sweep := func(prefix, problem string, valid func(key string, value []byte) bool) error {
+		return peb.Iterate([]byte(prefix), func(key []byte, value []byte) error {
+			r.Entry()
+			k := string(key)
+			if !valid(k, value) {
+				r.Report(check, k, problem, func() error { return peb.Delete([]byte(k)) })
+			}
+			return nil
+		})
+	}
+	// uuidSuffix returns the record of keys that end with ":<uuid>".
+	uuidSuffix := func(k string) *MediaFile {
+		return records[k[strings.LastIndexByte(k, ':')+1:]]
+	}
+	if err := sweep("media:path:", "dangling path key", func(k string, v []byte) bool {
+		m := records[string(v)]
+		return m != nil && k == string(keyByPath(m.Path))
+	}); err != nil {
+		return err
+	}
+	if err := sweep("media:fid:", "dangling fid key", func(k string, v []byte) bool {
+		m := records[string(v)]
+		return m != nil && k == string(keyByFID(m.FSUUID, m.Ino, m.Ctime))
+	}); err != nil {
+		return err
+	}
+	if err := sweep("media:type:", "dangling type index", func(k string, _ []byte) bool {
+		m := uuidSuffix(k)
+		if m == nil {
+			return false
+		}
+		for _, tk := range m.typeIndexKeys() {
+			if k == string(tk) {
+				return true
+			}
+		}
+		return false
+	}); err != nil {
+		return err
+	}
+	if err := sweep("media:hash:", "dangling hash key", func(k string, _ []byte) bool {
+		m := uuidSuffix(k)
+		return m != nil && m.hashValid() && k == string(keyByHash(m.SHA256, m.UUID))
+	}); err != nil {
+		return err
+	}
+	if err := sweep("media:fp:", "dangling fingerprint key", func(k string, _ []byte) bool {
+		m := uuidSuffix(k)
+		return m != nil && m.fingerprintValid() && k == string(keyByFingerprint(m.Fingerprint, m.UUID))
+	}); err != nil {
+		return err
+	}
+	return sweep("media:docid:", "dangling doc id", func(_ string, v []byte) bool {
+		return records[string(v)] != nil
+	})
+}
@@ -21,3 +21,12 @@ func keyByFID(fsUUID string, ino uint64, ctime int64) []byte {
 func keyByPath(path string) []byte { return []byte("media:path:" + path) }
 
 func keyByDocID(id uint64) []byte { return []byte(fmt.Sprintf("media:docid:%016x", id)) }
+
+// keyByHash indexes media by content hash. Several files may share a hash, so the uuid is part of the key.
+func keyByHash(sum string, uuid string) []byte { return []byte("media:hash:" + sum + ":" + uuid) }
+
+// keyByFingerprint indexes media by sampled content fingerprint; copies share one, so the uuid is part of the key.
+func keyByFingerprint(fp string, uuid string) []byte { return []byte("media:fp:" + fp + ":" + uuid) }
+
+// keyByDirMtime records the mtime of a scanned directory for incremental scans.
+func keyByDirMtime(dir string) []byte { return []byte("media:dirmtime:" + dir) }
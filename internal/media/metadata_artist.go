@@ -97,141 +97,145 @@ func probeArtistMP3(path string) (string, error) {
 }
 
 func probeMP3APEv2Text(f *os.File, wantKey string) (string, bool) {
+	val, ok := readMP3APEv2Items(f)[strings.ToLower(wantKey)]
+	if !ok {
+		return "", false
+	}
+	s := strings.TrimSpace(decodeLegacyTextBestEffort(val))
+	if s == "" {
+		return "", false
+	}
+	return s, true
+}
+
+// readMP3APEv2Items returns the items of an APEv2 tag at the end of f keyed by their lower-cased
+// key, keeping the first value of repeated keys. It returns nil when there is no valid tag.
+func readMP3APEv2Items(f *os.File) map[string][]byte {
 	st, err := f.Stat()
 	if err != nil {
-		return "", false
+		return nil
 	}
 	sz := st.Size()
 	if sz < 32 {
-		return "", false
+		return nil
 	}
 
 	footerOff := sz - 32
 	footer := make([]byte, 32)
 	if _, err := f.ReadAt(footer, footerOff); err != nil {
-		return "", false
+		return nil
 	}
 	if string(footer[0:8]) != "APETAGEX" {
-		return "", false
+		return nil
 	}
 	version := binary.LittleEndian.Uint32(footer[8:12])
 	if version != 2000 && version != 1000 {
-		return "", false
+		return nil
 	}
 	tagSize := int64(binary.LittleEndian.Uint32(footer[12:16]))
 	itemCount := int(binary.LittleEndian.Uint32(footer[16:20]))
 	if tagSize < 32 || tagSize > sz {
-		return "", false
+		return nil
 	}
 	if itemCount < 0 || itemCount > 256 {
-		return "", false
+		return nil
 	}
 
 	tagStart := sz - tagSize
 	itemsEnd := sz - 32
 	if tagStart < 0 || itemsEnd < tagStart {
-		return "", false
+		return nil
 	}
 	itemsSize := itemsEnd - tagStart
 	if itemsSize <= 0 || itemsSize > 8*1024*1024 {
-		return "", false
+		return nil
 	}
 
 	buf := make([]byte, itemsSize)
 	if _, err := f.ReadAt(buf, tagStart); err != nil {
-		return "", false
+		return nil
 	}
 
+	items := map[string][]byte{}
 	pos := 0
 	for i := 0; i < itemCount && pos+8 <= len(buf); i++ {
 		valueSize := int(binary.LittleEndian.Uint32(buf[pos : pos+4]))
 		_ = binary.LittleEndian.Uint32(buf[pos+4 : pos+8])
 		pos += 8
 		if valueSize < 0 || valueSize > len(buf) {
-			return "", false
+			break
 		}
 		k0 := bytes.IndexByte(buf[pos:], 0)
 		if k0 < 0 {
-			return "", false
+			break
 		}
-		key := string(buf[pos : pos+k0])
+		key := strings.ToLower(string(buf[pos : pos+k0]))
 		pos += k0 + 1
 		if pos+valueSize > len(buf) {
-			return "", false
+			break
 		}
 		val := buf[pos : pos+valueSize]
 		pos += valueSize
 
-		if !strings.EqualFold(key, wantKey) {
-			continue
+		if _, seen := items[key]; !seen {
+			items[key] = bytes.Trim(val, "\x00")
 		}
-		val = bytes.Trim(val, "\x00")
-		s := strings.TrimSpace(decodeLegacyTextBestEffort(val))
-		if s == "" {
-			return "", false
-		}
-		return s, true
 	}
-
-	return "", false
+	return items
 }
 
 func probeMP3ID3v2TextFrame(f *os.File, wantFrameID string) (string, bool) {
-	if _, err := f.Seek(0, io.SeekStart); err != nil {
+	tag, ver, flags, ok := readMP3ID3v2Tag(f)
+	if !ok {
 		return "", false
 	}
+	a := parseID3v2TextFrame(tag, ver, flags, wantFrameID)
+	if a == "" {
+		return "", false
+	}
+	return a, true
+}
+
+// readMP3ID3v2Tag reads the ID3v2.3/2.4 tag at the start of f and returns its body (after the
+// 10-byte header) with the major version and header flags.
+func readMP3ID3v2Tag(f *os.File) (tag []byte, ver byte, flags byte, ok bool) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, 0, false
+	}
 	var hdr [10]byte
 	if _, err := io.ReadFull(f, hdr[:]); err != nil {
-		return "", false
+		return nil, 0, 0, false
 	}
 	if string(hdr[0:3]) != "ID3" {
-		return "", false
+		return nil, 0, 0, false
 	}
-	ver := hdr[3]
+	ver = hdr[3]
 	if ver != 3 && ver != 4 {
-		return "", false
+		return nil, 0, 0, false
 	}
-	flags := hdr[5]
+	flags = hdr[5]
 	// If unsynchronisation is set, we bail out (best-effort).
 	if flags&0x80 != 0 {
-		return "", false
+		return nil, 0, 0, false
 	}
 
 	tagSize := int(decodeSyncsafe32(hdr[6:10]))
 	if tagSize <= 0 || tagSize > 8*1024*1024 {
-		return "", false
+		return nil, 0, 0, false
 	}
-	buf := make([]byte, tagSize)
-	if _, err := io.ReadFull(f, buf); err != nil {
-		return "", false
+	tag = make([]byte, tagSize)
+	if _, err := io.ReadFull(f, tag); err != nil {
+		return nil, 0, 0, false
 	}
-
-	a := parseID3v2TextFrame(buf, ver, flags, wantFrameID)
-	if a == "" {
-		return "", false
-	}
-	return a, true
+	return tag, ver, flags, true
 }
 
 func probeMP3ID3v1TextField(f *os.File, start int, end int) (string, bool) {
-	st, err := f.Stat()
-	if err != nil {
-		return "", false
-	}
-	if st.Size() < 128 {
-		return "", false
-	}
 	if start < 0 || end > 128 || start >= end {
 		return "", false
 	}
-	if _, err := f.Seek(-128, io.SeekEnd); err != nil {
-		return "", false
-	}
-	var tag [128]byte
-	if _, err := io.ReadFull(f, tag[:]); err != nil {
-		return "", false
-	}
-	if string(tag[0:3]) != "TAG" {
+	tag, ok := readMP3ID3v1Tag(f)
+	if !ok {
 		return "", false
 	}
 	b := bytes.Trim(tag[start:end], "\x00")
@@ -243,6 +247,24 @@ func probeMP3ID3v1TextField(f *os.File, start int, end int) (string, bool) {
 	return s, true
 }
 
+// readMP3ID3v1Tag reads the 128-byte ID3v1 tag at the end of f.
+func readMP3ID3v1Tag(f *os.File) (tag [128]byte, ok bool) {
+	st, err := f.Stat()
+	if err != nil {
+		return tag, false
+	}
+	if st.Size() < 128 {
+		return tag, false
+	}
+	if _, err := f.Seek(-128, io.SeekEnd); err != nil {
+		return tag, false
+	}
+	if _, err := io.ReadFull(f, tag[:]); err != nil {
+		return tag, false
+	}
+	return tag, string(tag[0:3]) == "TAG"
+}
+
 func parseID3v2TextFrame(tag []byte, ver byte, flags byte, wantFrameID string) string {
 	off := 0
 	// Extended header
@@ -623,41 +645,45 @@ func scanMP4BoxesForTextTypes(f *os.File, start, size int64, depth int, typeSet
 }
 
 func parseMP4TextItem(f *os.File, start, size int64) string {
+	return strings.TrimSpace(string(bytes.Trim(parseMP4DataItem(f, start, size), "\x00")))
+}
+
+// parseMP4DataItem returns the payload of the first readable "data" box of an ilst item.
+func parseMP4DataItem(f *os.File, start, size int64) []byte {
 	end := start + size
 	pos := start
 	for pos+8 <= end {
 		bs, bt, header, ok := readMP4BoxHeaderAtLoose(f, pos, end)
 		if !ok {
-			return ""
+			return nil
 		}
 		payloadStart := pos + header
 		payloadSize := bs - header
 		if payloadSize < 0 || payloadStart+payloadSize > end {
-			return ""
+			return nil
 		}
 		if bt != "data" {
 			pos += bs
 			continue
 		}
-		// data is FullBox (4) + type+locale (8) then payload.
-		if payloadSize <= 12 {
+		// data holds a type indicator (4) and a locale (4), then the value.
+		if payloadSize <= 8 {
 			pos += bs
 			continue
 		}
-		payload := payloadStart + 12
-		payloadLen := payloadSize - 12
+		payload := payloadStart + 8
+		payloadLen := payloadSize - 8
 		if payloadLen <= 0 || payloadLen > 1<<20 {
 			pos += bs
 			continue
 		}
 		b := make([]byte, payloadLen)
 		if _, err := f.ReadAt(b, payload); err != nil {
-			return ""
+			return nil
 		}
-		s := strings.TrimSpace(string(bytes.Trim(b, "\x00")))
-		return s
+		return b
 	}
-	return ""
+	return nil
 }
 
 func readMP4BoxHeaderAtLoose(f *os.File, pos int64, limit int64) (boxSize int64, boxType string, headerSize int64, ok bool) {
@@ -0,0 +1,519 @@
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var errTagsUnavailable = errors.New("audio tags unavailable")
+
+// AudioTags are the album-level audio tags cached on a MediaFile next to Artist and Title.
+// Zero values mean the tag is absent.
+type AudioTags struct {
+	Album       string
+	AlbumArtist string
+	Track       int
+	Disc        int
+	Year        int
+	Genre       string
+	Composer    string
+}
+
+func (t AudioTags) empty() bool {
+	return t == AudioTags{}
+}
+
+// AudioTags returns the cached audio tags of mf.
+func (mf *MediaFile) AudioTags() AudioTags {
+	return AudioTags{
+		Album:       mf.Album,
+		AlbumArtist: mf.AlbumArtist,
+		Track:       mf.Track,
+		Disc:        mf.Disc,
+		Year:        mf.Year,
+		Genre:       mf.Genre,
+		Composer:    mf.Composer,
+	}
+}
+
+func (mf *MediaFile) setAudioTags(t AudioTags) {
+	mf.Album, mf.AlbumArtist, mf.Track, mf.Disc = t.Album, t.AlbumArtist, t.Track, t.Disc
+	mf.Year, mf.Genre, mf.Composer = t.Year, t.Genre, t.Composer
+}
+
+// EnsureAudioTags best-effort populates the album, album artist, track, disc, year, genre and
+// composer of mf and persists them via UpsertMedia when they were never probed or are stale.
+// Files without such tags are cached as probed too, so they are not reopened on every listing.
+func EnsureAudioTags(mf *MediaFile) (AudioTags, error) {
+	if mf == nil {
+		return AudioTags{}, nil
+	}
+	if mf.Type != "audio" {
+		return mf.AudioTags(), nil
+	}
+	// Cached and still valid.
+	if mf.TagsRefMod == mf.ModifiedAt && mf.TagsRefSize == mf.Size && mf.TagsRefMod != 0 {
+		return mf.AudioTags(), nil
+	}
+
+	tags, err := ProbeAudioTags(mf.Path)
+	if err != nil && !errors.Is(err, errTagsUnavailable) {
+		return AudioTags{}, err
+	}
+
+	mf.setAudioTags(tags)
+	mf.TagsRefMod = mf.ModifiedAt
+	mf.TagsRefSize = mf.Size
+	_ = UpsertMedia(mf)
+	return tags, err
+}
+
+// ProbeAudioTags returns best-effort album-level tags from ID3v2.3/2.4, APEv2 and ID3v1 tags of
+// MP3 files, Vorbis comments of FLAC and Ogg (Vorbis, Opus, FLAC) files and ilst atoms of MP4
+// files.
+func ProbeAudioTags(path string) (AudioTags, error) {
+	path = filepath.Clean(path)
+	if path == "" {
+		return AudioTags{}, errTagsUnavailable
+	}
+
+	var (
+		tags AudioTags
+		err  error
+	)
+	ext := strings.ToLower(filepath.Ext(path))
+	switch ext {
+	case ".mp3":
+		tags, err = probeTagsMP3(path)
+	case ".flac":
+		tags, err = probeTagsFLAC(path)
+	case ".ogg", ".oga", ".opus":
+		tags, err = probeTagsOgg(path)
+	case ".mp4", ".m4a", ".mov", ".m4v", ".3gp", ".3gpp":
+		tags, err = probeTagsMP4(path)
+	default:
+		return AudioTags{}, errTagsUnavailable
+	}
+	if err != nil {
+		return AudioTags{}, err
+	}
+	if tags.empty() {
+		return AudioTags{}, errTagsUnavailable
+	}
+	return tags, nil
+}
+
+func probeTagsMP3(path string) (AudioTags, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return AudioTags{}, err
+	}
+	defer f.Close()
+
+	// Same precedence as the artist: APEv2, then ID3v2, then ID3v1, field by field.
+	var tags AudioTags
+	if items := readMP3APEv2Items(f); items != nil {
+		text := func(keys ...string) string {
+			for _, k := range keys {
+				if v, ok := items[k]; ok {
+					if s := strings.TrimSpace(decodeLegacyTextBestEffort(v)); s != "" {
+						return s
+					}
+				}
+			}
+			return ""
+		}
+		tags.Album = text("album")
+		tags.AlbumArtist = text("album artist", "albumartist")
+		tags.Track = parseTagNumber(text("track"))
+		tags.Disc = parseTagNumber(text("disc"))
+		tags.Year = parseTagYear(text("year"))
+		tags.Genre = parseID3Genre(text("genre"))
+		tags.Composer = text("composer")
+	}
+
+	if tag, ver, flags, ok := readMP3ID3v2Tag(f); ok {
+		frame := func(ids ...string) string {
+			for _, id := range ids {
+				if s := firstTagValue(parseID3v2TextFrame(tag, ver, flags, id)); s != "" {
+					return s
+				}
+			}
+			return ""
+		}
+		fillText(&tags.Album, frame("TALB"))
+		fillText(&tags.AlbumArtist, frame("TPE2"))
+		fillNumber(&tags.Track, parseTagNumber(frame("TRCK")))
+		fillNumber(&tags.Disc, parseTagNumber(frame("TPOS")))
+		fillNumber(&tags.Year, parseTagYear(frame("TDRC", "TYER", "TORY", "TDOR")))
+		fillText(&tags.Genre, parseID3Genre(frame("TCON")))
+		fillText(&tags.Composer, frame("TCOM"))
+	}
+
+	if tag, ok := readMP3ID3v1Tag(f); ok {
+		field := func(start, end int) string {
+			b := bytes.TrimRight(bytes.Trim(tag[start:end], "\x00"), " ")
+			return strings.TrimSpace(decodeLegacyTextBestEffort(b))
+		}
+		fillText(&tags.Album, field(63, 93))
+		fillNumber(&tags.Year, parseTagYear(field(93, 97)))
+		// ID3v1.1 stores the track in the last comment byte after a zero byte.
+		if tag[125] == 0 && tag[126] != 0 {
+			fillNumber(&tags.Track, int(tag[126]))
+		}
+		if tag[127] != 0xff {
+			fillText(&tags.Genre, id3v1GenreName(int(tag[127])))
+		}
+	}
+	return tags, nil
+}
+
+func probeTagsFLAC(path string) (AudioTags, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return AudioTags{}, err
+	}
+	defer f.Close()
+
+	var magic [4]byte
+	if _, err := io.ReadFull(f, magic[:]); err != nil {
+		return AudioTags{}, errTagsUnavailable
+	}
+	if string(magic[:]) != "fLaC" {
+		return AudioTags{}, errTagsUnavailable
+	}
+
+	for {
+		var hdr [4]byte
+		if _, err := io.ReadFull(f, hdr[:]); err != nil {
+			break
+		}
+		isLast := (hdr[0] & 0x80) != 0
+		blockType := hdr[0] & 0x7f
+		blockLen := int(hdr[1])<<16 | int(hdr[2])<<8 | int(hdr[3])
+		if blockLen < 0 || blockLen > 16*1024*1024 {
+			return AudioTags{}, errTagsUnavailable
+		}
+
+		if blockType != 4 {
+			if _, err := f.Seek(int64(blockLen), io.SeekCurrent); err != nil {
+				return AudioTags{}, errTagsUnavailable
+			}
+			if isLast {
+				break
+			}
+			continue
+		}
+
+		buf := make([]byte, blockLen)
+		if _, err := io.ReadFull(f, buf); err != nil {
+			return AudioTags{}, errTagsUnavailable
+		}
+		return parseVorbisCommentTags(buf), nil
+	}
+	return AudioTags{}, errTagsUnavailable
+}
+
+// maxOggCommentSize bounds the comment packet, which may embed cover art.
+const maxOggCommentSize = 16 * 1024 * 1024
+
+func probeTagsOgg(path string) (AudioTags, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return AudioTags{}, err
+	}
+	defer f.Close()
+
+	packets := readOggHeaderPackets(bufio.NewReader(f), 2)
+	if len(packets) < 2 {
+		return AudioTags{}, errTagsUnavailable
+	}
+	id, comment := packets[0], packets[1]
+	switch {
+	case bytes.HasPrefix(id, []byte("\x01vorbis")) && bytes.HasPrefix(comment, []byte("\x03vorbis")):
+		return parseVorbisCommentTags(comment[7:]), nil
+	case bytes.HasPrefix(id, []byte("OpusHead")) && bytes.HasPrefix(comment, []byte("OpusTags")):
+		return parseVorbisCommentTags(comment[8:]), nil
+	case bytes.HasPrefix(id, []byte("\x7fFLAC")) && len(comment) > 4 && comment[0]&0x7f == 4:
+		// Ogg FLAC: the second packet is the VORBIS_COMMENT metadata block with its header.
+		return parseVorbisCommentTags(comment[4:]), nil
+	default:
+		return AudioTags{}, errTagsUnavailable
+	}
+}
+
+// readOggHeaderPackets reassembles the first n packets of the first logical stream of an Ogg
+// file; a short result means the file ended or is not Ogg.
+func readOggHeaderPackets(r io.Reader, n int) [][]byte {
+	var (
+		packets [][]byte
+		cur     []byte
+		serial  uint32
+	)
+	for page := 0; len(packets) < n; page++ {
+		var hdr [27]byte
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			return packets
+		}
+		if string(hdr[0:4]) != "OggS" {
+			return packets
+		}
+		s := binary.LittleEndian.Uint32(hdr[14:18])
+		if page == 0 {
+			serial = s
+		}
+		lacing := make([]byte, hdr[26])
+		if _, err := io.ReadFull(r, lacing); err != nil {
+			return packets
+		}
+		total := 0
+		for _, l := range lacing {
+			total += int(l)
+		}
+		data := make([]byte, total)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return packets
+		}
+		if s != serial {
+			continue
+		}
+		off := 0
+		for _, l := range lacing {
+			cur = append(cur, data[off:off+int(l)]...)
+			off += int(l)
+			if len(cur) > maxOggCommentSize {
+				return packets
+			}
+			// A lacing value below 255 ends the packet.
+			if l < 255 {
+				packets = append(packets, cur)
+				cur = nil
+				if len(packets) == n {
+					break
+				}
+			}
+		}
+	}
+	return packets
+}
+
+// parseVorbisCommentTags reads the tags from a Vorbis comment block (vendor string, count,
+// then KEY=value comments) as used by FLAC, Ogg Vorbis and Opus.
+func parseVorbisCommentTags(b []byte) AudioTags {
+	text := func(keys ...string) string {
+		v, _ := parseVorbisCommentsFields(b, keys, nil)
+		return firstTagValue(v)
+	}
+	return AudioTags{
+		Album:       text("ALBUM"),
+		AlbumArtist: text("ALBUMARTIST", "ALBUM ARTIST", "ALBUM_ARTIST"),
+		Track:       parseTagNumber(text("TRACKNUMBER")),
+		Disc:        parseTagNumber(text("DISCNUMBER")),
+		Year:        parseTagYear(text("DATE", "YEAR", "ORIGINALDATE")),
+		Genre:       text("GENRE"),
+		Composer:    text("COMPOSER"),
+	}
+}
+
+func probeTagsMP4(path string) (AudioTags, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return AudioTags{}, err
+	}
+	defer f.Close()
+
+	st, err := f.Stat()
+	if err != nil {
+		return AudioTags{}, errTagsUnavailable
+	}
+	fileSize := st.Size()
+	if fileSize < 8 {
+		return AudioTags{}, errTagsUnavailable
+	}
+
+	moovStart, moovSize, ok := findTopLevelBoxLoose(f, fileSize, "moov")
+	if !ok {
+		return AudioTags{}, errTagsUnavailable
+	}
+	items := map[string][]byte{}
+	collectMP4DataItems(f, moovStart, moovSize, 0, false, items)
+
+	text := func(typ string) string {
+		return strings.TrimSpace(string(bytes.Trim(items[typ], "\x00")))
+	}
+	tags := AudioTags{
+		Album:       text("\xa9alb"),
+		AlbumArtist: text("aART"),
+		Track:       parseMP4IndexItem(items["trkn"]),
+		Disc:        parseMP4IndexItem(items["disk"]),
+		Year:        parseTagYear(text("\xa9day")),
+		Genre:       text("\xa9gen"),
+		Composer:    text("\xa9wrt"),
+	}
+	// gnre holds an ID3v1 genre index plus one.
+	if g := items["gnre"]; tags.Genre == "" && len(g) >= 2 {
+		tags.Genre = id3v1GenreName(int(binary.BigEndian.Uint16(g[0:2])) - 1)
+	}
+	return tags, nil
+}
+
+// collectMP4DataItems stores the data payload of every ilst item below moov into items, keyed
+// by the item's box type.
+func collectMP4DataItems(f *os.File, start, size int64, depth int, inIlst bool, items map[string][]byte) {
+	if depth > 12 {
+		return
+	}
+	end := start + size
+	pos := start
+	for pos+8 <= end {
+		bs, bt, header, ok := readMP4BoxHeaderAtLoose(f, pos, end)
+		if !ok {
+			return
+		}
+		payloadStart := pos + header
+		payloadSize := bs - header
+		if payloadSize < 0 || payloadStart+payloadSize > end {
+			return
+		}
+
+		switch bt {
+		case "meta":
+			// FullBox: 4 bytes version/flags then children.
+			if payloadSize > 4 {
+				collectMP4DataItems(f, payloadStart+4, payloadSize-4, depth+1, false, items)
+			}
+		case "moov", "udta", "ilst":
+			collectMP4DataItems(f, payloadStart, payloadSize, depth+1, bt == "ilst", items)
+		default:
+			if inIlst {
+				if _, seen := items[bt]; !seen {
+					if b := parseMP4DataItem(f, payloadStart, payloadSize); b != nil {
+						items[bt] = b
+					}
+				}
+			}
+		}
+
+		pos += bs
+	}
+}
+
+// parseMP4IndexItem reads the number of a trkn/disk item: 2 reserved bytes, the number and the
+// total as big-endian uint16.
+func parseMP4IndexItem(b []byte) int {
+	if len(b) < 4 {
+		return 0
+	}
+	return int(binary.BigEndian.Uint16(b[2:4]))
+}
+
+// firstTagValue returns the first of several NUL-separated values (ID3v2.4 and some taggers
+// write multi-valued frames that way).
+func firstTagValue(s string) string {
+	s, _, _ = strings.Cut(s, "\x00")
+	return strings.TrimSpace(s)
+}
+
+// parseTagNumber parses a track or disc number such as "3", "03" or "3/12".
+func parseTagNumber(s string) int {
+	s, _, _ = strings.Cut(strings.TrimSpace(s), "/")
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// parseTagYear returns the year of a date tag such as "1997", "1997-05-21" or
+// "1997-05-21T10:00:00Z".
+func parseTagYear(s string) int {
+	s = strings.TrimSpace(s)
+	if len(s) < 4 {
+		return 0
+	}
+	n, err := strconv.Atoi(s[:4])
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return n
+}
+
+// parseID3Genre resolves the numeric forms of an ID3v2 TCON frame: "(13)", "(13)Pop" (where
+// the text refines the number) and "13". Other text is returned as is.
+func parseID3Genre(s string) string {
+	s = strings.TrimSpace(s)
+	for strings.HasPrefix(s, "(") {
+		end := strings.IndexByte(s, ')')
+		if end < 0 {
+			break
+		}
+		ref, rest := s[1:end], strings.TrimSpace(s[end+1:])
+		if rest != "" {
+			s = rest
+			continue
+		}
+		switch ref {
+		case "RX":
+			return "Remix"
+		case "CR":
+			return "Cover"
+		}
+		if n, err := strconv.Atoi(ref); err == nil {
+			return id3v1GenreName(n)
+		}
+		return s
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		return id3v1GenreName(n)
+	}
+	return s
+}
+
+func id3v1GenreName(n int) string {
+	if n < 0 || n >= len(id3v1Genres) {
+		return ""
+	}
+	return id3v1Genres[n]
+}
+
+func fillText(dst *string, v string) {
+	if *dst == "" {
+		*dst = v
+	}
+}
+
+func fillNumber(dst *int, v int) {
+	if *dst == 0 {
+		*dst = v
+	}
+}
+
+// id3v1Genres are the ID3v1 genres with the Winamp extensions.
+var id3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop",
+	"Jazz", "Metal", "New Age", "Oldies", "Other", "Pop", "R&B", "Rap",
+	"Reggae", "Rock", "Techno", "Industrial", "Alternative", "Ska", "Death Metal", "Pranks",
+	"Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk", "Fusion", "Trance",
+	"Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"Alternative Rock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock",
+	"Ethnic", "Gothic", "Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream",
+	"Southern Rock", "Comedy", "Cult", "Gangsta", "Top 40", "Christian Rap", "Pop/Funk", "Jungle",
+	"Native American", "Cabaret", "New Wave", "Psychedelic", "Rave", "Showtunes", "Trailer", "Lo-Fi",
+	"Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+	"Folk", "Folk-Rock", "National Folk", "Swing", "Fast Fusion", "Bebop", "Latin", "Revival",
+	"Celtic", "Bluegrass", "Avantgarde", "Gothic Rock", "Progressive Rock", "Psychedelic Rock", "Symphonic Rock", "Slow Rock",
+	"Big Band", "Chorus", "Easy Listening", "Acoustic", "Humour", "Speech", "Chanson", "Opera",
+	"Chamber Music", "Sonata", "Symphony", "Booty Bass", "Primus", "Porn Groove", "Satire", "Slow Jam",
+	"Club", "Tango", "Samba", "Folklore", "Ballad", "Power Ballad", "Rhythmic Soul", "Freestyle",
+	"Duet", "Punk Rock", "Drum Solo", "A Cappella", "Euro-House", "Dance Hall", "Goa", "Drum & Bass",
+	"Club-House", "Hardcore", "Terror", "Indie", "BritPop", "Afro-Punk", "Polsk Punk", "Beat",
+	"Christian Gangsta Rap", "Heavy Metal", "Black Metal", "Crossover", "Contemporary Christian", "Christian Rock", "Merengue", "Salsa",
+	"Thrash Metal", "Anime", "JPop", "Synthpop",
+}
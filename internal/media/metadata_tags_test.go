@@ -0,0 +1,158 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func id3v24Frame(id string, text string) []byte {
+	payload := append([]byte{0x03}, []byte(text)...) // UTF-8
+	frame := append([]byte(id), 0, 0, 0, 0, 0x00, 0x00)
+	size := syncsafe32(len(payload))
+	copy(frame[4:8], size[:])
+	return append(frame, payload...)
+}
+
+func TestProbeAudioTags_MP3_ID3v24AndID3v1(t *testing.T) {
+	frames := &bytes.Buffer{}
+	frames.Write(id3v24Frame("TALB", "Blue Album"))
+	frames.Write(id3v24Frame("TPE2", "Weezer"))
+	frames.Write(id3v24Frame("TRCK", "03/10"))
+	frames.Write(id3v24Frame("TDRC", "1994-05-10"))
+	frames.Write(id3v24Frame("TCON", "(17)"))
+	frames.Write(id3v24Frame("TCOM", "Rivers Cuomo"))
+
+	b := &bytes.Buffer{}
+	b.WriteString("ID3")
+	b.Write([]byte{0x04, 0x00, 0x00}) // v2.4.0, no flags
+	size := syncsafe32(frames.Len())
+	b.Write(size[:])
+	b.Write(frames.Bytes())
+
+	// ID3v1.1 only fills fields ID3v2 lacks; its album and genre must not win.
+	var v1 [128]byte
+	copy(v1[0:3], "TAG")
+	copy(v1[63:93], "Other Album")
+	v1[126] = 7
+	v1[127] = 13
+	b.Write(v1[:])
+
+	p := writeTempFileArtist(t, "tags-*.mp3", b.Bytes())
+	defer os.Remove(p)
+
+	got, err := ProbeAudioTags(filepath.Clean(p))
+	if err != nil {
+		t.Fatalf("ProbeAudioTags err: %v", err)
+	}
+	want := AudioTags{Album: "Blue Album", AlbumArtist: "Weezer", Track: 3, Year: 1994, Genre: "Rock", Composer: "Rivers Cuomo"}
+	if got != want {
+		t.Fatalf("ProbeAudioTags = %+v, want %+v", got, want)
+	}
+}
+
+func vorbisComments(comments ...string) []byte {
+	b := &bytes.Buffer{}
+	binary.Write(b, binary.LittleEndian, uint32(4))
+	b.WriteString("test")
+	binary.Write(b, binary.LittleEndian, uint32(len(comments)))
+	for _, c := range comments {
+		binary.Write(b, binary.LittleEndian, uint32(len(c)))
+		b.WriteString(c)
+	}
+	return b.Bytes()
+}
+
+func oggPage(serial uint32, seq uint32, packet []byte) []byte {
+	var lacing []byte
+	n := len(packet)
+	for n >= 255 {
+		lacing = append(lacing, 255)
+		n -= 255
+	}
+	lacing = append(lacing, byte(n))
+	hdr := make([]byte, 27)
+	copy(hdr, "OggS")
+	binary.LittleEndian.PutUint32(hdr[14:18], serial)
+	binary.LittleEndian.PutUint32(hdr[18:22], seq)
+	hdr[26] = byte(len(lacing))
+	out := append(hdr, lacing...)
+	return append(out, packet...)
+}
+
+func TestProbeAudioTags_Opus(t *testing.T) {
+	// The long composer pushes the comment packet across several lacing segments.
+	comment := append([]byte("OpusTags"), vorbisComments(
+		"ALBUM=Kind of Blue",
+		"ALBUMARTIST=Miles Davis",
+		"TRACKNUMBER=2",
+		"DISCNUMBER=1/1",
+		"DATE=1959",
+		"GENRE=Jazz",
+		"COMPOSER="+string(bytes.Repeat([]byte("x"), 300)),
+	)...)
+	b := &bytes.Buffer{}
+	b.Write(oggPage(1, 0, append([]byte("OpusHead"), make([]byte, 11)...)))
+	b.Write(oggPage(1, 1, comment))
+
+	p := writeTempFileArtist(t, "tags-*.opus", b.Bytes())
+	defer os.Remove(p)
+
+	got, err := ProbeAudioTags(filepath.Clean(p))
+	if err != nil {
+		t.Fatalf("ProbeAudioTags err: %v", err)
+	}
+	if got.Album != "Kind of Blue" || got.AlbumArtist != "Miles Davis" || got.Track != 2 || got.Disc != 1 ||
+		got.Year != 1959 || got.Genre != "Jazz" || len(got.Composer) != 300 {
+		t.Fatalf("ProbeAudioTags = %+v", got)
+	}
+}
+
+func mp4DataItem(typ string, value []byte) []byte {
+	return mp4Box(typ, mp4Box("data", append(make([]byte, 8), value...)))
+}
+
+func TestProbeAudioTags_MP4(t *testing.T) {
+	ilst := mp4Box("ilst", bytes.Join([][]byte{
+		mp4DataItem("\xa9alb", []byte("Homework")),
+		mp4DataItem("trkn", []byte{0, 0, 0, 5, 0, 16, 0, 0}),
+		mp4DataItem("disk", []byte{0, 0, 0, 1, 0, 2}),
+		mp4DataItem("\xa9day", []byte("1997-01-20T08:00:00Z")),
+		mp4DataItem("gnre", []byte{0, 53}), // ID3v1 genre 52 + 1
+	}, nil))
+	meta := mp4Box("meta", append(make([]byte, 4), ilst...))
+	moov := mp4Box("moov", mp4Box("udta", meta))
+	b := append(mp4Box("ftyp", []byte("M4A \x00\x00\x00\x00")), moov...)
+
+	p := writeTempFileArtist(t, "tags-*.m4a", b)
+	defer os.Remove(p)
+
+	got, err := ProbeAudioTags(filepath.Clean(p))
+	if err != nil {
+		t.Fatalf("ProbeAudioTags err: %v", err)
+	}
+	want := AudioTags{Album: "Homework", Track: 5, Disc: 1, Year: 1997, Genre: "Electronic"}
+	if got != want {
+		t.Fatalf("ProbeAudioTags = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseID3Genre(t *testing.T) {
+	cases := map[string]string{
+		"(13)":           "Pop",
+		"(13)Power Pop":  "Power Pop",
+		"17":             "Rock",
+		"(RX)":           "Remix",
+		"Shoegaze":       "Shoegaze",
+		"(999)":          "",
+		"":               "",
+		"(4)(9)Nu Metal": "Nu Metal",
+	}
+	for in, want := range cases {
+		if got := parseID3Genre(in); got != want {
+			t.Errorf("parseID3Genre(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
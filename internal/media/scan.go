@@ -6,13 +6,14 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
 
 	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/db"
-	"ismartcoding/plainnas/internal/pkg/eventbus"
+	"ismartcoding/plainnas/internal/ignore"
 	"ismartcoding/plainnas/internal/pkg/log"
 )
 
@@ -32,9 +33,15 @@ func isFileInTrash(path string) bool {
 	return strings.Contains(cleanPath, needle) || strings.HasSuffix(cleanPath, sep+".nas-trash")
 }
 
-// ScanAndSync walks a root directory to update media DB and index.
-func ScanAndSync(root string) error {
-	root = filepath.Clean(root)
+// scanRoot walks the root directory of job j to update media DB and index.
+func scanRoot(j *ScanJob) error {
+	root := j.Root
+	if _, err := os.Stat(root); err != nil {
+		return err
+	}
+	if !j.start() {
+		return nil
+	}
 	log.Infof("Starting media scan from root: %s", root)
 	if root == "/" {
 		log.Info("Full disk scan mode: will skip system directories for optimal performance")
@@ -52,6 +59,22 @@ func ScanAndSync(root string) error {
 		ctime  int64
 	}
 	seen := make(map[fidKey]struct{})
+	// scanned maps each walked directory to whether its files are looked at; incremental scans
+	// skip directories with an unchanged mtime. dirMtimes are recorded once the scan completed,
+	// for directories whose files were all indexed.
+	scanned := make(map[string]bool)
+	dirMtimes := make(map[string]int64)
+	// incomplete holds the directories with a file that was not indexed (failed, or left out by
+	// the source dir whitelist); their mtimes are not recorded, so the next scan looks again.
+	var incompleteMu sync.Mutex
+	incomplete := make(map[string]struct{})
+	markIncomplete := func(path string) {
+		incompleteMu.Lock()
+		incomplete[filepath.Dir(path)] = struct{}{}
+		incompleteMu.Unlock()
+	}
+	// .plainnasignore files and the global ignore patterns.
+	ign := ignore.New(db.GetIgnorePatterns())
 
 	// Resolve filesystem id once for non-root scans (hot path optimization).
 	rootFSID := ""
@@ -60,11 +83,8 @@ func ScanAndSync(root string) error {
 			rootFSID = fsid
 		}
 	}
-	var total int64
-	var done int64
-	atomic.StoreInt32(&stopFlag, 0)
-	atomic.StoreInt32(&pauseFlag, 0)
-	setStateRunning()
+	total := &j.total
+	done := &j.indexed
 
 	// Cancel any previous cleanup operations before starting new scan
 	if cleanupCancel != nil {
@@ -72,6 +92,7 @@ func ScanAndSync(root string) error {
 	}
 	// pre-count files (best-effort)
 	if consts.ENABLE_SCAN_PRECOUNT {
+		unchanged := make(map[string]bool)
 		_ = filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
 			if err != nil {
 				return nil
@@ -105,15 +126,26 @@ func ScanAndSync(root string) error {
 					strings.HasPrefix(path, "/bin/") {
 					return filepath.SkipDir
 				}
+				if path != root && ign.Match(path, true) {
+					return filepath.SkipDir
+				}
+				if j.Incremental {
+					if info, err := d.Info(); err == nil && dirUnchanged(path, info.ModTime().UnixNano()) {
+						unchanged[path] = true
+					}
+				}
 				return nil
 			}
 			if len(name) > 0 && name[0] == '.' {
 				return nil
 			}
+			if unchanged[filepath.Dir(path)] || ign.Match(path, false) {
+				return nil
+			}
 			if len(allowedRoots) > 0 && !pathInAnyPrefix(path, allowedRoots) {
 				return nil
 			}
-			atomic.AddInt64(&total, 1)
+			atomic.AddInt64(total, 1)
 			return nil
 		})
 	}
@@ -124,16 +156,7 @@ func ScanAndSync(root string) error {
 		for {
 			select {
 			case <-ticker.C:
-				t := atomic.LoadInt64(&total)
-				d := atomic.LoadInt64(&done)
-				UpdateProgress(d, t)
-				eventbus.GetDefault().Publish(consts.EVENT_MEDIA_SCAN_PROGRESS, map[string]any{
-					"indexed": d,
-					"pending": max64(t-d, 0),
-					"total":   t,
-					"state":   getStateString(),
-					"root":    filepath.ToSlash(root),
-				})
+				j.publish()
 			case <-doneCh:
 				return
 			}
@@ -147,8 +170,11 @@ func ScanAndSync(root string) error {
 	for w := 0; w < consts.SCAN_INDEXER_WORKERS; w++ {
 		go func() {
 			for mf := range jobs {
-				_ = UpsertMedia(mf)
-				atomic.AddInt64(&done, 1)
+				if err := UpsertMedia(mf); err != nil {
+					j.addError(fmt.Sprintf("%s: %v", mf.Path, err))
+					markIncomplete(mf.Path)
+				}
+				atomic.AddInt64(done, 1)
 			}
 			doneCh2 <- struct{}{}
 		}()
@@ -156,16 +182,17 @@ func ScanAndSync(root string) error {
 	// producer walk
 	_ = filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
+			j.addError(err.Error())
 			return nil
 		}
-		if IsStopping() {
+		if j.stopping() {
 			return fmt.Errorf("stopped")
 		}
-		for IsPaused() {
+		for j.paused() && !j.stopping() {
 			time.Sleep(200 * time.Millisecond)
 		}
 		// Yield briefly every few files to smooth CPU on low-end CPUs
-		if atomic.LoadInt64(&done)%consts.SCAN_YIELD_EVERY_N == 0 {
+		if atomic.LoadInt64(done)%consts.SCAN_YIELD_EVERY_N == 0 {
 			time.Sleep(time.Duration(consts.SCAN_YIELD_MS) * time.Millisecond)
 		}
 		name := d.Name()
@@ -193,12 +220,32 @@ func ScanAndSync(root string) error {
 				strings.HasPrefix(path, "/bin/") {
 				return filepath.SkipDir
 			}
+			if path != root && ign.Match(path, true) {
+				return filepath.SkipDir
+			}
+			scanFiles := true
+			if info, err := d.Info(); err == nil {
+				mt := info.ModTime().UnixNano()
+				if j.Incremental && dirUnchanged(path, mt) {
+					scanFiles = false
+				} else {
+					dirMtimes[path] = mt
+				}
+			}
+			scanned[path] = scanFiles
 			return nil
 		}
 		if len(name) > 0 && name[0] == '.' {
 			return nil
 		}
+		if j.Incremental && !scanned[filepath.Dir(path)] {
+			return nil
+		}
+		if ign.Match(path, false) {
+			return nil
+		}
 		if len(allowedRoots) > 0 && !pathInAnyPrefix(path, allowedRoots) {
+			markIncomplete(path)
 			return nil
 		}
 		// Skip metadata-like sidecars (legacy)
@@ -212,13 +259,16 @@ func ScanAndSync(root string) error {
 		info, e := d.Info()
 		if e != nil {
 			// Count this entry to avoid pending hanging when stat fails
-			atomic.AddInt64(&done, 1)
+			j.addError(e.Error())
+			markIncomplete(path)
+			atomic.AddInt64(done, 1)
 			return nil
 		}
 		st, ok := info.Sys().(*syscall.Stat_t)
 		if !ok || st == nil {
 			// Count this entry even if stat decoding failed
-			atomic.AddInt64(&done, 1)
+			markIncomplete(path)
+			atomic.AddInt64(done, 1)
 			return nil
 		}
 		ino := uint64(st.Ino)
@@ -269,20 +319,21 @@ func ScanAndSync(root string) error {
 	// Ensure any pending batched index writes are flushed
 	_ = FlushMediaIndexBatch()
 
-	finalIndexed := atomic.LoadInt64(&done)
-	finalTotal := atomic.LoadInt64(&total)
-	log.Infof("Media scan completed: indexed %d files out of %d total", finalIndexed, finalTotal)
-
-	// Immediately reflect completion to UI before heavier cleanup
+	finalIndexed := atomic.LoadInt64(done)
+	finalTotal := atomic.LoadInt64(total)
 	close(doneCh)
-	setStateIdle()
-	eventbus.GetDefault().Publish(consts.EVENT_MEDIA_SCAN_PROGRESS, map[string]any{
-		"indexed": finalIndexed,
-		"pending": max64(finalTotal-finalIndexed, 0),
-		"total":   finalTotal,
-		"state":   getStateString(),
-		"root":    filepath.ToSlash(root),
-	})
+	if j.stopping() {
+		// Entries the walk did not reach are not gone; leave them to the next scan.
+		log.Infof("Media scan stopped: indexed %d files out of %d total", finalIndexed, finalTotal)
+		return nil
+	}
+	log.Infof("Media scan completed: indexed %d files out of %d total", finalIndexed, finalTotal)
+	for dir := range incomplete {
+		delete(dirMtimes, dir)
+	}
+	if err := storeDirMtimes(dirMtimes); err != nil {
+		log.Errorf("Media scan: record directory mtimes: %v", err)
+	}
 
 	// Cleanup: remove entries under this root that were not seen (run after final progress update)
 	// Use a cancellable context to prevent race conditions with subsequent scans
@@ -319,8 +370,12 @@ func ScanAndSync(root string) error {
 					return nil
 				}
 
-				// Only delete if file doesn't exist on disk
-				if _, err := os.Stat(mf.Path); os.IsNotExist(err) {
+				// Keep the offline catalog of unplugged volumes.
+				if !VolumeOnline(mf.FSUUID) {
+					return nil
+				}
+				// Only delete if file doesn't exist on disk or is ignored now
+				if _, err := os.Stat(mf.Path); os.IsNotExist(err) || ign.Ignored(mf.Path, false) {
 					batch = append(batch, append([]byte{}, key...))
 					_ = DeleteMedia(uuid)
 					deletedCount++
@@ -359,6 +414,15 @@ func ScanAndSync(root string) error {
 				if isFileInTrash(mf.Path) {
 					return nil
 				}
+				// Media of an unplugged volume that used the same mount point is kept as
+				// offline catalog.
+				if !VolumeOnline(mf.FSUUID) {
+					return nil
+				}
+				// Files of directories an incremental scan skipped were not looked at.
+				if walked, ok := scanned[filepath.Dir(mf.Path)]; ok && !walked {
+					return nil
+				}
 				k := fidKey{fsHash: fidHash(mf.FSUUID), ino: mf.Ino, ctime: mf.Ctime}
 				if _, ok := seen[k]; !ok {
 					batch = append(batch, append([]byte{}, key...))
@@ -12,8 +12,8 @@ import (
 
 	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/fuzzy"
 
-	"github.com/cespare/xxhash/v2"
 	"golang.org/x/sys/unix"
 )
 
@@ -26,26 +26,12 @@ func init() {
 	mediaIndexPath = filepath.Join(consts.DATA_DIR, "searchidx_media")
 }
 
-func mediaNameDat() string  { return filepath.Join(mediaIndexPath, "name.postings.dat") }
-func mediaNameIdx() string  { return filepath.Join(mediaIndexPath, "name.postings.idx") }
-func mediaNameDict() string { return filepath.Join(mediaIndexPath, "name.dict.json") }
-func mediaPathDat() string  { return filepath.Join(mediaIndexPath, "path.postings.dat") }
-func mediaPathIdx() string  { return filepath.Join(mediaIndexPath, "path.postings.idx") }
-func mediaPathDict() string { return filepath.Join(mediaIndexPath, "path.dict.json") }
-
-// Ngram index files for fuzzy search
-func mediaNameNgramDat() string  { return filepath.Join(mediaIndexPath, "name_ngram.postings.dat") }
-func mediaNameNgramIdx() string  { return filepath.Join(mediaIndexPath, "name_ngram.postings.idx") }
-func mediaNameNgramDict() string { return filepath.Join(mediaIndexPath, "name_ngram.dict.json") }
-func mediaPathNgramDat() string  { return filepath.Join(mediaIndexPath, "path_ngram.postings.dat") }
-func mediaPathNgramIdx() string  { return filepath.Join(mediaIndexPath, "path_ngram.postings.idx") }
-func mediaPathNgramDict() string { return filepath.Join(mediaIndexPath, "path_ngram.dict.json") }
-
 // ResetMediaIndex closes and removes the media index on disk.
 func ResetMediaIndex() error {
 	if mediaIndexPath == "" {
 		mediaIndexPath = filepath.Join(consts.DATA_DIR, "searchidx_media")
 	}
+	fuzzy.Forget(mediaIndexPath)
 	return os.RemoveAll(mediaIndexPath)
 }
 
@@ -54,9 +40,16 @@ type mediaMmap struct {
 	dict map[string]uint32
 	dat  []byte
 	idx  []byte
+	// dictPath and dictVersion (size and mtime of the dict file) key the cached typo tree
+	dictPath    string
+	dictVersion string
 }
 
 func openMediaMmap(dictPath, datPath, idxPath string) (*mediaMmap, error) {
+	dst, err := os.Stat(dictPath)
+	if err != nil {
+		return nil, err
+	}
 	b, err := os.ReadFile(dictPath)
 	if err != nil {
 		return nil, err
@@ -94,7 +87,8 @@ func openMediaMmap(dictPath, datPath, idxPath string) (*mediaMmap, error) {
 		_ = unix.Munmap(dat)
 		return nil, err
 	}
-	return &mediaMmap{dict: dict, dat: dat, idx: idx}, nil
+	version := fmt.Sprintf("%d:%d", dst.Size(), dst.ModTime().UnixNano())
+	return &mediaMmap{dict: dict, dat: dat, idx: idx, dictPath: dictPath, dictVersion: version}, nil
 }
 
 func (m *mediaMmap) close() {
@@ -176,56 +170,6 @@ func (m *mediaMmap) postingCapped(termID uint32, capN int) ([]uint64, error) {
 	return out, nil
 }
 
-// BuildMediaIndex rebuilds the media inverted index from Pebble records.
-func BuildMediaIndex() error {
-	_ = os.MkdirAll(mediaIndexPath, 0o755)
-	names := make(map[string][]uint64, 1<<16)
-	paths := make(map[string][]uint64, 1<<16)
-	// Fuzzy ngram term maps
-	nameNgrams := make(map[string][]uint64, 1<<16)
-	pathNgrams := make(map[string][]uint64, 1<<16)
-	peb := db.GetDefault()
-	err := peb.Iterate([]byte("media:uuid:"), func(_ []byte, value []byte) error {
-		var m MediaFile
-		if err := json.Unmarshal(value, &m); err != nil {
-			return nil
-		}
-		docID := xxhash.Sum64String(m.UUID)
-		_ = peb.Set(keyByDocID(docID), []byte(m.UUID), nil)
-		for _, t := range tokenize(m.Name) {
-			names[t] = append(names[t], docID)
-		}
-		for _, t := range tokenize(filepath.ToSlash(m.Path)) {
-			paths[t] = append(paths[t], docID)
-		}
-		// ngram tokens
-		for _, ng := range buildQueryNgrams(m.Name) {
-			nameNgrams[ng] = append(nameNgrams[ng], docID)
-		}
-		for _, ng := range buildQueryNgrams(filepath.ToSlash(m.Path)) {
-			pathNgrams[ng] = append(pathNgrams[ng], docID)
-		}
-		return nil
-	})
-	if err != nil {
-		return err
-	}
-	if err := buildIndexFiles(names, mediaNameDict(), mediaNameDat(), mediaNameIdx()); err != nil {
-		return err
-	}
-	if err := buildIndexFiles(paths, mediaPathDict(), mediaPathDat(), mediaPathIdx()); err != nil {
-		return err
-	}
-	// Build ngram indexes (append-only)
-	if err := buildIndexFiles(nameNgrams, mediaNameNgramDict(), mediaNameNgramDat(), mediaNameNgramIdx()); err != nil {
-		return err
-	}
-	if err := buildIndexFiles(pathNgrams, mediaPathNgramDict(), mediaPathNgramDat(), mediaPathNgramIdx()); err != nil {
-		return err
-	}
-	return nil
-}
-
 func Search(query string, filters map[string]string, offset int, limit int) ([]MediaFile, error) {
 	// Normalize filters
 	filtType := strings.ToLower(filters["type"])
@@ -319,16 +263,12 @@ func Search(query string, filters map[string]string, offset int, limit int) ([]M
 	useFallback := !MediaIndexExists()
 	var out []MediaFile
 	if !useFallback {
-		// Open exact indexes for name and path
-		nm, err1 := openMediaMmap(mediaNameDict(), mediaNameDat(), mediaNameIdx())
-		pm, err2 := openMediaMmap(mediaPathDict(), mediaPathDat(), mediaPathIdx())
+		// Open exact indexes for name and path of all volumes
+		nm, err1 := openMediaVolumeIndex(segName)
+		pm, err2 := openMediaVolumeIndex(segPath)
 		if err1 != nil || err2 != nil {
-			if nm != nil {
-				nm.close()
-			}
-			if pm != nil {
-				pm.close()
-			}
+			nm.close()
+			pm.close()
 			useFallback = true
 		} else {
 			defer nm.close()
@@ -339,11 +279,7 @@ func Search(query string, filters map[string]string, offset int, limit int) ([]M
 				type termSet struct{ ids []uint64 }
 				sets := make([]termSet, 0, len(exactTerms))
 				for _, t := range exactTerms {
-					nid := nm.dict[t]
-					pid := pm.dict[t]
-					npl, _ := nm.posting(nid)
-					ppl, _ := pm.posting(pid)
-					union := unionSorted(npl, ppl)
+					union := unionSorted(nm.term(t), pm.term(t))
 					sets = append(sets, termSet{ids: union})
 				}
 				sort.Slice(sets, func(i, j int) bool { return len(sets[i].ids) < len(sets[j].ids) })
@@ -364,21 +300,17 @@ func Search(query string, filters map[string]string, offset int, limit int) ([]M
 			}
 			finalIDs := idsExact
 			if enableFuzzy {
-				fnm, e1 := openMediaMmap(mediaNameNgramDict(), mediaNameNgramDat(), mediaNameNgramIdx())
-				fpm, e2 := openMediaMmap(mediaPathNgramDict(), mediaPathNgramDat(), mediaPathNgramIdx())
+				fnm, e1 := openMediaVolumeIndex(segNameNgram)
+				fpm, e2 := openMediaVolumeIndex(segPathNgram)
+				defer fnm.close()
+				defer fpm.close()
 				if e1 == nil && e2 == nil {
-					defer fnm.close()
-					defer fpm.close()
 					ngrams := buildQueryNgrams(query)
 					if len(ngrams) > 0 {
 						type termSet struct{ ids []uint64 }
 						fsets := make([]termSet, 0, len(ngrams))
 						for _, ng := range ngrams {
-							nid := fnm.dict[ng]
-							pid := fpm.dict[ng]
-							npl, _ := fnm.postingCapped(nid, 20000)
-							ppl, _ := fpm.postingCapped(pid, 20000)
-							union := unionSorted(npl, ppl)
+							union := unionSorted(fnm.termCapped(ng, 20000), fpm.termCapped(ng, 20000))
 							if len(union) > 0 {
 								fsets = append(fsets, termSet{ids: union})
 							}
@@ -399,6 +331,16 @@ func Search(query string, filters map[string]string, offset int, limit int) ([]M
 					}
 				}
 			}
+			// Pinyin matches go after the direct character matches
+			if ids := pinyinMatches(exactTerms); len(ids) > 0 {
+				finalIDs = appendNew(finalIDs, ids)
+			}
+			// Typo matches go after the exact and ngram matches, closest first
+			if enableFuzzy {
+				if typos := typoMatches(exactTerms, append(nm.parts, pm.parts...)...); len(typos) > 0 {
+					finalIDs = append(finalIDs, typoOrder(typos, finalIDs)...)
+				}
+			}
 			peb := db.GetDefault()
 			out = make([]MediaFile, 0, len(finalIDs))
 			for _, docID := range finalIDs {
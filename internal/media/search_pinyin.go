@@ -0,0 +1,84 @@
+package media
+
+import (
+	"path/filepath"
+	"sort"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/pkg/pinyin"
+)
+
+// Pinyin transliteration of Chinese names and folders (see pinyin.Tokens) is kept in the pinyin
+// segment of each volume, shared pattern with FS search.
+
+func pinyinEnabled() bool {
+	return config.GetDefault().GetString("search.pinyin") != "false"
+}
+
+// pinyinSegmentOK reports whether the volume in dir has the pinyin segment when enabled.
+func pinyinSegmentOK(dir string) bool {
+	return !pinyinEnabled() || mediaSegmentExists(dir, segPinyin)
+}
+
+// addPinyinTokens indexes the pinyin of the media name and its folders under docID.
+func addPinyinTokens(terms map[string][]uint64, m *MediaFile, docID uint64) {
+	seen := map[string]struct{}{}
+	for _, s := range []string{m.Name, filepath.ToSlash(filepath.Dir(m.Path))} {
+		for _, t := range pinyin.Tokens(s) {
+			if _, ok := seen[t]; ok {
+				continue
+			}
+			seen[t] = struct{}{}
+			terms[t] = append(terms[t], docID)
+		}
+	}
+}
+
+// pinyinMatches returns the media whose name or folders transliterate to every query term.
+// Only all-ASCII-letter queries are looked up.
+func pinyinMatches(terms []string) []uint64 {
+	if len(terms) == 0 || !pinyinEnabled() {
+		return nil
+	}
+	for _, t := range terms {
+		for i := 0; i < len(t); i++ {
+			if t[i] < 'a' || t[i] > 'z' {
+				return nil
+			}
+		}
+	}
+	pm, err := openMediaVolumeIndex(segPinyin)
+	if err != nil {
+		return nil
+	}
+	defer pm.close()
+	var ids []uint64
+	for i, t := range terms {
+		pl := pm.term(t)
+		if i == 0 {
+			ids = pl
+		} else {
+			ids = intersectSorted(ids, pl)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+	}
+	return ids
+}
+
+// appendNew appends the ids not already in found, in ascending order.
+func appendNew(found, ids []uint64) []uint64 {
+	seen := make(map[uint64]struct{}, len(found))
+	for _, id := range found {
+		seen[id] = struct{}{}
+	}
+	extra := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			extra = append(extra, id)
+		}
+	}
+	sort.Slice(extra, func(i, j int) bool { return extra[i] < extra[j] })
+	return append(found, extra...)
+}
@@ -0,0 +1,116 @@
+package media
+
+import (
+	"sort"
+
+	"ismartcoding/plainnas/internal/pkg/fuzzy"
+)
+
+const (
+	// maxTypoExpansions caps how many dictionary terms one query term may expand to.
+	maxTypoExpansions = 32
+	// typoPostingCap truncates the posting lists read for typo matching, like the ngram fallback.
+	typoPostingCap = 20000
+)
+
+// typoTree returns the BK-tree over the index dictionary, built once per index rebuild.
+func (m *mediaMmap) typoTree() *fuzzy.BKTree {
+	return fuzzy.Cached(m.dictPath, m.dictVersion, func() *fuzzy.BKTree {
+		terms := make([]string, 0, len(m.dict))
+		for t := range m.dict {
+			terms = append(terms, t)
+		}
+		sort.Strings(terms)
+		return fuzzy.NewBKTree(terms)
+	})
+}
+
+// typoMatches finds media whose name or path matches every term exactly or within
+// fuzzy.MaxEdits edits of a dictionary term. It returns the summed edit distance per document,
+// leaving out documents that match all terms exactly.
+func typoMatches(terms []string, idxs ...*mediaMmap) map[uint64]int {
+	if len(terms) == 0 {
+		return nil
+	}
+	tolerant := false
+	for _, t := range terms {
+		if fuzzy.MaxEdits(t) > 0 {
+			tolerant = true
+			break
+		}
+	}
+	if !tolerant {
+		return nil
+	}
+	var acc map[uint64]int
+	for i, t := range terms {
+		dists := make(map[uint64]int)
+		k := fuzzy.MaxEdits(t)
+		for _, idx := range idxs {
+			pl, _ := idx.postingCapped(idx.dict[t], typoPostingCap)
+			for _, id := range pl {
+				dists[id] = 0
+			}
+			if k == 0 {
+				continue
+			}
+			n := 0
+			for _, m := range idx.typoTree().Search(t, k) {
+				if m.Distance == 0 {
+					continue
+				}
+				if n == maxTypoExpansions {
+					break
+				}
+				n++
+				pl, _ := idx.postingCapped(idx.dict[m.Term], typoPostingCap)
+				for _, id := range pl {
+					if d, ok := dists[id]; !ok || m.Distance < d {
+						dists[id] = m.Distance
+					}
+				}
+			}
+		}
+		if i == 0 {
+			acc = dists
+			continue
+		}
+		for id, d := range acc {
+			if d2, ok := dists[id]; ok {
+				acc[id] = d + d2
+			} else {
+				delete(acc, id)
+			}
+		}
+		if len(acc) == 0 {
+			return nil
+		}
+	}
+	for id, d := range acc {
+		if d == 0 {
+			delete(acc, id)
+		}
+	}
+	return acc
+}
+
+// typoOrder returns the typo matches not already in found, closest first.
+func typoOrder(typos map[uint64]int, found []uint64) []uint64 {
+	seen := make(map[uint64]struct{}, len(found))
+	for _, id := range found {
+		seen[id] = struct{}{}
+	}
+	out := make([]uint64, 0, len(typos))
+	for id := range typos {
+		if _, ok := seen[id]; !ok {
+			out = append(out, id)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if typos[out[i]] != typos[out[j]] {
+			return typos[out[i]] < typos[out[j]]
+		}
+		return out[i] < out[j]
+	})
+	return out
+}
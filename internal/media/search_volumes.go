@@ -0,0 +1,282 @@
+package media
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/fuzzy"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// The media index is split into one set of segments per filesystem under
+// searchidx_media/vol/<key>/, where key hashes MediaFile.FSUUID, so a volume is rebuilt on its
+// own. Search reads all volumes together; media of unmounted volumes is kept as an offline
+// catalog (see VolumeOnline).
+
+// Segment names of a media volume; files are <segment>.dict.json, .postings.dat and .postings.idx.
+const (
+	segName      = "name"
+	segPath      = "path"
+	segNameNgram = "name_ngram"
+	segPathNgram = "path_ngram"
+	segPinyin    = "pinyin"
+)
+
+var mediaVolumeSegments = []string{segName, segPath, segNameNgram, segPathNgram}
+
+func mediaVolumesRoot() string { return filepath.Join(mediaIndexPath, "vol") }
+
+func mediaVolumeDir(fsUUID string) string {
+	return filepath.Join(mediaVolumesRoot(), fmt.Sprintf("%016x", fidHash(fsUUID)))
+}
+
+func mediaSegmentFiles(dir, seg string) (dictPath, datPath, idxPath string) {
+	return filepath.Join(dir, seg+".dict.json"), filepath.Join(dir, seg+".postings.dat"), filepath.Join(dir, seg+".postings.idx")
+}
+
+func mediaSegmentExists(dir, seg string) bool {
+	d, p, i := mediaSegmentFiles(dir, seg)
+	for _, f := range []string{d, p, i} {
+		if _, err := os.Stat(f); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// VolumeIDForPath returns the filesystem id media under path is stored with (MediaFile.FSUUID).
+// It is also the volume id of the search index segments.
+func VolumeIDForPath(path string) string {
+	if id, err := filesystemIDForPath(path); err == nil && id != "" {
+		return id
+	}
+	return string(filepath.Separator)
+}
+
+var (
+	volumesMu sync.RWMutex
+	// activeVolumes holds the FSUUIDs of mounted volumes; nil until SetActiveVolumes is first
+	// called, meaning every volume is online.
+	activeVolumes map[string]struct{}
+)
+
+// SetActiveVolumes sets the mounted volumes (by FSUUID). Media of other volumes stays in Pebble,
+// the index and the thumbnail cache, and is reported offline.
+func SetActiveVolumes(fsUUIDs []string) {
+	m := make(map[string]struct{}, len(fsUUIDs))
+	for _, id := range fsUUIDs {
+		m[id] = struct{}{}
+	}
+	volumesMu.Lock()
+	activeVolumes = m
+	volumesMu.Unlock()
+}
+
+// VolumeOnline reports whether the volume fsUUID is mounted.
+func VolumeOnline(fsUUID string) bool {
+	volumesMu.RLock()
+	defer volumesMu.RUnlock()
+	if activeVolumes == nil {
+		return true
+	}
+	_, ok := activeVolumes[fsUUID]
+	return ok
+}
+
+// mediaVolumeDirs lists the segment directories of all volumes, mounted or not.
+func mediaVolumeDirs() []string {
+	ents, _ := os.ReadDir(mediaVolumesRoot())
+	var out []string
+	for _, e := range ents {
+		// Staging directories of a build in progress contain a dot.
+		if e.IsDir() && !strings.Contains(e.Name(), ".") {
+			out = append(out, filepath.Join(mediaVolumesRoot(), e.Name()))
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// MediaVolumeIndexExists reports whether the volume has all of its media segments.
+func MediaVolumeIndexExists(fsUUID string) bool {
+	dir := mediaVolumeDir(fsUUID)
+	for _, seg := range mediaVolumeSegments {
+		if !mediaSegmentExists(dir, seg) {
+			return false
+		}
+	}
+	return pinyinSegmentOK(dir)
+}
+
+// mediaVolumeIndex reads one segment across all volumes. Doc ids hash the media UUID, so
+// the postings of a term are the union of its postings in every volume.
+type mediaVolumeIndex struct {
+	parts []*mediaMmap
+}
+
+// openMediaVolumeIndex opens seg in every volume that has it; it fails when none does.
+func openMediaVolumeIndex(seg string) (*mediaVolumeIndex, error) {
+	v := &mediaVolumeIndex{}
+	for _, dir := range mediaVolumeDirs() {
+		m, err := openMediaMmap(mediaSegmentFiles(dir, seg))
+		if err != nil {
+			continue
+		}
+		v.parts = append(v.parts, m)
+	}
+	if len(v.parts) == 0 {
+		return nil, os.ErrNotExist
+	}
+	return v, nil
+}
+
+func (v *mediaVolumeIndex) close() {
+	if v == nil {
+		return
+	}
+	for _, m := range v.parts {
+		m.close()
+	}
+}
+
+// termCapped returns the documents containing t, reading at most capN ids per volume
+// (0 reads all).
+func (v *mediaVolumeIndex) termCapped(t string, capN int) []uint64 {
+	var out []uint64
+	for _, m := range v.parts {
+		ids, _ := m.postingCapped(m.dict[t], capN)
+		out = unionSorted(out, ids)
+	}
+	return out
+}
+
+func (v *mediaVolumeIndex) term(t string) []uint64 { return v.termCapped(t, 0) }
+
+// BuildMediaIndex rebuilds the media segments of every volume found in Pebble and removes the
+// segments of volumes that no longer have media.
+func BuildMediaIndex() error {
+	ids := map[string]struct{}{}
+	err := db.GetDefault().Iterate([]byte("media:uuid:"), func(_ []byte, value []byte) error {
+		var m MediaFile
+		if err := json.Unmarshal(value, &m); err == nil {
+			ids[m.FSUUID] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	keep := map[string]struct{}{}
+	for id := range ids {
+		if err := BuildMediaVolumeIndex(id); err != nil {
+			return err
+		}
+		keep[filepath.Base(mediaVolumeDir(id))] = struct{}{}
+	}
+	ents, _ := os.ReadDir(mediaVolumesRoot())
+	for _, e := range ents {
+		if _, ok := keep[e.Name()]; !ok {
+			fuzzy.Forget(filepath.Join(mediaVolumesRoot(), e.Name()))
+			_ = os.RemoveAll(filepath.Join(mediaVolumesRoot(), e.Name()))
+		}
+	}
+	removeLegacyMediaSegments()
+	return nil
+}
+
+// buildMu serializes volume builds, which share staging directory names.
+var buildMu sync.Mutex
+
+// BuildMediaVolumeIndex rebuilds the media segments of one volume from its Pebble records. The
+// segments are written to a staging directory and swapped in when complete.
+func BuildMediaVolumeIndex(fsUUID string) error {
+	buildMu.Lock()
+	defer buildMu.Unlock()
+	final := mediaVolumeDir(fsUUID)
+	stage := final + ".new"
+	_ = os.RemoveAll(stage)
+	if err := os.MkdirAll(stage, 0o755); err != nil {
+		return err
+	}
+	defer os.RemoveAll(stage)
+	names := make(map[string][]uint64, 1<<12)
+	paths := make(map[string][]uint64, 1<<12)
+	// Fuzzy ngram term maps
+	nameNgrams := make(map[string][]uint64, 1<<12)
+	pathNgrams := make(map[string][]uint64, 1<<12)
+	pinyins := make(map[string][]uint64, 1<<10)
+	withPinyin := pinyinEnabled()
+	peb := db.GetDefault()
+	err := peb.Iterate([]byte("media:uuid:"), func(_ []byte, value []byte) error {
+		var m MediaFile
+		if err := json.Unmarshal(value, &m); err != nil || m.FSUUID != fsUUID {
+			return nil
+		}
+		docID := xxhash.Sum64String(m.UUID)
+		_ = peb.Set(keyByDocID(docID), []byte(m.UUID), nil)
+		for _, t := range tokenize(m.Name) {
+			names[t] = append(names[t], docID)
+		}
+		for _, t := range tokenize(filepath.ToSlash(m.Path)) {
+			paths[t] = append(paths[t], docID)
+		}
+		// ngram tokens
+		for _, ng := range buildQueryNgrams(m.Name) {
+			nameNgrams[ng] = append(nameNgrams[ng], docID)
+		}
+		for _, ng := range buildQueryNgrams(filepath.ToSlash(m.Path)) {
+			pathNgrams[ng] = append(pathNgrams[ng], docID)
+		}
+		if withPinyin {
+			addPinyinTokens(pinyins, &m, docID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	segs := map[string]map[string][]uint64{segName: names, segPath: paths, segNameNgram: nameNgrams, segPathNgram: pathNgrams}
+	if withPinyin {
+		segs[segPinyin] = pinyins
+	}
+	for seg, terms := range segs {
+		dictPath, datPath, idxPath := mediaSegmentFiles(stage, seg)
+		if err := buildIndexFiles(terms, dictPath, datPath, idxPath); err != nil {
+			return err
+		}
+	}
+	vb, _ := json.Marshal(map[string]string{"fsUUID": fsUUID})
+	if err := os.WriteFile(filepath.Join(stage, "volume.json"), vb, 0o644); err != nil {
+		return err
+	}
+	// Swap the new segments in; open readers keep their mappings of the old files.
+	old := final + ".old"
+	_ = os.RemoveAll(old)
+	if _, err := os.Stat(final); err == nil {
+		if err := os.Rename(final, old); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(stage, final); err != nil {
+		return err
+	}
+	return os.RemoveAll(old)
+}
+
+// removeLegacyMediaSegments deletes the single global media index written before per-volume
+// segments.
+func removeLegacyMediaSegments() {
+	for _, seg := range append(mediaVolumeSegments, segPinyin) {
+		d, p, i := mediaSegmentFiles(mediaIndexPath, seg)
+		_ = os.Remove(d)
+		_ = os.Remove(p)
+		_ = os.Remove(i)
+	}
+}
@@ -3,7 +3,6 @@ package media
 import (
 	"bytes"
 	"encoding/json"
-	"os"
 	"path/filepath"
 
 	"ismartcoding/plainnas/internal/db"
@@ -63,6 +62,36 @@ func ResetAllMediaData() error {
 		return nil
 	})
 
+	// Remove all media:hash: entries
+	_ = peb.Iterate([]byte("media:hash:"), func(key []byte, value []byte) error {
+		batch = append(batch, append([]byte{}, key...))
+		if len(batch) >= 1000 {
+			_ = peb.BatchDelete(batch)
+			batch = batch[:0]
+		}
+		return nil
+	})
+
+	// Remove all media:fp: entries
+	_ = peb.Iterate([]byte("media:fp:"), func(key []byte, value []byte) error {
+		batch = append(batch, append([]byte{}, key...))
+		if len(batch) >= 1000 {
+			_ = peb.BatchDelete(batch)
+			batch = batch[:0]
+		}
+		return nil
+	})
+
+	// Remove all media:dirmtime: entries, so the next scans look at every directory
+	_ = peb.Iterate([]byte("media:dirmtime:"), func(key []byte, value []byte) error {
+		batch = append(batch, append([]byte{}, key...))
+		if len(batch) >= 1000 {
+			_ = peb.BatchDelete(batch)
+			batch = batch[:0]
+		}
+		return nil
+	})
+
 	// Remove all media:fid: entries
 	_ = peb.Iterate([]byte("media:fid:"), func(key []byte, value []byte) error {
 		batch = append(batch, append([]byte{}, key...))
@@ -83,15 +112,9 @@ func ResetAllMediaData() error {
 	return nil
 }
 
-// MediaIndexExists reports whether the media bleve index already exists on disk
+// MediaIndexExists reports whether any volume has media index segments on disk
 func MediaIndexExists() bool {
-	_, e1 := os.Stat(mediaNameDat())
-	_, e2 := os.Stat(mediaNameIdx())
-	_, e3 := os.Stat(mediaNameDict())
-	_, e4 := os.Stat(mediaPathDat())
-	_, e5 := os.Stat(mediaPathIdx())
-	_, e6 := os.Stat(mediaPathDict())
-	return e1 == nil && e2 == nil && e3 == nil && e4 == nil && e5 == nil && e6 == nil
+	return len(mediaVolumeDirs()) > 0
 }
 
 func UpsertMedia(m *MediaFile) error {
@@ -115,17 +138,32 @@ func UpsertMedia(m *MediaFile) error {
 	if m.Type == "" {
 		m.Type = inferType(m.Name)
 	}
+	peb := db.GetDefault()
+	old, _ := peb.Get(keyByUUID(m.UUID))
+	var prev MediaFile
+	if old != nil {
+		_ = json.Unmarshal(old, &prev)
+		// Scans build records from stat data only; keep a content hash that is still valid.
+		if m.SHA256 == "" && prev.SHA256 != "" && prev.HashRefMod == m.ModifiedAt && prev.HashRefSize == m.Size {
+			m.SHA256, m.HashRefMod, m.HashRefSize = prev.SHA256, prev.HashRefMod, prev.HashRefSize
+		}
+		if m.Fingerprint == "" && prev.Fingerprint != "" && prev.FingerprintRefMod == m.ModifiedAt && prev.FingerprintRefSize == m.Size {
+			m.Fingerprint, m.FingerprintRefMod, m.FingerprintRefSize = prev.Fingerprint, prev.FingerprintRefMod, prev.FingerprintRefSize
+		}
+		if m.TagsRefMod == 0 && prev.TagsRefMod != 0 && prev.TagsRefMod == m.ModifiedAt && prev.TagsRefSize == m.Size {
+			m.setAudioTags(prev.AudioTags())
+			m.TagsRefMod, m.TagsRefSize = prev.TagsRefMod, prev.TagsRefSize
+		}
+	}
+	ensureFingerprint(m, old == nil)
 	b, err := json.Marshal(m)
 	if err != nil {
 		return err
 	}
-	peb := db.GetDefault()
 	// Fast path: skip writes if content unchanged
-	if old, _ := peb.Get(keyByUUID(m.UUID)); old != nil && bytes.Equal(old, b) {
+	if old != nil && bytes.Equal(old, b) {
 		return nil
 	} else if old != nil {
-		var prev MediaFile
-		_ = json.Unmarshal(old, &prev)
 		if prev.Path != "" && prev.Path != m.Path {
 			_ = peb.Delete(keyByPath(prev.Path))
 		}
@@ -138,6 +176,12 @@ func UpsertMedia(m *MediaFile) error {
 			_ = peb.Delete(keyTypeTrashNameDesc(prev.Type, prev.IsTrash, prev.Name, prev.UUID))
 			_ = peb.Delete(keyTypeTrashSize(prev.Type, prev.IsTrash, prev.Size, prev.UUID))
 			_ = peb.Delete(keyTypeTrashSizeDesc(prev.Type, prev.IsTrash, prev.Size, prev.UUID))
+			if prev.SHA256 != "" {
+				_ = peb.Delete(keyByHash(prev.SHA256, prev.UUID))
+			}
+			if prev.Fingerprint != "" {
+				_ = peb.Delete(keyByFingerprint(prev.Fingerprint, prev.UUID))
+			}
 		}
 	}
 
@@ -156,6 +200,12 @@ func UpsertMedia(m *MediaFile) error {
 	_ = peb.Set(keyTypeTrashNameDesc(m.Type, m.IsTrash, m.Name, m.UUID), []byte{}, &pebble.WriteOptions{Sync: false})
 	_ = peb.Set(keyTypeTrashSize(m.Type, m.IsTrash, m.Size, m.UUID), []byte{}, &pebble.WriteOptions{Sync: false})
 	_ = peb.Set(keyTypeTrashSizeDesc(m.Type, m.IsTrash, m.Size, m.UUID), []byte{}, &pebble.WriteOptions{Sync: false})
+	if m.hashValid() {
+		_ = peb.Set(keyByHash(m.SHA256, m.UUID), []byte{}, &pebble.WriteOptions{Sync: false})
+	}
+	if m.fingerprintValid() {
+		_ = peb.Set(keyByFingerprint(m.Fingerprint, m.UUID), []byte{}, &pebble.WriteOptions{Sync: false})
+	}
 
 	// Index updates are handled by background rebuild; no runtime writes.
 	return nil
@@ -182,6 +232,12 @@ func DeleteMedia(uuid string) error {
 		_ = peb.Delete(keyTypeTrashNameDesc(m.Type, m.IsTrash, m.Name, m.UUID))
 		_ = peb.Delete(keyTypeTrashSize(m.Type, m.IsTrash, m.Size, m.UUID))
 		_ = peb.Delete(keyTypeTrashSizeDesc(m.Type, m.IsTrash, m.Size, m.UUID))
+		if m.SHA256 != "" {
+			_ = peb.Delete(keyByHash(m.SHA256, m.UUID))
+		}
+		if m.Fingerprint != "" {
+			_ = peb.Delete(keyByFingerprint(m.Fingerprint, m.UUID))
+		}
 	}
 	if m.Path != "" {
 		_ = peb.Delete(keyByPath(m.Path))
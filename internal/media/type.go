@@ -1,7 +1,6 @@
 package media
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -123,6 +122,28 @@ func TypeIndexPrefix(mediaType string, isTrash bool, indexKind string) []byte {
 	}
 }
 
+// TypeIndexSortKey returns the part of a type index key after TypeIndexPrefix for an item:
+// "<sortKey>:<uuid>". Comparing these strings orders items exactly like the index does, which
+// lets in-memory result lists share cursors with index scans.
+func TypeIndexSortKey(indexKind string, mod, size int64, name, uuid string) string {
+	switch indexKind {
+	case "mod":
+		return modKey(mod) + ":" + uuid
+	case "moddesc":
+		return modDescKey(mod) + ":" + uuid
+	case "name":
+		return normName(name) + ":" + uuid
+	case "namedesc":
+		return nameDescKey(name) + ":" + uuid
+	case "size":
+		return sizeKey(size) + ":" + uuid
+	case "sizedesc":
+		return sizeDescKey(size) + ":" + uuid
+	default:
+		return uuid
+	}
+}
+
 // UUIDFromTypeIndexKey extracts the uuid suffix from a type index key.
 func UUIDFromTypeIndexKey(key []byte) string {
 	s := string(key)
@@ -133,58 +154,6 @@ func UUIDFromTypeIndexKey(key []byte) string {
 	return s[idx+1:]
 }
 
-// EnsureTypeIndexes ensures the type/trash secondary indexes exist; if missing, it rebuilds them.
-// This project is currently in development, so we intentionally do not persist an index version key.
-func EnsureTypeIndexes() error {
-	peb := db.GetDefault()
-
-	// Minimal but safe: ensure every index kind exists somewhere. This avoids
-	// the "some indexes exist so we skip rebuild" bug when adding new kinds.
-	need := map[string]bool{
-		"uuid":     true,
-		"mod":      true,
-		"moddesc":  true,
-		"name":     true,
-		"namedesc": true,
-		"size":     true,
-		"sizedesc": true,
-	}
-	remaining := len(need)
-	trashMarker := []byte(":trash:")
-	iterErr := peb.Iterate([]byte("media:type:"), func(k []byte, _ []byte) error {
-		p := bytes.Index(k, trashMarker)
-		if p < 0 {
-			return nil
-		}
-		p += len(trashMarker)
-		// Expect ":trash:<digit>:<kind>:..."
-		if p+2 >= len(k) {
-			return nil
-		}
-		p += 2 // skip "<digit>:"
-		q := bytes.IndexByte(k[p:], ':')
-		if q <= 0 {
-			return nil
-		}
-		kind := string(k[p : p+q])
-		if need[kind] {
-			need[kind] = false
-			remaining--
-			if remaining == 0 {
-				return db.ErrIterateStop
-			}
-		}
-		return nil
-	})
-	if iterErr != nil && iterErr != db.ErrIterateStop {
-		return iterErr
-	}
-	if remaining == 0 {
-		return nil
-	}
-	return RebuildTypeIndexes()
-}
-
 // RebuildTypeIndexes rebuilds the type/trash secondary indexes from the primary media:uuid: records.
 func RebuildTypeIndexes() error {
 	peb := db.GetDefault()
@@ -28,6 +28,29 @@ type MediaFile struct {
 	Title        string `json:"title"`
 	TitleRefMod  int64  `json:"title_ref_mod"`
 	TitleRefSize int64  `json:"title_ref_size"`
+	// Album, AlbumArtist, Track, Disc, Year, Genre and Composer are best-effort extracted from
+	// audio tags (see EnsureAudioTags). They are considered valid, empty or not, when
+	// TagsRefMod/TagsRefSize match current file metadata.
+	Album       string `json:"album,omitempty"`
+	AlbumArtist string `json:"album_artist,omitempty"`
+	Track       int    `json:"track,omitempty"`
+	Disc        int    `json:"disc,omitempty"`
+	Year        int    `json:"year,omitempty"`
+	Genre       string `json:"genre,omitempty"`
+	Composer    string `json:"composer,omitempty"`
+	TagsRefMod  int64  `json:"tags_ref_mod,omitempty"`
+	TagsRefSize int64  `json:"tags_ref_size,omitempty"`
+	// SHA256 is the hex content hash used to deduplicate uploads. It is considered valid when
+	// HashRefMod/HashRefSize match current file metadata.
+	SHA256      string `json:"sha256,omitempty"`
+	HashRefMod  int64  `json:"hash_ref_mod,omitempty"`
+	HashRefSize int64  `json:"hash_ref_size,omitempty"`
+	// Fingerprint is a sampled content fingerprint (size plus xxhash of the head, middle and tail
+	// blocks) that recognizes a file copied or moved to another filesystem. It is considered valid
+	// when FingerprintRefMod/FingerprintRefSize match current file metadata.
+	Fingerprint        string `json:"fingerprint,omitempty"`
+	FingerprintRefMod  int64  `json:"fingerprint_ref_mod,omitempty"`
+	FingerprintRefSize int64  `json:"fingerprint_ref_size,omitempty"`
 	// Path is the current physical file path. When trashed, it points to the trash location.
 	Path string `json:"path"`
 	// OriginalPath preserves the original file path before moving to trash.
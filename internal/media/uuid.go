@@ -145,6 +145,27 @@ func rebuildMountCacheLocked() {
 	mountCache.lastBuild = time.Now()
 }
 
+// MountedVolumeIDs re-reads /proc/mounts and returns the filesystem ids of all mounts, as used
+// for MediaFile.FSUUID. Call it after volumes were mounted or unmounted.
+func MountedVolumeIDs() []string {
+	mountCache.mu.Lock()
+	defer mountCache.mu.Unlock()
+	rebuildMountCacheLocked()
+	seen := make(map[string]struct{}, len(mountCache.fsIDByMount))
+	ids := make([]string, 0, len(mountCache.fsIDByMount))
+	for _, id := range mountCache.fsIDByMount {
+		if id == "" {
+			id = string(filepath.Separator)
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
 func filesystemIDForPath(path string) (string, error) {
 	path = filepath.Clean(path)
 	if path == "" || path == "." {
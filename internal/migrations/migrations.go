@@ -0,0 +1,97 @@
+// Package migrations brings the Pebble schema of an existing database up to date.
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/pkg/log"
+
+	"github.com/cockroachdb/pebble"
+)
+
+const versionKey = "schema:version"
+
+// ErrNewerSchema is returned for a database migrated by a newer binary.
+var ErrNewerSchema = errors.New("database schema is newer than this binary")
+
+type migration struct {
+	version int
+	name    string
+	// up must be idempotent: a migration interrupted by a crash runs again from the start.
+	up func() error
+}
+
+// registry lists the migrations in version order. New schema changes are appended with the next
+// version; released migrations are never renumbered or removed.
+var registry = []migration{
+	{1, "tag relation by-key index", db.BackfillTagRelationKeyIndex},
+	{2, "media type indexes", media.RebuildTypeIndexes},
+	{3, "samba multi-share settings", db.MigrateLegacySambaSettings},
+}
+
+// Latest returns the schema version this binary writes.
+func Latest() int { return registry[len(registry)-1].version }
+
+// Version returns the schema version of the database; 0 before the first migration.
+func Version() (int, error) {
+	b, err := db.GetDefault().Get([]byte(versionKey))
+	if err != nil || b == nil {
+		return 0, err
+	}
+	v, err := strconv.Atoi(string(b))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", versionKey, b)
+	}
+	return v, nil
+}
+
+// Check fails with ErrNewerSchema when the database was migrated by a newer binary.
+func Check() error {
+	v, err := Version()
+	if err != nil {
+		return err
+	}
+	if v > Latest() {
+		return fmt.Errorf("%w: database is at v%d, this binary supports up to v%d", ErrNewerSchema, v, Latest())
+	}
+	return nil
+}
+
+// Run applies the pending migrations in order. The version is stored after each one, so a run
+// interrupted by a crash resumes with the migration that did not finish.
+func Run() error { return run(registry) }
+
+func run(list []migration) error {
+	cur, err := Version()
+	if err != nil {
+		return err
+	}
+	latest := list[len(list)-1].version
+	if cur > latest {
+		return fmt.Errorf("%w: database is at v%d, this binary supports up to v%d", ErrNewerSchema, cur, latest)
+	}
+	if cur == latest {
+		return nil
+	}
+	log.Infof("Schema migration: v%d -> v%d", cur, latest)
+	for _, m := range list {
+		if m.version <= cur {
+			continue
+		}
+		start := time.Now()
+		log.Infof("Schema migration v%d: %s", m.version, m.name)
+		if err := m.up(); err != nil {
+			return fmt.Errorf("schema migration v%d (%s): %w", m.version, m.name, err)
+		}
+		if err := db.GetDefault().Set([]byte(versionKey), []byte(strconv.Itoa(m.version)), &pebble.WriteOptions{Sync: true}); err != nil {
+			return err
+		}
+		log.Infof("Schema migration v%d done in %s", m.version, time.Since(start).Round(time.Millisecond))
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package migrations
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/db"
+)
+
+func TestMain(m *testing.M) {
+	tmp, err := os.MkdirTemp("", "plainnas-migrations-test-*")
+	if err != nil {
+		panic(err)
+	}
+	consts.DATA_DIR = tmp
+	code := m.Run()
+	_ = os.RemoveAll(tmp)
+	os.Exit(code)
+}
+
+func setVersion(t *testing.T, v int) {
+	t.Helper()
+	if err := db.GetDefault().Set([]byte(versionKey), []byte(strconv.Itoa(v)), nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunResumesAfterFailure(t *testing.T) {
+	setVersion(t, 0)
+	var ran []int
+	fail := true
+	list := []migration{
+		{1, "one", func() error { ran = append(ran, 1); return nil }},
+		{2, "two", func() error {
+			ran = append(ran, 2)
+			if fail {
+				return errors.New("crash")
+			}
+			return nil
+		}},
+		{3, "three", func() error { ran = append(ran, 3); return nil }},
+	}
+
+	if err := run(list); err == nil {
+		t.Fatalf("run succeeded despite failing migration")
+	}
+	if v, _ := Version(); v != 1 {
+		t.Fatalf("version after failure = %d, want 1", v)
+	}
+
+	fail = false
+	if err := run(list); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if v, _ := Version(); v != 3 {
+		t.Fatalf("version after resume = %d, want 3", v)
+	}
+	want := []int{1, 2, 2, 3}
+	if len(ran) != len(want) {
+		t.Fatalf("ran %v, want %v", ran, want)
+	}
+	for i := range want {
+		if ran[i] != want[i] {
+			t.Fatalf("ran %v, want %v", ran, want)
+		}
+	}
+
+	// Up to date: nothing runs.
+	ran = nil
+	if err := run(list); err != nil || len(ran) != 0 {
+		t.Fatalf("rerun ran %v, err %v", ran, err)
+	}
+}
+
+func TestRunRefusesNewerSchema(t *testing.T) {
+	setVersion(t, Latest()+1)
+	defer setVersion(t, 0)
+	if err := Run(); !errors.Is(err, ErrNewerSchema) {
+		t.Fatalf("Run err = %v, want ErrNewerSchema", err)
+	}
+	if err := Check(); !errors.Is(err, ErrNewerSchema) {
+		t.Fatalf("Check err = %v, want ErrNewerSchema", err)
+	}
+}
@@ -0,0 +1,213 @@
+// Package fuzzy finds dictionary terms within a small edit distance of a query term.
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Distance returns the Damerau-Levenshtein distance between a and b: the number of rune
+// insertions, deletions, substitutions and transpositions of adjacent runes needed to turn one
+// into the other. Unlike the restricted (optimal string alignment) variant it is a metric, which
+// the BK-tree relies on.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+	if la == 0 {
+		return lb
+	}
+	if lb == 0 {
+		return la
+	}
+	inf := la + lb
+	w := lb + 2
+	d := make([]int, (la+2)*w)
+	at := func(i, j int) *int { return &d[i*w+j] }
+	*at(0, 0) = inf
+	for i := 0; i <= la; i++ {
+		*at(i+1, 0) = inf
+		*at(i+1, 1) = i
+	}
+	for j := 0; j <= lb; j++ {
+		*at(0, j+1) = inf
+		*at(1, j+1) = j
+	}
+	// last row where each rune of a was seen
+	lastRow := make(map[rune]int, la)
+	for i := 1; i <= la; i++ {
+		lastCol := 0
+		for j := 1; j <= lb; j++ {
+			i1 := lastRow[rb[j-1]]
+			j1 := lastCol
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			*at(i+1, j+1) = min(
+				*at(i, j)+cost,
+				*at(i+1, j)+1,
+				*at(i, j+1)+1,
+				*at(i1, j1)+(i-i1-1)+1+(j-j1-1),
+			)
+		}
+		lastRow[ra[i-1]] = i
+	}
+	return *at(la+1, lb+1)
+}
+
+// MaxEdits returns how many edits a query term tolerates: none for terms shorter than three
+// runes or made of digits only (years, episode numbers), one up to five runes, two beyond.
+func MaxEdits(term string) int {
+	n := 0
+	letters := false
+	for _, r := range term {
+		n++
+		if !unicode.IsDigit(r) {
+			letters = true
+		}
+	}
+	switch {
+	case n < 3 || !letters:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Match is a dictionary term found by BKTree.Search.
+type Match struct {
+	Term     string
+	Distance int
+}
+
+type bkEdge struct {
+	dist int32
+	node int32
+}
+
+type bkNode struct {
+	term string
+	kids []bkEdge
+}
+
+// BKTree indexes terms by edit distance so that all terms within k edits of a query can be found
+// without comparing against the whole dictionary.
+type BKTree struct {
+	nodes []bkNode
+}
+
+// NewBKTree builds a tree over terms. Duplicates are ignored.
+func NewBKTree(terms []string) *BKTree {
+	t := &BKTree{nodes: make([]bkNode, 0, len(terms))}
+	for _, term := range terms {
+		t.add(term)
+	}
+	return t
+}
+
+// Len returns the number of terms in the tree.
+func (t *BKTree) Len() int { return len(t.nodes) }
+
+func (t *BKTree) add(term string) {
+	if len(t.nodes) == 0 {
+		t.nodes = append(t.nodes, bkNode{term: term})
+		return
+	}
+	cur := 0
+	for {
+		d := int32(Distance(term, t.nodes[cur].term))
+		if d == 0 {
+			return
+		}
+		next := -1
+		for _, e := range t.nodes[cur].kids {
+			if e.dist == d {
+				next = int(e.node)
+				break
+			}
+		}
+		if next < 0 {
+			t.nodes = append(t.nodes, bkNode{term: term})
+			t.nodes[cur].kids = append(t.nodes[cur].kids, bkEdge{dist: d, node: int32(len(t.nodes) - 1)})
+			return
+		}
+		cur = next
+	}
+}
+
+// Search returns the terms within maxDist edits of term, closest first and then alphabetically.
+func (t *BKTree) Search(term string, maxDist int) []Match {
+	if t == nil || len(t.nodes) == 0 || maxDist < 0 {
+		return nil
+	}
+	var out []Match
+	stack := []int32{0}
+	for len(stack) > 0 {
+		n := &t.nodes[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		d := Distance(term, n.term)
+		if d <= maxDist {
+			out = append(out, Match{Term: n.term, Distance: d})
+		}
+		// Triangle inequality: only subtrees at distance d±maxDist from n can hold matches.
+		for _, e := range n.kids {
+			if int(e.dist) >= d-maxDist && int(e.dist) <= d+maxDist {
+				stack = append(stack, e.node)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Distance != out[j].Distance {
+			return out[i].Distance < out[j].Distance
+		}
+		return out[i].Term < out[j].Term
+	})
+	return out
+}
+
+// cacheSlot holds the tree of one slot; once lets concurrent callers wait for a single build
+// without holding cacheMu.
+type cacheSlot struct {
+	version string
+	once    sync.Once
+	tree    *BKTree
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = map[string]*cacheSlot{}
+)
+
+// Cached returns the tree stored under slot if it was built for version, otherwise it builds a
+// new one with build and keeps it in place of the old one. Index segments use their dictionary
+// path as slot and its size and mtime as version, so a tree is built once per index rebuild.
+// Builds of different slots run concurrently.
+func Cached(slot, version string, build func() *BKTree) *BKTree {
+	cacheMu.Lock()
+	e, ok := cache[slot]
+	if !ok || e.version != version {
+		// A new version replaces the stale tree; callers still building it keep their own.
+		e = &cacheSlot{version: version}
+		cache[slot] = e
+	}
+	cacheMu.Unlock()
+	e.once.Do(func() { e.tree = build() })
+	return e.tree
+}
+
+// Forget drops the trees of all slots under dir, e.g. when the segments of a volume are deleted.
+func Forget(dir string) {
+	dir = strings.TrimSuffix(dir, "/") + "/"
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	for slot := range cache {
+		if strings.HasPrefix(slot, dir) {
+			delete(cache, slot)
+		}
+	}
+}
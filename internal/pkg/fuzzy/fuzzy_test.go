@@ -0,0 +1,78 @@
+package fuzzy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"vacation", "vacation", 0},
+		{"vacaton", "vacation", 1},
+		{"vacatoin", "vacation", 1},
+		{"ca", "abc", 2},
+		{"kitten", "sitting", 3},
+		{"café", "cafe", 1},
+	}
+	for _, c := range cases {
+		if got := Distance(c.a, c.b); got != c.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestMaxEdits(t *testing.T) {
+	cases := map[string]int{"ab": 0, "2023": 0, "cat": 1, "photo": 1, "holiday": 2}
+	for term, want := range cases {
+		if got := MaxEdits(term); got != want {
+			t.Errorf("MaxEdits(%q) = %d, want %d", term, got, want)
+		}
+	}
+}
+
+func TestBKTreeSearch(t *testing.T) {
+	terms := []string{"vacation", "vacancy", "location", "vocation", "vacations", "beach", "vacation"}
+	tree := NewBKTree(terms)
+	if tree.Len() != 6 {
+		t.Fatalf("Len = %d, want 6", tree.Len())
+	}
+	got := tree.Search("vacaton", 2)
+	want := []Match{{"vacation", 1}, {"vacations", 2}, {"vocation", 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Search = %v, want %v", got, want)
+	}
+	// The tree must find exactly what a linear scan finds.
+	for _, q := range []string{"beech", "locaton", "vacancies", "x"} {
+		var scan []string
+		for _, term := range terms[:6] {
+			if Distance(q, term) <= 2 {
+				scan = append(scan, term)
+			}
+		}
+		if n := len(tree.Search(q, 2)); n != len(scan) {
+			t.Errorf("Search(%q) found %d terms, linear scan %v", q, n, scan)
+		}
+	}
+}
+
+func TestCached(t *testing.T) {
+	builds := 0
+	build := func() *BKTree { builds++; return NewBKTree([]string{"a"}) }
+	t1 := Cached("/idx/vol/a/dict", "v1", build)
+	if t2 := Cached("/idx/vol/a/dict", "v1", build); t2 != t1 || builds != 1 {
+		t.Fatalf("same version rebuilt: builds = %d", builds)
+	}
+	if Cached("/idx/vol/a/dict", "v2", build) == t1 || builds != 2 {
+		t.Fatalf("new version not rebuilt: builds = %d", builds)
+	}
+	Forget("/idx/vol/a")
+	Cached("/idx/vol/a/dict", "v2", build)
+	if builds != 3 {
+		t.Fatalf("forgotten slot not rebuilt: builds = %d", builds)
+	}
+}
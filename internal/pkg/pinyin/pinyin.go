@@ -0,0 +1,83 @@
+// Package pinyin transliterates Chinese characters to toneless Hanyu Pinyin for search.
+package pinyin
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed table.txt
+var table string
+
+var (
+	loadOnce  sync.Once
+	syllables map[rune]string
+)
+
+func load() {
+	syllables = make(map[rune]string, 27000)
+	for _, line := range strings.Split(table, "\n") {
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		py, chars, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		for _, r := range chars {
+			syllables[r] = py
+		}
+	}
+}
+
+// Syllable returns the toneless pinyin of a Chinese character ("周" → "zhou"). Characters with
+// several readings get the most common one.
+func Syllable(r rune) (string, bool) {
+	loadOnce.Do(load)
+	py, ok := syllables[r]
+	return py, ok
+}
+
+// Tokens returns the search tokens for the Chinese text in s. Every run of characters with a
+// known reading contributes its syllables, the syllables joined, and for runs of two or more
+// characters their initial letters: "周杰伦.mp3" → zhou, jie, lun, zhoujielun, zjl.
+// Tokens are unique and in order of first occurrence; s without Chinese yields none.
+func Tokens(s string) []string {
+	var out []string
+	seen := make(map[string]struct{})
+	add := func(t string) {
+		if _, ok := seen[t]; ok {
+			return
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+	var run []string
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		for _, py := range run {
+			add(py)
+		}
+		if len(run) > 1 {
+			add(strings.Join(run, ""))
+			initials := make([]byte, len(run))
+			for i, py := range run {
+				initials[i] = py[0]
+			}
+			add(string(initials))
+		}
+		run = run[:0]
+	}
+	for _, r := range s {
+		if py, ok := Syllable(r); ok {
+			run = append(run, py)
+			continue
+		}
+		flush()
+	}
+	flush()
+	return out
+}
@@ -0,0 +1,28 @@
+package pinyin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSyllable(t *testing.T) {
+	for r, want := range map[rune]string{'周': "zhou", '杰': "jie", '伦': "lun", '稻': "dao", '㐀': "qiu"} {
+		if got, ok := Syllable(r); !ok || got != want {
+			t.Errorf("Syllable(%q) = %q, %v; want %q", r, got, ok, want)
+		}
+	}
+	if _, ok := Syllable('a'); ok {
+		t.Errorf("Syllable('a') should not be found")
+	}
+}
+
+func TestTokens(t *testing.T) {
+	got := Tokens("周杰伦 - 稻香.mp3")
+	want := []string{"zhou", "jie", "lun", "zhoujielun", "zjl", "dao", "xiang", "daoxiang", "dx"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Tokens = %q, want %q", got, want)
+	}
+	if got := Tokens("holiday.jpg"); len(got) != 0 {
+		t.Fatalf("expected no tokens, got %q", got)
+	}
+}
@@ -0,0 +1,332 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/log"
+	"ismartcoding/plainnas/internal/textextract"
+)
+
+const (
+	// defaultContentMaxBytes caps the text kept per file (for indexing and snippets).
+	defaultContentMaxBytes = 256 << 10
+	// defaultContentMaxFileSize skips files too large to be worth extracting.
+	defaultContentMaxFileSize = 64 << 20
+	// defaultContentThrottle is the pause between two extracted files.
+	defaultContentThrottle = 50 * time.Millisecond
+	// defaultContentInterval is how often the background indexer looks for changed files.
+	defaultContentInterval = time.Hour
+)
+
+// ContentRecord is the extracted text of one file, keyed by FileID in Pebble.
+// MTime and Size record the file version the text was extracted from.
+type ContentRecord struct {
+	MTime int64  `json:"mtime"`
+	Size  uint64 `json:"size"`
+	Text  string `json:"text"`
+}
+
+func keyContent(id uint64) []byte { return []byte(fmt.Sprintf("ct:%d", id)) }
+
+// Content index segment (separate from name/path so it can be rebuilt on its own)
+func contentPostingsDat() string { return filepath.Join(indexDir(), "content.postings.dat") }
+func contentPostingsIdx() string { return filepath.Join(indexDir(), "content.postings.idx") }
+func contentDictJSON() string    { return filepath.Join(indexDir(), "content.dict.json") }
+func contentStatsJSON() string   { return filepath.Join(indexDir(), "content.stats.json") }
+
+// contentStats are the collection statistics BM25 needs.
+type contentStats struct {
+	Docs   int     `json:"docs"`
+	AvgLen float64 `json:"avgLen"`
+}
+
+func contentIndexExists() bool {
+	if !indexVersionOK(indexDir(), "content", contentIndexVersion) {
+		return false
+	}
+	_, e1 := os.Stat(contentPostingsDat())
+	_, e2 := os.Stat(contentPostingsIdx())
+	_, e3 := os.Stat(contentDictJSON())
+	_, e4 := os.Stat(contentStatsJSON())
+	return e1 == nil && e2 == nil && e3 == nil && e4 == nil
+}
+
+func loadContentStats() contentStats {
+	var st contentStats
+	if b, err := os.ReadFile(contentStatsJSON()); err == nil {
+		_ = json.Unmarshal(b, &st)
+	}
+	return st
+}
+
+// eachContentTerm calls fn for every term occurrence of text: the same tokens as names with
+// diacritics folded, so content search ignores accents, plus CJK bigrams, since CJK text has no
+// separators to split on.
+func eachContentTerm(text string, fn func(t string)) {
+	text = normalizeText(text)
+	for _, t := range segmentWords(text) {
+		fn(foldDiacritics(t))
+	}
+	runes := []rune(text)
+	for i := 0; i+1 < len(runes); i++ {
+		if isCJK(runes[i]) && isCJK(runes[i+1]) {
+			fn(string(runes[i : i+2]))
+		}
+	}
+}
+
+// contentTerms returns the unique terms of text in order of first occurrence.
+func contentTerms(text string) []string {
+	seen := make(map[string]struct{}, 64)
+	out := make([]string, 0, 64)
+	eachContentTerm(text, func(t string) {
+		if _, ok := seen[t]; ok {
+			return
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	})
+	return out
+}
+
+// contentTermCounts returns the frequency of each term and the document length in terms.
+func contentTermCounts(text string) (map[string]uint32, uint32) {
+	counts := make(map[string]uint32, 64)
+	var n uint32
+	eachContentTerm(text, func(t string) {
+		counts[t]++
+		n++
+	})
+	return counts, n
+}
+
+func contentMaxBytes() int {
+	if n := config.GetDefault().GetInt("search.content_max_bytes"); n > 0 {
+		return n
+	}
+	return defaultContentMaxBytes
+}
+
+func contentMaxFileSize() uint64 {
+	if n := config.GetDefault().GetInt("search.content_max_file_size_mb"); n > 0 {
+		return uint64(n) << 20
+	}
+	return defaultContentMaxFileSize
+}
+
+func contentThrottle() time.Duration {
+	if v := config.GetDefault().GetString("search.content_throttle_ms"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			return time.Duration(n) * time.Millisecond
+		}
+	}
+	return defaultContentThrottle
+}
+
+func contentInterval() time.Duration {
+	if n := config.GetDefault().GetInt("search.content_index_interval_minutes"); n > 0 {
+		return time.Duration(n) * time.Minute
+	}
+	return defaultContentInterval
+}
+
+func contentIndexEnabled() bool {
+	return config.GetDefault().GetString("search.content_index") != "false"
+}
+
+// contentCandidate reports whether the content indexer should look at m.
+func contentCandidate(m *FileMeta, maxSize uint64) bool {
+	if m.IsDir || m.Size == 0 || m.Size > maxSize {
+		return false
+	}
+	if m.Ext != "" {
+		return textextract.Supported(m.Ext)
+	}
+	return textextract.Supported(strings.ToLower(m.Name))
+}
+
+var contentMu sync.Mutex
+
+// UpdateContentIndex extracts text from new or changed files known to the file index, drops
+// records of files that are gone, and rebuilds the content segment when anything changed.
+// Files whose mtime and size match their record are not read again. Extraction pauses between
+// files so a large backlog does not saturate the disks. It returns ctx.Err() when cancelled;
+// work done so far is kept and picked up by the next run.
+func UpdateContentIndex(ctx context.Context) error {
+	contentMu.Lock()
+	defer contentMu.Unlock()
+
+	peb := db.GetDefault()
+	maxBytes := contentMaxBytes()
+	maxSize := contentMaxFileSize()
+	throttle := contentThrottle()
+
+	var pending []FileMeta
+	live := make(map[uint64]struct{}, 1<<12)
+	if err := peb.Iterate([]byte("f:"), func(key []byte, value []byte) error {
+		var m FileMeta
+		if err := json.Unmarshal(value, &m); err != nil {
+			return nil
+		}
+		if !contentCandidate(&m, maxSize) {
+			return nil
+		}
+		live[m.FileID] = struct{}{}
+		if !m.ContentIndexed {
+			pending = append(pending, m)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	changed := false
+	var stale [][]byte
+	if err := peb.Iterate([]byte("ct:"), func(key []byte, value []byte) error {
+		id, err := strconv.ParseUint(strings.TrimPrefix(string(key), "ct:"), 10, 64)
+		if err != nil {
+			return nil
+		}
+		if _, ok := live[id]; !ok {
+			stale = append(stale, append([]byte(nil), key...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(stale) > 0 {
+		if err := peb.BatchDelete(stale); err != nil {
+			return err
+		}
+		changed = true
+	}
+
+	indexed := 0
+	for i := range pending {
+		if err := ctx.Err(); err != nil {
+			if changed {
+				_ = buildContentSegment()
+			}
+			return err
+		}
+		m := &pending[i]
+		var rec ContentRecord
+		if b, _ := pebGet(keyContent(m.FileID)); b != nil && json.Unmarshal(b, &rec) == nil && rec.MTime == m.MTime && rec.Size == m.Size {
+			// Text is current, only the flag was lost (e.g. the file index was rebuilt).
+			markContentIndexed(m)
+			continue
+		}
+		text, err := textextract.Extract(filepath.FromSlash(m.Path), maxBytes)
+		if err != nil && !errors.Is(err, textextract.ErrUnsupported) {
+			if os.IsNotExist(err) {
+				continue
+			}
+			log.Debugf("[search] content extraction failed for %s: %v", m.Path, err)
+		}
+		// An empty record still stops the file from being retried until it changes.
+		rec = ContentRecord{MTime: m.MTime, Size: m.Size, Text: text}
+		b, _ := json.Marshal(rec)
+		if err := peb.Set(keyContent(m.FileID), b, nil); err != nil {
+			return err
+		}
+		markContentIndexed(m)
+		changed = true
+		indexed++
+		if throttle > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(throttle):
+			}
+		}
+	}
+	if indexed > 0 {
+		log.Infof("[search] extracted text from %d files", indexed)
+	}
+	if changed || !contentIndexExists() {
+		return buildContentSegment()
+	}
+	return nil
+}
+
+func markContentIndexed(m *FileMeta) {
+	m.ContentIndexed = true
+	b, _ := json.Marshal(m)
+	_ = db.GetDefault().Set(keyFileMeta(m.FileID), b, nil)
+}
+
+// buildContentSegment rewrites the content postings from all stored records.
+func buildContentSegment() error {
+	terms := make(payloadTermMap, 1<<16)
+	var st contentStats
+	var totalLen uint64
+	if err := db.GetDefault().Iterate([]byte("ct:"), func(key []byte, value []byte) error {
+		id, err := strconv.ParseUint(strings.TrimPrefix(string(key), "ct:"), 10, 64)
+		if err != nil {
+			return nil
+		}
+		var rec ContentRecord
+		if err := json.Unmarshal(value, &rec); err != nil {
+			return nil
+		}
+		counts, dl := contentTermCounts(rec.Text)
+		if dl == 0 {
+			return nil
+		}
+		for t, tf := range counts {
+			terms[t] = append(terms[t], docEntry{id: id, tf: tf, dl: dl})
+		}
+		st.Docs++
+		totalLen += uint64(dl)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return writeContentSegment(terms, st, totalLen)
+}
+
+func writeContentSegment(terms payloadTermMap, st contentStats, totalLen uint64) error {
+	_ = os.MkdirAll(indexDir(), 0o755)
+	if st.Docs > 0 {
+		st.AvgLen = float64(totalLen) / float64(st.Docs)
+	}
+	if err := buildPayloadIndexFiles(terms, contentDictJSON(), contentPostingsDat(), contentPostingsIdx()); err != nil {
+		return err
+	}
+	b, _ := json.Marshal(st)
+	if err := os.WriteFile(contentStatsJSON(), b, 0o644); err != nil {
+		return err
+	}
+	return writeIndexVersion(indexDir(), "content", contentIndexVersion)
+}
+
+// RunContentIndexer keeps the content index up to date: it runs one pass immediately and then
+// one per `search.content_index_interval_minutes`. Disable it with `search.content_index = false`.
+// It returns when ctx is done.
+func RunContentIndexer(ctx context.Context) {
+	if !contentIndexEnabled() {
+		return
+	}
+	ticker := time.NewTicker(contentInterval())
+	defer ticker.Stop()
+	for {
+		if err := UpdateContentIndex(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Errorf("[search] content index update failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
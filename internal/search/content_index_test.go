@@ -0,0 +1,143 @@
+package search
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func (p *memPebble) setContent(id uint64, rec ContentRecord) {
+	b, _ := json.Marshal(rec)
+	p.m[string(keyContent(id))] = b
+}
+
+func buildTestContentIndex(t *testing.T, texts map[uint64]string) {
+	t.Helper()
+	terms := make(payloadTermMap, 16)
+	var st contentStats
+	var total uint64
+	for id, text := range texts {
+		counts, dl := contentTermCounts(text)
+		for tok, tf := range counts {
+			terms[tok] = append(terms[tok], docEntry{id: id, tf: tf, dl: dl})
+		}
+		st.Docs++
+		total += uint64(dl)
+	}
+	if err := writeContentSegment(terms, st, total); err != nil {
+		t.Fatalf("build content index: %v", err)
+	}
+}
+
+func TestSearchContent_MatchesAllTermsWithSnippet(t *testing.T) {
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+	}()
+	pebGet = p.get
+	indexDirOverride = t.TempDir()
+
+	texts := map[uint64]string{
+		1: strings.Repeat("filler ", 20) + "The Quarterly Revenue grew by ten percent.",
+		2: "Revenue is not mentioned with the other word here.",
+		3: "会议纪要：季度收入增长",
+		4: "Le café était fermé.",
+	}
+	paths := map[uint64]string{1: "/mnt/usb1/docs/report.pdf", 2: "/mnt/usb1/docs/memo.docx", 3: "/mnt/usb2/纪要.txt", 4: "/mnt/usb2/notes.txt"}
+	for id, text := range texts {
+		p.setMeta(FileMeta{FileID: id, Path: paths[id], Name: filepath.Base(paths[id]), Size: uint64(len(text))})
+		p.setContent(id, ContentRecord{Size: uint64(len(text)), Text: text})
+	}
+	buildTestContentIndex(t, texts)
+
+	hits, err := SearchContent("quarterly revenue", "", "", 0, 10, "", 0, nil)
+	if err != nil {
+		t.Fatalf("SearchContent: %v", err)
+	}
+	if len(hits) != 1 || hits[0].Path != paths[1] {
+		t.Fatalf("expected only %s, got %+v", paths[1], hits)
+	}
+	if !strings.Contains(hits[0].Snippet, "The Quarterly Revenue grew") || !strings.HasPrefix(hits[0].Snippet, "…") {
+		t.Fatalf("unexpected snippet %q", hits[0].Snippet)
+	}
+
+	hits, _ = SearchContent("收入", "", "", 0, 10, "", 0, nil)
+	if len(hits) != 1 || hits[0].Path != paths[3] {
+		t.Fatalf("expected CJK match %s, got %+v", paths[3], hits)
+	}
+
+	// Accents and case are ignored; highlights cover the accented original.
+	hits, _ = SearchContent("CAFE ferme", "", "", 0, 10, "", 0, nil)
+	if len(hits) != 1 || hits[0].Path != paths[4] {
+		t.Fatalf("expected diacritic-folded match %s, got %+v", paths[4], hits)
+	}
+	if want := []MatchRange{{Start: 3, Length: 4}, {Start: 14, Length: 5}}; !reflect.DeepEqual(hits[0].SnippetMatches, want) {
+		t.Fatalf("unexpected snippet matches %+v", hits[0].SnippetMatches)
+	}
+
+	hits, _ = SearchContent("revenue", "", "/mnt/usb1/docs", 0, 10, "", 0, nil)
+	if len(hits) != 2 {
+		t.Fatalf("expected 2 hits under parent, got %+v", hits)
+	}
+	hits, _ = SearchContent("revenue", "", "/mnt/usb2", 0, 10, "", 0, nil)
+	if len(hits) != 0 {
+		t.Fatalf("expected no hits under /mnt/usb2, got %+v", hits)
+	}
+	// The name filter applies before paging: the second name match is on page 2.
+	hits, _ = SearchContent("revenue", "MEMO", "", 0, 10, "", 0, nil)
+	if len(hits) != 1 || hits[0].Path != paths[2] {
+		t.Fatalf("expected only %s by name, got %+v", paths[2], hits)
+	}
+	hits, _ = SearchContent("revenue", ".", "", 1, 1, "", 0, nil)
+	if len(hits) != 1 {
+		t.Fatalf("expected a second page of name matches, got %+v", hits)
+	}
+}
+
+func TestMakeSnippet_ShortTextIsWhole(t *testing.T) {
+	got := makeSnippet("Hello\nworld", []string{"world"})
+	if got != "Hello world" {
+		t.Fatalf("unexpected snippet %q", got)
+	}
+}
+
+func TestSearchContent_RanksByBM25(t *testing.T) {
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+	}()
+	pebGet = p.get
+	indexDirOverride = t.TempDir()
+
+	texts := map[uint64]string{
+		1: "budget mentioned once among many other words in a long planning document about travel",
+		2: "budget budget budget review",
+		3: "unrelated text",
+	}
+	for id, text := range texts {
+		p.setMeta(FileMeta{FileID: id, Path: "/d/" + strconv.FormatUint(id, 10) + ".txt", Name: strconv.FormatUint(id, 10) + ".txt"})
+		p.setContent(id, ContentRecord{Text: text})
+	}
+	buildTestContentIndex(t, texts)
+
+	hits, err := SearchContent("Budget", "", "", 0, 10, "", 0, nil)
+	if err != nil {
+		t.Fatalf("SearchContent: %v", err)
+	}
+	if len(hits) != 2 || hits[0].Path != "/d/2.txt" || hits[0].Score <= hits[1].Score {
+		t.Fatalf("expected /d/2.txt ranked first, got %+v", hits)
+	}
+	want := []MatchRange{{Start: 0, Length: 6}, {Start: 7, Length: 6}, {Start: 14, Length: 6}}
+	if !reflect.DeepEqual(hits[0].SnippetMatches, want) {
+		t.Fatalf("unexpected snippet matches %+v", hits[0].SnippetMatches)
+	}
+}
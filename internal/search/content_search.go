@@ -0,0 +1,196 @@
+package search
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Snippet window around the first match, in bytes of extracted text.
+const (
+	snippetBefore = 60
+	snippetAfter  = 140
+)
+
+// ContentHit is a file whose extracted text matched a content query.
+type ContentHit struct {
+	Path    string
+	Snippet string
+	// Score is the BM25 relevance of the document for the query.
+	Score float64
+	// SnippetMatches highlight the query terms inside Snippet.
+	SnippetMatches []MatchRange
+}
+
+// SearchContent returns files whose text contains every term of query, best BM25 score first,
+// with a snippet around the first match. parent restricts results to a directory and name, when
+// set, to files whose name contains it (case-insensitively); sizeOp/sizeBytes and filters apply
+// like in SearchIndexFiltered. All of them are applied before offset and limit.
+func SearchContent(query string, name string, parent string, offset int, limit int, sizeOp string, sizeBytes uint64, filters [][]FilterField) ([]ContentHit, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		limit = 500
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	terms := contentTerms(strings.TrimSpace(query))
+	if len(terms) == 0 {
+		return []ContentHit{}, nil
+	}
+	if !contentIndexExists() {
+		// Missing or written in an older format; the content indexer rebuilds it.
+		return []ContentHit{}, nil
+	}
+	cm, err := openMmapIndex(contentDictJSON(), contentPostingsDat(), contentPostingsIdx())
+	if err != nil {
+		return []ContentHit{}, nil
+	}
+	defer cm.close()
+
+	lists := make([][]docEntry, 0, len(terms))
+	for _, t := range terms {
+		entries, _ := cm.postingEntries(cm.dict[t])
+		if len(entries) == 0 {
+			return []ContentHit{}, nil
+		}
+		lists = append(lists, entries)
+	}
+	sort.Slice(lists, func(i, j int) bool { return len(lists[i]) < len(lists[j]) })
+	// Intersect, keeping each document's entries in lists order for scoring.
+	cand := make(map[uint64][]docEntry, len(lists[0]))
+	for _, e := range lists[0] {
+		cand[e.id] = []docEntry{e}
+	}
+	for _, list := range lists[1:] {
+		next := make(map[uint64][]docEntry, len(cand))
+		for _, e := range list {
+			if prev, ok := cand[e.id]; ok {
+				next[e.id] = append(prev, e)
+			}
+		}
+		cand = next
+	}
+	dfs := make([]int, len(lists))
+	for i, l := range lists {
+		dfs[i] = len(l)
+	}
+
+	groups := compileFilters(filters, time.Now())
+	if len(groups) > 0 {
+		if fids, ok := filterCandidates(groups); ok {
+			keep := make(map[uint64]struct{}, len(fids))
+			for _, id := range fids {
+				keep[id] = struct{}{}
+			}
+			for id := range cand {
+				if _, ok := keep[id]; !ok {
+					delete(cand, id)
+				}
+			}
+		}
+	}
+
+	st := loadContentStats()
+	type scored struct {
+		id    uint64
+		score float64
+	}
+	ranked := make([]scored, 0, len(cand))
+	for id, entries := range cand {
+		ranked = append(ranked, scored{id: id, score: bm25(entries, dfs, st)})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].id < ranked[j].id
+	})
+
+	if parent != "" && !strings.HasSuffix(parent, "/") {
+		parent += "/"
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	out := make([]ContentHit, 0, min(len(ranked), limit))
+	skipped := 0
+	for _, r := range ranked {
+		id := r.id
+		b, _ := pebGet(keyFileMeta(id))
+		if b == nil {
+			continue
+		}
+		var m FileMeta
+		if json.Unmarshal(b, &m) != nil {
+			continue
+		}
+		if parent != "" && !strings.HasPrefix(m.Path, parent) {
+			continue
+		}
+		if name != "" && !strings.Contains(strings.ToLower(m.Name), name) {
+			continue
+		}
+		if sizeOp != "" && !fileSizeMatches(m.Size, sizeOp, sizeBytes) {
+			continue
+		}
+		if !matchFilters(&m, groups) {
+			continue
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		hit := ContentHit{Path: m.Path, Score: r.score}
+		if rb, _ := pebGet(keyContent(id)); rb != nil {
+			var rec ContentRecord
+			if json.Unmarshal(rb, &rec) == nil {
+				hit.Snippet = makeSnippet(rec.Text, terms)
+				hit.SnippetMatches = toUTF16Ranges(hit.Snippet, findFolded(hit.Snippet, terms))
+			}
+		}
+		out = append(out, hit)
+		if len(out) >= limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+// makeSnippet cuts a single-line excerpt of text around the earliest occurrence of any term.
+func makeSnippet(text string, terms []string) string {
+	if text == "" {
+		return ""
+	}
+	pos := 0
+	if ranges := findFolded(text, terms); len(ranges) > 0 {
+		pos = ranges[0][0]
+		for _, r := range ranges[1:] {
+			pos = min(pos, r[0])
+		}
+	}
+	start := pos - snippetBefore
+	if start < 0 {
+		start = 0
+	}
+	end := pos + snippetAfter
+	if end > len(text) {
+		end = len(text)
+	}
+	for start > 0 && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end++
+	}
+	s := strings.Join(strings.Fields(text[start:end]), " ")
+	if start > 0 {
+		s = "…" + s
+	}
+	if end < len(text) {
+		s += "…"
+	}
+	return s
+}
@@ -0,0 +1,331 @@
+package search
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// typeExts groups extensions for type: filters. image/video/audio follow the media scanner.
+var typeExts = map[string][]string{
+	"image": {"jpg", "jpeg", "png", "gif", "webp", "bmp", "tif", "tiff", "heic", "heif", "avif", "svg"},
+	"video": {"mp4", "mkv", "webm", "avi", "3gp", "mov", "m4v", "3gpp"},
+	"audio": {"mp3", "wav", "wma", "ogg", "m4a", "opus", "flac", "aac"},
+	"doc": {"pdf", "doc", "docx", "xls", "xlsx", "ppt", "pptx", "odt", "ods", "odp", "rtf", "txt", "md",
+		"markdown", "csv", "epub", "pages", "numbers", "key"},
+}
+
+var extType = func() map[string]string {
+	m := make(map[string]string)
+	for t, exts := range typeExts {
+		for _, e := range exts {
+			m[e] = t
+		}
+	}
+	return m
+}()
+
+// IsIndexFilter reports whether f is evaluated against the filter index
+// (ext:, type:, modified, is:dir, is:file and negated text).
+func IsIndexFilter(f FilterField) bool {
+	switch f.Name {
+	case "ext", "type", "modified", "dir", "file":
+		return true
+	case "text":
+		return f.Op == "!="
+	}
+	return false
+}
+
+// timeRange is a half-open [from, to) interval of unix seconds.
+type timeRange struct{ from, to int64 }
+
+func (r timeRange) contains(ts int64) bool { return ts >= r.from && ts < r.to }
+
+// compiledFilter is a FilterField with its values parsed once per query.
+type compiledFilter struct {
+	FilterField
+	values []string
+	ranges []timeRange
+	tokens []string
+	valid  bool
+}
+
+var relativeTimeRe = regexp.MustCompile(`^(\d+)([hdwmy])$`)
+
+// parseTimeSpan turns a date value into the span it covers: "2024" a year, "2024-03" a month,
+// "2024-03-01" a day, RFC 3339 an instant. "7d", "12h", "2w", "6m" and "1y" are instants relative to now.
+func parseTimeSpan(v string, now time.Time) (int64, int64, bool) {
+	v = strings.TrimSpace(v)
+	if m := relativeTimeRe.FindStringSubmatch(strings.ToLower(v)); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		var t time.Time
+		switch m[2] {
+		case "h":
+			t = now.Add(-time.Duration(n) * time.Hour)
+		case "d":
+			t = now.AddDate(0, 0, -n)
+		case "w":
+			t = now.AddDate(0, 0, -7*n)
+		case "m":
+			t = now.AddDate(0, -n, 0)
+		case "y":
+			t = now.AddDate(-n, 0, 0)
+		}
+		return t.Unix(), t.Unix() + 1, true
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.Unix(), t.Unix() + 1, true
+	}
+	layouts := []struct {
+		layout string
+		next   func(time.Time) time.Time
+	}{
+		{"2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }},
+		{"2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }},
+		{"2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }},
+	}
+	for _, l := range layouts {
+		if t, err := time.ParseInLocation(l.layout, v, now.Location()); err == nil {
+			return t.Unix(), l.next(t).Unix(), true
+		}
+	}
+	return 0, 0, false
+}
+
+// timeRanges returns the intervals matching "modified <op> span".
+func timeRanges(op string, from, to int64) []timeRange {
+	switch op {
+	case ">=":
+		return []timeRange{{from, math.MaxInt64}}
+	case ">":
+		return []timeRange{{to, math.MaxInt64}}
+	case "<":
+		return []timeRange{{math.MinInt64, from}}
+	case "<=":
+		return []timeRange{{math.MinInt64, to}}
+	case "!=":
+		return []timeRange{{math.MinInt64, from}, {to, math.MaxInt64}}
+	default:
+		return []timeRange{{from, to}}
+	}
+}
+
+func compileFilters(groups [][]FilterField, now time.Time) [][]compiledFilter {
+	out := make([][]compiledFilter, 0, len(groups))
+	for _, g := range groups {
+		cg := make([]compiledFilter, 0, len(g))
+		for _, f := range g {
+			cf := compiledFilter{FilterField: f, valid: true}
+			switch f.Name {
+			case "ext", "type":
+				for _, v := range strings.Split(strings.ToLower(f.Value), ",") {
+					if v = strings.TrimPrefix(strings.TrimSpace(v), "."); v != "" {
+						cf.values = append(cf.values, v)
+					}
+				}
+			case "modified":
+				from, to, ok := parseTimeSpan(f.Value, now)
+				cf.valid = ok
+				if ok {
+					cf.ranges = timeRanges(f.Op, from, to)
+				}
+			case "text":
+				cf.tokens = tokenize(f.Value)
+			}
+			cg = append(cg, cf)
+		}
+		out = append(out, cg)
+	}
+	return out
+}
+
+// match reports whether the file satisfies the filter exactly.
+func (f *compiledFilter) match(m *FileMeta) bool {
+	if !f.valid {
+		return false
+	}
+	switch f.Name {
+	case "ext", "type":
+		v := m.Ext
+		if f.Name == "type" {
+			v = extType[m.Ext]
+		}
+		hit := !m.IsDir && v != "" && containsString(f.values, v)
+		if f.Op == "!=" {
+			return !hit
+		}
+		return hit
+	case "modified":
+		for _, r := range f.ranges {
+			if r.contains(m.MTime) {
+				return true
+			}
+		}
+		return false
+	case "dir":
+		return m.IsDir == (f.Value != "false")
+	case "file":
+		return !m.IsDir == (f.Value != "false")
+	case "text":
+		// Negated text: exclude names that contain every token.
+		if len(f.tokens) == 0 {
+			return true
+		}
+		name := tokenize(m.Name)
+		for _, t := range f.tokens {
+			if !containsString(name, t) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// matchFilters reports whether m satisfies every group (an OR of filters).
+func matchFilters(m *FileMeta, groups [][]compiledFilter) bool {
+	for i := range groups {
+		ok := false
+		for j := range groups[i] {
+			if groups[i][j].match(m) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// filterCandidates narrows groups to a superset of matching FileIDs using the filter index:
+// alternatives are unioned, groups intersected. Exact matching happens per file afterwards.
+// ok is false when the filter index is unavailable.
+func filterCandidates(groups [][]compiledFilter) (ids []uint64, ok bool) {
+	fm, err := openVolumeIndex(segFilter, "")
+	if err != nil {
+		return nil, false
+	}
+	defer fm.close()
+
+	// Both sets are loaded at most once per query.
+	var all, fileIDs []uint64
+	allLoaded, filesLoaded := false, false
+	universe := func() []uint64 {
+		if !allLoaded {
+			// Every entry, file or directory, has exactly one mtime bucket.
+			all = fm.unionPrefix("mtime:", nil)
+			allLoaded = true
+		}
+		return all
+	}
+	files := func() []uint64 {
+		if !filesLoaded {
+			// Only files get a size bucket.
+			fileIDs = fm.unionPrefix("size:", nil)
+			filesLoaded = true
+		}
+		return fileIDs
+	}
+
+	for gi, g := range groups {
+		alts := make([][]uint64, 0, len(g))
+		for i := range g {
+			f := &g[i]
+			var c []uint64
+			switch f.Name {
+			case "ext":
+				exts := make([][]uint64, 0, len(f.values))
+				for _, v := range f.values {
+					exts = append(exts, fm.term("ext:"+v))
+				}
+				c = unionAll(exts)
+				if f.Op == "!=" {
+					c = differenceSorted(universe(), c)
+				}
+			case "type":
+				c = fm.unionPrefix("ext:", func(ext string) bool { return containsString(f.values, extType[ext]) })
+				if f.Op == "!=" {
+					c = differenceSorted(universe(), c)
+				}
+			case "modified":
+				if f.valid {
+					c = fm.unionPrefix("mtime:", func(bucket string) bool { return bucketInRanges(bucket, f.ranges) })
+				}
+			case "dir", "file":
+				wantFiles := (f.Name == "file") == (f.Value != "false")
+				c = files()
+				if !wantFiles {
+					c = differenceSorted(universe(), c)
+				}
+			default:
+				c = universe()
+			}
+			alts = append(alts, c)
+		}
+		u := unionAll(alts)
+		if gi == 0 {
+			ids = u
+		} else {
+			ids = intersectSorted(ids, u)
+		}
+		if len(ids) == 0 {
+			break
+		}
+	}
+	return ids, true
+}
+
+// bucketInRanges reports whether a 30-day mtime bucket ("m<N>") may hold times in ranges.
+func bucketInRanges(bucket string, ranges []timeRange) bool {
+	n, err := strconv.ParseInt(strings.TrimPrefix(bucket, "m"), 10, 64)
+	if err != nil {
+		return false
+	}
+	const span = 30 * 24 * 3600
+	from, to := n*span, (n+1)*span
+	if n == 0 {
+		// m0 also holds unknown (<= 0) times.
+		from = math.MinInt64
+	}
+	for _, r := range ranges {
+		if r.from < to && from < r.to {
+			return true
+		}
+	}
+	return false
+}
+
+// term returns the posting list of an exact term.
+func (m *mmapIndex) term(t string) []uint64 {
+	ids, _ := m.posting(m.dict[t])
+	return ids
+}
+
+// prefixPostings appends to lists the postings of all terms with prefix whose remainder passes
+// keep (nil keeps all).
+func (m *mmapIndex) prefixPostings(lists [][]uint64, prefix string, keep func(rest string) bool) [][]uint64 {
+	for t, id := range m.dict {
+		rest, ok := strings.CutPrefix(t, prefix)
+		if !ok || (keep != nil && !keep(rest)) {
+			continue
+		}
+		if ids, _ := m.posting(id); len(ids) > 0 {
+			lists = append(lists, ids)
+		}
+	}
+	return lists
+}
@@ -0,0 +1,104 @@
+package search
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func buildTestFilterIndex(t *testing.T, metas []FileMeta) {
+	t.Helper()
+	terms := make(termMap, 16)
+	for _, m := range metas {
+		if !m.IsDir {
+			terms["ext:"+m.Ext] = append(terms["ext:"+m.Ext], m.FileID)
+			terms["size:"+sizeBucket(m.Size)] = append(terms["size:"+sizeBucket(m.Size)], m.FileID)
+		}
+		terms["mtime:"+mtimeBucket(m.MTime)] = append(terms["mtime:"+mtimeBucket(m.MTime)], m.FileID)
+	}
+	buildTestSegment(t, volumeDir(testVolume), segFilter, terms)
+}
+
+func TestSearchIndexFiltered_ExtTypeModifiedAndKind(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+	}()
+	pebGet = p.get
+
+	jan := time.Date(2024, 1, 15, 12, 0, 0, 0, time.Local).Unix()
+	jun := time.Date(2024, 6, 15, 12, 0, 0, 0, time.Local).Unix()
+	metas := []FileMeta{
+		{FileID: 1, Path: "/d/trip/beach.jpg", Name: "beach.jpg", Ext: "jpg", Size: 2048, MTime: jan},
+		{FileID: 2, Path: "/d/trip/beach.mp4", Name: "beach.mp4", Ext: "mp4", Size: 4096, MTime: jun},
+		{FileID: 3, Path: "/d/trip/notes.pdf", Name: "notes.pdf", Ext: "pdf", Size: 100, MTime: jun},
+		{FileID: 4, Path: "/d/trip", Name: "trip", IsDir: true, MTime: jun},
+		{FileID: 5, Path: "/d/trip/beach draft.png", Name: "beach draft.png", Ext: "png", Size: 10, MTime: jun},
+	}
+	for _, m := range metas {
+		p.setPathToID(m.Path, m.FileID)
+		p.setMeta(m)
+	}
+	buildTestIndexes(t, filepath.Join(tmpDir, "searchidx"), metas)
+	buildTestFilterIndex(t, metas)
+
+	search := func(text string, filters [][]FilterField) []string {
+		t.Helper()
+		got, err := SearchIndexFiltered(text, "", 0, 50, "", 0, filters)
+		if err != nil {
+			t.Fatalf("SearchIndexFiltered: %v", err)
+		}
+		return got
+	}
+	cases := []struct {
+		name    string
+		text    string
+		filters [][]FilterField
+		want    []string
+	}{
+		{"ext", "", [][]FilterField{{{Name: "ext", Op: "=", Value: "pdf"}}}, []string{"/d/trip/notes.pdf"}},
+		{"ext or", "beach", [][]FilterField{{{Name: "ext", Op: "=", Value: "jpg"}, {Name: "ext", Op: "=", Value: "mp4", Or: true}}},
+			[]string{"/d/trip/beach.mp4", "/d/trip/beach.jpg"}}, // same tier, newer first
+		{"type", "", [][]FilterField{{{Name: "type", Op: "=", Value: "image"}}}, []string{"/d/trip/beach.jpg", "/d/trip/beach draft.png"}},
+		{"not type", "beach", [][]FilterField{{{Name: "type", Op: "!=", Value: "image"}}}, []string{"/d/trip/beach.mp4"}},
+		{"modified", "", [][]FilterField{{{Name: "modified", Op: "<", Value: "2024-02"}}}, []string{"/d/trip/beach.jpg"}},
+		{"is dir", "", [][]FilterField{{{Name: "dir", Value: "true"}}}, []string{"/d/trip"}},
+		{"negated text", "beach", [][]FilterField{{{Name: "text", Op: "!=", Value: "draft"}}, {{Name: "type", Op: "=", Value: "image"}}},
+			[]string{"/d/trip/beach.jpg"}},
+	}
+	for _, c := range cases {
+		if got := search(c.text, c.filters); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestParseTimeSpan(t *testing.T) {
+	now := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	from, to, ok := parseTimeSpan("2024-03", now)
+	if !ok || from != time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Unix() || to != time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC).Unix() {
+		t.Fatalf("month span: %d %d %v", from, to, ok)
+	}
+	from, _, ok = parseTimeSpan("7d", now)
+	if !ok || from != now.AddDate(0, 0, -7).Unix() {
+		t.Fatalf("relative span: %d %v", from, ok)
+	}
+	if _, _, ok := parseTimeSpan("yesterday", now); ok {
+		t.Fatalf("expected invalid value")
+	}
+}
+
+func TestUnionAll(t *testing.T) {
+	got := unionAll([][]uint64{{1, 4, 9}, nil, {2, 4, 10}, {1, 3}, {11}})
+	if want := []uint64{1, 2, 3, 4, 9, 10, 11}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unionAll = %v, want %v", got, want)
+	}
+	if got := unionAll(nil); got != nil {
+		t.Fatalf("unionAll(nil) = %v", got)
+	}
+}
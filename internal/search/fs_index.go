@@ -10,10 +10,15 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"syscall"
 
 	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/ignore"
+	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
+	"ismartcoding/plainnas/internal/pkg/pinyin"
 
 	"github.com/cespare/xxhash/v2"
 )
@@ -31,6 +36,8 @@ type FileMeta struct {
 	MTime          int64  `json:"mtime"`
 	IsDir          bool   `json:"isDir"`
 	ContentIndexed bool   `json:"contentIndexed"`
+	// Volume is the FSUUID of the volume the file was indexed from (see IndexVolume).
+	Volume string `json:"volume,omitempty"`
 }
 
 // Pebble key helpers
@@ -49,45 +56,6 @@ func indexDir() string {
 	return filepath.Join(consts.DATA_DIR, "searchidx")
 }
 
-func namePostingsDat() string { return filepath.Join(indexDir(), "name.postings.dat") }
-func namePostingsIdx() string { return filepath.Join(indexDir(), "name.postings.idx") }
-func nameDictJSON() string    { return filepath.Join(indexDir(), "name.dict.json") }
-
-func pathPostingsDat() string { return filepath.Join(indexDir(), "path.postings.dat") }
-func pathPostingsIdx() string { return filepath.Join(indexDir(), "path.postings.idx") }
-func pathDictJSON() string    { return filepath.Join(indexDir(), "path.dict.json") }
-
-// Ngram index files for fuzzy search (ASCII 2-gram + CJK bigram)
-func nameNgramPostingsDat() string { return filepath.Join(indexDir(), "name_ngram.postings.dat") }
-func nameNgramPostingsIdx() string { return filepath.Join(indexDir(), "name_ngram.postings.idx") }
-func nameNgramDictJSON() string    { return filepath.Join(indexDir(), "name_ngram.dict.json") }
-func pathNgramPostingsDat() string { return filepath.Join(indexDir(), "path_ngram.postings.dat") }
-func pathNgramPostingsIdx() string { return filepath.Join(indexDir(), "path_ngram.postings.idx") }
-func pathNgramDictJSON() string    { return filepath.Join(indexDir(), "path_ngram.dict.json") }
-
-// Filter index files (bitmap-style postings for ext/size/mtime)
-func filterPostingsDat() string { return filepath.Join(indexDir(), "filter.postings.dat") }
-func filterPostingsIdx() string { return filepath.Join(indexDir(), "filter.postings.idx") }
-func filterDictJSON() string    { return filepath.Join(indexDir(), "filter.dict.json") }
-
-// IndexExists reports whether the custom inverted index exists on disk.
-func IndexExists() bool {
-	_, e1 := os.Stat(namePostingsDat())
-	_, e2 := os.Stat(namePostingsIdx())
-	_, e3 := os.Stat(nameDictJSON())
-	_, e4 := os.Stat(pathPostingsDat())
-	_, e5 := os.Stat(pathPostingsIdx())
-	_, e6 := os.Stat(pathDictJSON())
-	// Require fuzzy ngram indexes too, so watcher rebuilds if they are missing
-	_, e7 := os.Stat(nameNgramPostingsDat())
-	_, e8 := os.Stat(nameNgramPostingsIdx())
-	_, e9 := os.Stat(nameNgramDictJSON())
-	_, e10 := os.Stat(pathNgramPostingsDat())
-	_, e11 := os.Stat(pathNgramPostingsIdx())
-	_, e12 := os.Stat(pathNgramDictJSON())
-	return e1 == nil && e2 == nil && e3 == nil && e4 == nil && e5 == nil && e6 == nil && e7 == nil && e8 == nil && e9 == nil && e10 == nil && e11 == nil && e12 == nil
-}
-
 // FileID generation using xxhash64(dev:ino:ctime)
 func genFileID(fi os.FileInfo) (uint64, error) {
 	st, ok := fi.Sys().(*syscall.Stat_t)
@@ -101,141 +69,269 @@ func genFileID(fi os.FileInfo) (uint64, error) {
 // postings builder in-memory
 type termMap map[string][]uint64
 
-// IndexPaths scans roots, writes Pebble metadata, and builds name/path inverted indexes.
+// progressEvery is how many files IndexVolume walks between two progress events.
+const progressEvery = 5000
+
+func publishIndexProgress(v Volume, state string, files int64, runs int) {
+	eventbus.GetDefault().Publish(consts.EVENT_SEARCH_INDEX_PROGRESS, map[string]any{
+		"volume": v.FSUUID,
+		"root":   filepath.ToSlash(v.Root),
+		"state":  state,
+		"files":  files,
+		"runs":   runs,
+	})
+}
+
+// buildMu serializes volume builds, which share the scratch directory.
+var buildMu sync.Mutex
+
+// IndexPaths indexes the roots by filesystem: roots on the same volume (see
+// media.VolumeIDForPath) are walked into one set of segments. Segments of other volumes are
+// removed, unless the volume is known (db.KnownVolume) and kept as offline catalog.
 func IndexPaths(roots []string, showHidden bool) error {
-	if len(roots) == 0 {
+	var ids []string
+	byID := map[string][]string{}
+	for _, root := range roots {
+		id := media.VolumeIDForPath(root)
+		if _, ok := byID[id]; !ok {
+			ids = append(ids, id)
+		}
+		byID[id] = append(byID[id], root)
+	}
+	keep := map[string]struct{}{}
+	for id := range db.GetKnownVolumes() {
+		keep[volumeKey(id)] = struct{}{}
+	}
+	for _, id := range ids {
+		rs := byID[id]
+		if err := indexVolume(Volume{FSUUID: id, Root: rs[0]}, rs, showHidden); err != nil {
+			return err
+		}
+		keep[volumeKey(id)] = struct{}{}
+	}
+	removeVolumesExcept(keep)
+	return nil
+}
+
+// IndexVolume walks the volume root, writes Pebble metadata, and rebuilds the volume's
+// segments; other volumes are untouched. The segments are built in a staging directory and
+// swapped in when complete, so queries keep using the previous segments meanwhile.
+// Postings are kept within the search.index_memory_mb budget by spilling sorted runs to disk
+// (see indexBuilder); progress is published as EVENT_SEARCH_INDEX_PROGRESS.
+func IndexVolume(v Volume, showHidden bool) error {
+	return indexVolume(v, []string{v.Root}, showHidden)
+}
+
+// indexVolume is IndexVolume walking each of roots, all on volume v.
+func indexVolume(v Volume, roots []string, showHidden bool) (err error) {
+	if v.Root == "" {
 		return nil
 	}
-	_ = os.MkdirAll(indexDir(), 0o755)
-	names := make(termMap, 1<<16)
-	paths := make(termMap, 1<<16)
+	if v.FSUUID == "" {
+		v.FSUUID = v.Root
+	}
+	buildMu.Lock()
+	defer buildMu.Unlock()
+	if err := os.MkdirAll(volumesRoot(), 0o755); err != nil {
+		return err
+	}
+	removeStaleBuildDirs()
+	final := volumeDir(v.FSUUID)
+	stale, _ := filepath.Glob(final + ".*")
+	for _, d := range stale {
+		_ = os.RemoveAll(d)
+	}
+	stage, err := os.MkdirTemp(volumesRoot(), volumeKey(v.FSUUID)+".new-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stage)
+	ib, err := newIndexBuilder()
+	if err != nil {
+		return err
+	}
+	defer ib.close()
+	var files int64
+	ib.onSpill = func(runs int) { publishIndexProgress(v, "scanning", files, runs) }
+	defer func() {
+		if err != nil {
+			publishIndexProgress(v, "failed", files, ib.runs)
+		}
+	}()
+
+	seg := func(name string) *builderSegment { return ib.segment(segmentFiles(stage, name)) }
+	names := seg(segName)
+	paths := seg(segPath)
+	nameFolds := seg(segNameFold)
+	pathFolds := seg(segPathFold)
+	var namePinyins *builderSegment
+	if pinyinEnabled() {
+		namePinyins = seg(segNamePinyin)
+	}
 	// Fuzzy term maps
-	nameNgrams := make(termMap, 1<<16)
-	pathNgrams := make(termMap, 1<<16)
+	nameNgrams := seg(segNameNgram)
+	pathNgrams := seg(segPathNgram)
+	filters := seg(segFilter)
 	peb := db.GetDefault()
+	publishIndexProgress(v, "scanning", 0, 0)
 
-	for _, root := range roots {
-		filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
-			if err != nil {
-				return nil
+	isRoot := map[string]bool{}
+	for _, r := range roots {
+		isRoot[r] = true
+	}
+	ign := ignore.New(db.GetIgnorePatterns())
+	walk := func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		// Always exclude PlainNAS trash trees from the search index.
+		// These are implementation details and must never enter the normal file index.
+		name := d.Name()
+		if d.IsDir() {
+			if name == ".nas-trash" {
+				return filepath.SkipDir
 			}
-			// Always exclude PlainNAS trash trees from the search index.
-			// These are implementation details and must never enter the normal file index.
-			name := d.Name()
+		}
+		if strings.Contains(filepath.Clean(p), string(filepath.Separator)+".nas-trash"+string(filepath.Separator)) {
 			if d.IsDir() {
-				if name == ".nas-trash" {
-					return filepath.SkipDir
-				}
+				return filepath.SkipDir
 			}
-			if strings.Contains(filepath.Clean(p), string(filepath.Separator)+".nas-trash"+string(filepath.Separator)) {
-				if d.IsDir() {
-					return filepath.SkipDir
-				}
-				return nil
+			return nil
+		}
+		// Skip heavy/virtual pseudo filesystems
+		if d.IsDir() {
+			switch p {
+			case "/proc", "/sys", "/dev", "/run", "/tmp", "/var/run", "/var/tmp":
+				return filepath.SkipDir
 			}
-			// Skip heavy/virtual pseudo filesystems
+		}
+		if !showHidden && strings.HasPrefix(name, ".") {
 			if d.IsDir() {
-				switch p {
-				case "/proc", "/sys", "/dev", "/run", "/tmp", "/var/run", "/var/tmp":
-					return filepath.SkipDir
-				}
-			}
-			if !showHidden && strings.HasPrefix(name, ".") {
-				if d.IsDir() {
-					return filepath.SkipDir
-				}
-				return nil
+				return filepath.SkipDir
 			}
-			fi, e := os.Lstat(p)
-			if e != nil {
-				return nil
-			}
-			fid, e := genFileID(fi)
-			if e != nil {
-				return nil
-			}
-			meta := FileMeta{
-				FileID: fid,
-				Path:   filepath.ToSlash(p),
-				Name:   name,
-				Ext:    strings.TrimPrefix(strings.ToLower(filepath.Ext(name)), "."),
-				Size:   uint64(fi.Size()),
-				MTime:  fi.ModTime().Unix(),
-				IsDir:  fi.IsDir(),
+			return nil
+		}
+		if !isRoot[p] && ign.Match(p, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
 			}
-			// Pebble writes (source of truth)
-			b, _ := json.Marshal(meta)
-			_ = peb.Set(keyFileMeta(fid), b, nil)
-			_ = peb.Set(keyPathToID(meta.Path), []byte(fmt.Sprintf("%d", fid)), nil)
-			// exact tokens
-			for _, t := range tokenize(name) {
-				names[t] = append(names[t], fid)
+			return nil
+		}
+		fi, e := os.Lstat(p)
+		if e != nil {
+			return nil
+		}
+		fid, e := genFileID(fi)
+		if e != nil {
+			return nil
+		}
+		meta := FileMeta{
+			FileID: fid,
+			Path:   filepath.ToSlash(p),
+			Name:   name,
+			Ext:    strings.TrimPrefix(strings.ToLower(filepath.Ext(name)), "."),
+			Size:   uint64(fi.Size()),
+			MTime:  fi.ModTime().Unix(),
+			IsDir:  fi.IsDir(),
+			Volume: v.FSUUID,
+		}
+		// Keep the content flag while the file is unchanged, so the content indexer skips it.
+		if prev, _ := pebGet(keyFileMeta(fid)); prev != nil {
+			var pm FileMeta
+			if json.Unmarshal(prev, &pm) == nil && pm.MTime == meta.MTime && pm.Size == meta.Size {
+				meta.ContentIndexed = pm.ContentIndexed
 			}
-			for _, t := range tokenize(meta.Path) {
-				paths[t] = append(paths[t], fid)
+		}
+		// Pebble writes (source of truth)
+		b, _ := json.Marshal(meta)
+		_ = peb.Set(keyFileMeta(fid), b, nil)
+		_ = peb.Set(keyPathToID(meta.Path), []byte(fmt.Sprintf("%d", fid)), nil)
+		files++
+		if files%progressEvery == 0 {
+			publishIndexProgress(v, "scanning", files, ib.runs)
+		}
+		add := func(s *builderSegment, terms []string) error {
+			for _, t := range terms {
+				if err := ib.add(s, t, fid); err != nil {
+					return err
+				}
 			}
-			// ngram tokens for fuzzy search
-			for _, ng := range buildQueryNgrams(name) {
-				nameNgrams[ng] = append(nameNgrams[ng], fid)
+			return nil
+		}
+		// exact tokens
+		nameToks := tokenize(name)
+		pathToks := tokenize(meta.Path)
+		if err := add(names, nameToks); err != nil {
+			return err
+		}
+		if err := add(paths, pathToks); err != nil {
+			return err
+		}
+		// diacritic-folded tokens
+		if err := add(nameFolds, foldedTokens(nameToks)); err != nil {
+			return err
+		}
+		if err := add(pathFolds, foldedTokens(pathToks)); err != nil {
+			return err
+		}
+		if namePinyins != nil {
+			if err := add(namePinyins, pinyin.Tokens(name)); err != nil {
+				return err
 			}
-			for _, ng := range buildQueryNgrams(meta.Path) {
-				pathNgrams[ng] = append(pathNgrams[ng], fid)
+		}
+		// ngram tokens for fuzzy search
+		if err := add(nameNgrams, buildQueryNgrams(name)); err != nil {
+			return err
+		}
+		if err := add(pathNgrams, buildQueryNgrams(meta.Path)); err != nil {
+			return err
+		}
+		// ext and size buckets (only for files, not directories), month buckets for all
+		var filterTerms []string
+		if !meta.IsDir {
+			if meta.Ext != "" {
+				filterTerms = append(filterTerms, "ext:"+meta.Ext)
 			}
-			return nil
-		})
+			filterTerms = append(filterTerms, "size:"+sizeBucket(meta.Size))
+		}
+		filterTerms = append(filterTerms, "mtime:"+mtimeBucket(meta.MTime))
+		return add(filters, filterTerms)
+	}
+	for _, root := range roots {
+		if err := filepath.WalkDir(root, walk); err != nil {
+			return err
+		}
 	}
 
 	// Build and persist indexes
-	if err := buildIndexFiles(names, nameDictJSON(), namePostingsDat(), namePostingsIdx()); err != nil {
+	publishIndexProgress(v, "merging", files, ib.runs)
+	if err := ib.finish(); err != nil {
 		return err
 	}
-	if err := buildIndexFiles(paths, pathDictJSON(), pathPostingsDat(), pathPostingsIdx()); err != nil {
+	if err := writeIndexVersion(stage, "files", filesIndexVersion); err != nil {
 		return err
 	}
-	// Build ngram fuzzy indexes
-	if err := buildIndexFiles(nameNgrams, nameNgramDictJSON(), nameNgramPostingsDat(), nameNgramPostingsIdx()); err != nil {
+	vb, _ := json.Marshal(v)
+	if err := os.WriteFile(volumeJSON(stage), vb, 0o644); err != nil {
 		return err
 	}
-	if err := buildIndexFiles(pathNgrams, pathNgramDictJSON(), pathNgramPostingsDat(), pathNgramPostingsIdx()); err != nil {
-		return err
+	// Swap the new segments in; open readers keep their mappings of the old files.
+	old := final + ".old"
+	_ = os.RemoveAll(old)
+	if _, err := os.Stat(final); err == nil {
+		if err := os.Rename(final, old); err != nil {
+			return err
+		}
 	}
-	// Build filter index from Pebble metadata
-	if err := BuildFilterIndex(); err != nil {
+	if err := os.Rename(stage, final); err != nil {
 		return err
 	}
+	_ = os.RemoveAll(old)
+	removeLegacySegments()
+	publishIndexProgress(v, "done", files, ib.runs)
 	return nil
 }
 
-// BuildFilterIndex scans Pebble FileMeta entries and writes filter postings for ext/size/mtime
-func BuildFilterIndex() error {
-	peb := db.GetDefault()
-	// term -> docIDs for filters
-	terms := make(termMap, 1<<14)
-	// Iterate all file metas
-	if err := peb.Iterate([]byte("f:"), func(key []byte, value []byte) error {
-		var m FileMeta
-		if err := json.Unmarshal(value, &m); err != nil {
-			return nil
-		}
-		docID := m.FileID
-		// ext (only for files, not directories)
-		if !m.IsDir && m.Ext != "" {
-			terms["ext:"+m.Ext] = append(terms["ext:"+m.Ext], docID)
-		}
-		// size bucket (only for files, not directories)
-		if !m.IsDir {
-			terms["size:"+sizeBucket(m.Size)] = append(terms["size:"+sizeBucket(m.Size)], docID)
-		}
-		// mtime bucket (month)
-		terms["mtime:"+mtimeBucket(m.MTime)] = append(terms["mtime:"+mtimeBucket(m.MTime)], docID)
-		return nil
-	}); err != nil {
-		return err
-	}
-	// Persist filter postings
-	return buildIndexFiles(terms, filterDictJSON(), filterPostingsDat(), filterPostingsIdx())
-}
-
 func sizeBucket(sz uint64) string {
 	switch {
 	case sz < 1<<10: // <1KB
@@ -268,86 +364,167 @@ func mtimeBucket(ts int64) string {
 
 // buildIndexFiles sorts, delta-encodes, and writes postings + dictionary + offsets
 func buildIndexFiles(terms termMap, dictPath, datPath, idxPath string) error {
-	// Collect and sort terms
 	keys := make([]string, 0, len(terms))
 	for k := range terms {
 		keys = append(keys, k)
 	}
-	sort.Strings(keys)
+	return writePostingFiles(keys, dictPath, datPath, idxPath, func(w *bufio.Writer, term string) (int, error) {
+		ids := terms[term]
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+		return writePostingIDs(w, ids)
+	})
+}
 
-	// Prepare files
-	fdat, err := os.Create(datPath)
+// writePostingIDs encodes a sorted posting list: the doc count, then delta-encoded ids.
+func writePostingIDs(w *bufio.Writer, ids []uint64) (int, error) {
+	total, err := writeUvarint(w, uint64(len(ids)))
 	if err != nil {
-		return err
+		return total, err
 	}
-	defer fdat.Close()
-	w := bufio.NewWriterSize(fdat, 1<<20)
-
-	// offsets array: for TermID starting at 1
-	type offrec struct {
-		Off uint64
-		Len uint32
+	var last uint64 = 0
+	for _, id := range ids {
+		n, err := writeUvarint(w, id-last)
+		if err != nil {
+			return total, err
+		}
+		total += n
+		last = id
 	}
-	offsets := make([]offrec, len(keys))
+	return total, nil
+}
 
-	// Dictionary map term -> TermID
-	dict := make(map[string]uint32, len(keys))
+// docEntry is a posting with its scoring payload: how often the term occurs in the document
+// and the document length in tokens.
+type docEntry struct {
+	id uint64
+	tf uint32
+	dl uint32
+}
 
-	// Write postings sequentially
-	var cur uint64 = 0
-	for i, term := range keys {
-		ids := terms[term]
-		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
-		// record offset
-		off := cur
-		// encode doc count
-		if _, err := writeUvarint(w, uint64(len(ids))); err != nil {
-			return err
+// payloadTermMap is a termMap whose postings carry docEntry payloads.
+type payloadTermMap map[string][]docEntry
+
+// buildPayloadIndexFiles writes postings like buildIndexFiles, with tf and dl varints after each id delta.
+func buildPayloadIndexFiles(terms payloadTermMap, dictPath, datPath, idxPath string) error {
+	keys := make([]string, 0, len(terms))
+	for k := range terms {
+		keys = append(keys, k)
+	}
+	return writePostingFiles(keys, dictPath, datPath, idxPath, func(w *bufio.Writer, term string) (int, error) {
+		docs := terms[term]
+		sort.Slice(docs, func(a, b int) bool { return docs[a].id < docs[b].id })
+		total, err := writeUvarint(w, uint64(len(docs)))
+		if err != nil {
+			return total, err
 		}
-		cur += uint64(varintLen(uint64(len(ids))))
-		// delta-encode ids
-		var last uint64 = 0
-		for _, id := range ids {
-			d := id - last
-			n, err := writeUvarint(w, d)
-			if err != nil {
-				return err
+		var last uint64
+		for _, d := range docs {
+			for _, v := range []uint64{d.id - last, uint64(d.tf), uint64(d.dl)} {
+				n, err := writeUvarint(w, v)
+				if err != nil {
+					return total, err
+				}
+				total += n
 			}
-			cur += uint64(n)
-			last = id
+			last = d.id
 		}
-		dict[term] = uint32(i + 1)
-		offsets[i] = offrec{Off: off, Len: uint32(cur - off)}
-	}
-	if err := w.Flush(); err != nil {
+		return total, nil
+	})
+}
+
+// writePostingFiles writes one posting list per term (in sorted term order) with encode,
+// then the offsets file and the term -> TermID dictionary.
+func writePostingFiles(keys []string, dictPath, datPath, idxPath string, encode func(w *bufio.Writer, term string) (int, error)) error {
+	sort.Strings(keys)
+	pw, err := newPostingWriter(dictPath, datPath, idxPath)
+	if err != nil {
 		return err
 	}
+	defer pw.abort()
+	for _, term := range keys {
+		if err := pw.add(term, func(w *bufio.Writer) (int, error) { return encode(w, term) }); err != nil {
+			return err
+		}
+	}
+	return pw.close()
+}
 
-	// Write offsets file
+// postingWriter streams a segment: posting lists go to the .dat file and their offsets to the
+// .idx file as they are added, so only the dictionary is kept in memory. Terms must be added in
+// sorted order; TermIDs are assigned from 1.
+type postingWriter struct {
+	fdat, fidx *os.File
+	w, iw      *bufio.Writer
+	dictPath   string
+	// Dictionary map term -> TermID
+	dict map[string]uint32
+	cur  uint64
+}
+
+func newPostingWriter(dictPath, datPath, idxPath string) (*postingWriter, error) {
+	fdat, err := os.Create(datPath)
+	if err != nil {
+		return nil, err
+	}
 	fidx, err := os.Create(idxPath)
+	if err != nil {
+		fdat.Close()
+		return nil, err
+	}
+	return &postingWriter{
+		fdat:     fdat,
+		fidx:     fidx,
+		w:        bufio.NewWriterSize(fdat, 1<<20),
+		iw:       bufio.NewWriterSize(fidx, 1<<20),
+		dictPath: dictPath,
+		dict:     make(map[string]uint32, 1<<12),
+	}, nil
+}
+
+func (pw *postingWriter) add(term string, encode func(w *bufio.Writer) (int, error)) error {
+	n, err := encode(pw.w)
 	if err != nil {
 		return err
 	}
-	defer fidx.Close()
-	bw := bufio.NewWriterSize(fidx, 1<<20)
-	for _, r := range offsets {
-		if err := binary.Write(bw, binary.LittleEndian, r.Off); err != nil {
-			return err
-		}
-		if err := binary.Write(bw, binary.LittleEndian, r.Len); err != nil {
-			return err
-		}
+	pw.dict[term] = uint32(len(pw.dict) + 1)
+	// offsets record: Off uint64, Len uint32
+	if err := binary.Write(pw.iw, binary.LittleEndian, pw.cur); err != nil {
+		return err
 	}
-	if err := bw.Flush(); err != nil {
+	if err := binary.Write(pw.iw, binary.LittleEndian, uint32(n)); err != nil {
 		return err
 	}
+	pw.cur += uint64(n)
+	return nil
+}
 
-	// Write dictionary json
-	bdict, _ := json.Marshal(dict)
-	if err := os.WriteFile(dictPath, bdict, 0o644); err != nil {
+// close flushes postings and offsets and writes the dictionary json.
+func (pw *postingWriter) close() error {
+	if err := pw.w.Flush(); err != nil {
 		return err
 	}
-	return nil
+	if err := pw.iw.Flush(); err != nil {
+		return err
+	}
+	if err := pw.fdat.Close(); err != nil {
+		return err
+	}
+	if err := pw.fidx.Close(); err != nil {
+		return err
+	}
+	pw.fdat, pw.fidx = nil, nil
+	bdict, _ := json.Marshal(pw.dict)
+	return os.WriteFile(pw.dictPath, bdict, 0o644)
+}
+
+// abort releases the files of a writer that was not closed.
+func (pw *postingWriter) abort() {
+	if pw.fdat != nil {
+		pw.fdat.Close()
+	}
+	if pw.fidx != nil {
+		pw.fidx.Close()
+	}
 }
 
 // varint helpers
@@ -357,7 +534,3 @@ func writeUvarint(w *bufio.Writer, x uint64) (int, error) {
 	_, err := w.Write(buf[:n])
 	return n, err
 }
-func varintLen(x uint64) int {
-	var buf [10]byte
-	return binary.PutUvarint(buf[:], x)
-}
@@ -8,11 +8,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
-func slicePage(out []string, offset, limit int) []string {
+func slicePage[T any](out []T, offset, limit int) []T {
 	if offset >= len(out) {
-		return []string{}
+		return []T{}
 	}
 	end := offset + limit
 	if end > len(out) {
@@ -49,11 +50,34 @@ func tryAbsolutePath(q string, offset, limit int) ([]string, bool) {
 // SearchIndex executes name/path token search with fuzzy fallback, optional parent prefix filter, and optional file size filter.
 // If sizeOp is non-empty, applies file size filtering using the filter index.
 func SearchIndex(text string, parent string, offset int, limit int, sizeOp string, sizeBytes uint64) ([]string, error) {
+	return SearchIndexFiltered(text, parent, offset, limit, sizeOp, sizeBytes, nil)
+}
+
+// SearchIndexFiltered is SearchIndex with additional filter groups (see IsIndexFilter).
+// Every group must match; the filters inside a group are alternatives (OR).
+func SearchIndexFiltered(text string, parent string, offset int, limit int, sizeOp string, sizeBytes uint64, filters [][]FilterField) ([]string, error) {
+	hits, err := SearchIndexHits(text, parent, offset, limit, sizeOp, sizeBytes, filters)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, len(hits))
+	for i, h := range hits {
+		out[i] = h.Path
+	}
+	return out, nil
+}
+
+// SearchIndexHits runs SearchIndexFiltered and returns ranked hits with match offsets.
+// Name queries are ordered by score (see nameScore); path and filter-only queries keep index order.
+func SearchIndexHits(text string, parent string, offset int, limit int, sizeOp string, sizeBytes uint64, filters [][]FilterField) ([]Hit, error) {
 	limit, offset, text, isPathQuery, parent, q, boundary, dirPrefix := searchIndexPreprocess(text, parent, offset, limit)
+	groups := compileFilters(filters, time.Now())
 
 	if isPathQuery {
-		if out, ok := tryAbsolutePath(q, offset, limit); ok {
-			return out, nil
+		if len(groups) == 0 {
+			if out, ok := tryAbsolutePath(q, offset, limit); ok {
+				return pathHits(out), nil
+			}
 		}
 		dirPrefix = searchIndexMaybeSetDirPrefix(boundary, &dirPrefix, sizeOp, sizeBytes, offset, limit)
 	}
@@ -62,17 +86,30 @@ func SearchIndex(text string, parent string, offset int, limit int, sizeOp strin
 	var sizeFilterIDs []uint64
 	if sizeOp != "" {
 		sizeFilterIDs = getSizeFilterIDs(sizeOp, sizeBytes)
-		// If no text query, return size filter results directly
-		if text == "" {
-			out := mapIDsToPathsWithFilters(sizeFilterIDs, isPathQuery, dirPrefix, boundary, parent, sizeOp, sizeBytes)
-			return slicePage(out, offset, limit), nil
+	}
+	// Narrow ext/type/mtime/kind filters via the filter index
+	var filterIDs []uint64
+	filterIndexed := false
+	if len(groups) > 0 {
+		filterIDs, filterIndexed = filterCandidates(groups)
+	}
+	// If no text query, return filter results directly
+	if text == "" && (sizeOp != "" || len(groups) > 0) {
+		ids := sizeFilterIDs
+		if filterIndexed {
+			ids = filterIDs
+			if sizeOp != "" {
+				ids = intersectSorted(ids, sizeFilterIDs)
+			}
 		}
+		metas := mapIDsToMetasWithFilters(ids, isPathQuery, dirPrefix, boundary, parent, sizeOp, sizeBytes, groups)
+		return slicePage(metaHits(metas), offset, limit), nil
 	}
 
 	// Execute text search
 	nm, pm, err := searchIndexOpenIndexes(isPathQuery)
 	if err != nil {
-		return []string{}, nil
+		return []Hit{}, nil
 	}
 	defer func() {
 		if nm != nil {
@@ -86,20 +123,56 @@ func SearchIndex(text string, parent string, offset int, limit int, sizeOp strin
 	terms := searchIndexTokenizeTerms(text, q, isPathQuery)
 	idsExact := searchIndexCollectIDs(terms, isPathQuery, nm, pm)
 	finalIDs := searchIndexMaybeFuzzy(idsExact, isPathQuery, limit, q, text)
+	typos := searchIndexMaybeTypos(idsExact, terms, isPathQuery, limit, nm, pm)
+	if len(typos) > 0 {
+		finalIDs = unionSorted(finalIDs, sortedIDs(typos))
+	}
+	var translit map[uint64]struct{}
+	if !isPathQuery {
+		if translit = pinyinMatches(terms); len(translit) > 0 {
+			finalIDs = unionSorted(finalIDs, sortedSet(translit))
+		}
+	}
 
 	// Intersect with size filter IDs if size filter is specified
 	if len(sizeFilterIDs) > 0 {
 		finalIDs = intersectSorted(finalIDs, sizeFilterIDs)
 	}
+	if filterIndexed {
+		finalIDs = intersectSorted(finalIDs, filterIDs)
+	}
 
 	// Map IDs to paths and apply filters
-	out := mapIDsToPathsWithFilters(finalIDs, isPathQuery, dirPrefix, boundary, parent, sizeOp, sizeBytes)
-	return slicePage(out, offset, limit), nil
+	metas := mapIDsToMetasWithFilters(finalIDs, isPathQuery, dirPrefix, boundary, parent, sizeOp, sizeBytes, groups)
+	if isPathQuery {
+		return slicePage(metaHits(metas), offset, limit), nil
+	}
+	exact := make(map[uint64]struct{}, len(idsExact))
+	for _, id := range idsExact {
+		exact[id] = struct{}{}
+	}
+	return slicePage(rankNameHits(metas, text, exact, translit, typos), offset, limit), nil
+}
+
+func pathHits(paths []string) []Hit {
+	out := make([]Hit, len(paths))
+	for i, p := range paths {
+		out[i] = Hit{Path: p, Online: true}
+	}
+	return out
+}
+
+func metaHits(metas []FileMeta) []Hit {
+	out := make([]Hit, len(metas))
+	for i := range metas {
+		out[i] = Hit{Path: metas[i].Path, Online: metaOnline(&metas[i]), Meta: &metas[i]}
+	}
+	return out
 }
 
 // getSizeFilterIDs retrieves file IDs from filter index that match the size criteria
 func getSizeFilterIDs(sizeOp string, sizeBytes uint64) []uint64 {
-	fm, err := openMmapIndex(filterDictJSON(), filterPostingsDat(), filterPostingsIdx())
+	fm, err := openVolumeIndex(segFilter, "")
 	if err != nil {
 		return nil
 	}
@@ -108,11 +181,7 @@ func getSizeFilterIDs(sizeOp string, sizeBytes uint64) []uint64 {
 	buckets := getSizeBuckets(sizeOp, sizeBytes)
 	var result []uint64
 	for _, bucket := range buckets {
-		termID := fm.dict["size:"+bucket]
-		if termID > 0 {
-			postings, _ := fm.posting(termID)
-			result = unionSorted(result, postings)
-		}
+		result = unionSorted(result, fm.term("size:"+bucket))
 	}
 	return result
 }
@@ -163,9 +232,9 @@ func getSizeBuckets(op string, sizeBytes uint64) []string {
 	}
 }
 
-// mapIDsToPathsWithFilters maps file IDs to paths and applies path prefix and size filters
-func mapIDsToPathsWithFilters(finalIDs []uint64, isPathQuery bool, dirPrefix, boundary, parent string, sizeOp string, sizeBytes uint64) []string {
-	out := make([]string, 0, len(finalIDs))
+// mapIDsToMetasWithFilters loads file metas for IDs and applies path prefix, size and other filters
+func mapIDsToMetasWithFilters(finalIDs []uint64, isPathQuery bool, dirPrefix, boundary, parent string, sizeOp string, sizeBytes uint64, groups [][]compiledFilter) []FileMeta {
+	out := make([]FileMeta, 0, len(finalIDs))
 	for _, id := range finalIDs {
 		b, _ := pebGet(keyFileMeta(id))
 		if b == nil {
@@ -200,8 +269,12 @@ func mapIDsToPathsWithFilters(finalIDs []uint64, isPathQuery bool, dirPrefix, bo
 		if sizeOp != "" && !fileSizeMatches(m.Size, sizeOp, sizeBytes) {
 			continue
 		}
+		// Exact ext/type/mtime/kind checks (index buckets are coarse)
+		if !matchFilters(&m, groups) {
+			continue
+		}
 
-		out = append(out, m.Path)
+		out = append(out, m)
 	}
 	return out
 }
@@ -261,12 +334,12 @@ func searchIndexMaybeSetDirPrefix(boundary string, dirPrefix *string, sizeOp str
 	return *dirPrefix
 }
 
-func searchIndexOpenIndexes(isPathQuery bool) (nm, pm *mmapIndex, err error) {
+func searchIndexOpenIndexes(isPathQuery bool) (nm, pm *volumeIndex, err error) {
 	if isPathQuery {
-		pm, err = openMmapIndex(pathDictJSON(), pathPostingsDat(), pathPostingsIdx())
+		pm, err = openVolumeIndex(segPath, segPathFold)
 		return nil, pm, err
 	}
-	nm, err = openMmapIndex(nameDictJSON(), namePostingsDat(), namePostingsIdx())
+	nm, err = openVolumeIndex(segName, segNameFold)
 	return nm, nil, err
 }
 
@@ -277,7 +350,7 @@ func searchIndexTokenizeTerms(text, q string, isPathQuery bool) []string {
 	return tokenize(text)
 }
 
-func searchIndexCollectIDs(terms []string, isPathQuery bool, nm, pm *mmapIndex) []uint64 {
+func searchIndexCollectIDs(terms []string, isPathQuery bool, nm, pm *volumeIndex) []uint64 {
 	if len(terms) == 0 {
 		return nil
 	}
@@ -286,13 +359,9 @@ func searchIndexCollectIDs(terms []string, isPathQuery bool, nm, pm *mmapIndex)
 	for _, t := range terms {
 		var union []uint64
 		if isPathQuery {
-			pid := pm.dict[t]
-			ppl, _ := pm.posting(pid)
-			union = unionSorted(union, ppl)
+			union = unionSorted(union, pm.termPosting(t))
 		} else {
-			nid := nm.dict[t]
-			npl, _ := nm.posting(nid)
-			union = unionSorted(union, npl)
+			union = unionSorted(union, nm.termPosting(t))
 		}
 		sets = append(sets, termSet{ids: union})
 	}
@@ -316,7 +385,7 @@ func searchIndexMaybeFuzzy(idsExact []uint64, isPathQuery bool, limit int, q, te
 	}
 	finalIDs := idsExact
 	if isPathQuery {
-		fpm, err := openMmapIndex(pathNgramDictJSON(), pathNgramPostingsDat(), pathNgramPostingsIdx())
+		fpm, err := openVolumeIndex(segPathNgram, "")
 		if err != nil {
 			return finalIDs
 		}
@@ -328,8 +397,7 @@ func searchIndexMaybeFuzzy(idsExact []uint64, isPathQuery bool, limit int, q, te
 		type termSet struct{ ids []uint64 }
 		fsets := make([]termSet, 0, len(ngrams))
 		for _, ng := range ngrams {
-			pid := fpm.dict[ng]
-			ppl, _ := fpm.postingCapped(pid, 20000)
+			ppl := fpm.termCapped(ng, 20000)
 			if len(ppl) > 0 {
 				fsets = append(fsets, termSet{ids: ppl})
 			}
@@ -348,7 +416,7 @@ func searchIndexMaybeFuzzy(idsExact []uint64, isPathQuery bool, limit int, q, te
 		finalIDs = unionSorted(finalIDs, idsFuzzy)
 		return finalIDs
 	}
-	fnm, err := openMmapIndex(nameNgramDictJSON(), nameNgramPostingsDat(), nameNgramPostingsIdx())
+	fnm, err := openVolumeIndex(segNameNgram, "")
 	if err != nil {
 		return finalIDs
 	}
@@ -360,8 +428,7 @@ func searchIndexMaybeFuzzy(idsExact []uint64, isPathQuery bool, limit int, q, te
 	type termSet struct{ ids []uint64 }
 	fsets := make([]termSet, 0, len(ngrams))
 	for _, ng := range ngrams {
-		nid := fnm.dict[ng]
-		npl, _ := fnm.postingCapped(nid, 20000)
+		npl := fnm.termCapped(ng, 20000)
 		if len(npl) > 0 {
 			fsets = append(fsets, termSet{ids: npl})
 		}
@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strconv"
 	"testing"
+
+	"ismartcoding/plainnas/internal/pkg/pinyin"
 )
 
 type memPebble struct {
@@ -31,29 +35,58 @@ func (p *memPebble) setMeta(m FileMeta) {
 	p.m[string(keyFileMeta(m.FileID))] = b
 }
 
+// testVolume is the volume buildTestIndexes writes its segments to.
+const testVolume = "test"
+
 func buildTestIndexes(t *testing.T, tmpDir string, metas []FileMeta) {
 	t.Helper()
 	indexDirOverride = tmpDir
+	buildTestVolume(t, testVolume, metas)
+}
+
+// buildTestVolume writes the name, path, fold and pinyin segments of one volume.
+func buildTestVolume(t *testing.T, fsUUID string, metas []FileMeta) {
+	t.Helper()
 
 	names := make(termMap, 16)
 	paths := make(termMap, 16)
+	nameFolds := make(termMap, 16)
+	pathFolds := make(termMap, 16)
+	pinyins := make(termMap, 16)
 	for _, m := range metas {
+		for _, tok := range pinyin.Tokens(m.Name) {
+			pinyins[tok] = append(pinyins[tok], m.FileID)
+		}
 		for _, tok := range tokenize(m.Name) {
 			names[tok] = append(names[tok], m.FileID)
 		}
 		for _, tok := range tokenize(m.Path) {
 			paths[tok] = append(paths[tok], m.FileID)
 		}
+		for _, tok := range foldedTokens(tokenize(m.Name)) {
+			nameFolds[tok] = append(nameFolds[tok], m.FileID)
+		}
+		for _, tok := range foldedTokens(tokenize(m.Path)) {
+			pathFolds[tok] = append(pathFolds[tok], m.FileID)
+		}
 	}
 
-	if err := os.MkdirAll(tmpDir, 0o755); err != nil {
+	dir := volumeDir(fsUUID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
 		t.Fatalf("mkdir: %v", err)
 	}
-	if err := buildIndexFiles(names, nameDictJSON(), namePostingsDat(), namePostingsIdx()); err != nil {
-		t.Fatalf("build name index: %v", err)
+	for seg, terms := range map[string]termMap{
+		segName: names, segPath: paths, segNameFold: nameFolds, segPathFold: pathFolds, segNamePinyin: pinyins,
+	} {
+		buildTestSegment(t, dir, seg, terms)
 	}
-	if err := buildIndexFiles(paths, pathDictJSON(), pathPostingsDat(), pathPostingsIdx()); err != nil {
-		t.Fatalf("build path index: %v", err)
+}
+
+func buildTestSegment(t *testing.T, dir, seg string, terms termMap) {
+	t.Helper()
+	dictPath, datPath, idxPath := segmentFiles(dir, seg)
+	if err := buildIndexFiles(terms, dictPath, datPath, idxPath); err != nil {
+		t.Fatalf("build %s index: %v", seg, err)
 	}
 }
 
@@ -183,3 +216,267 @@ func contains(xs []string, s string) bool {
 	}
 	return false
 }
+
+func TestSearchIndexHits_RanksExactBeforePrefixBeforeToken(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+	}()
+	pebGet = p.get
+
+	metas := []FileMeta{
+		{FileID: 1, Path: "/d/a/b/c/old report.txt", Name: "old report.txt"},
+		{FileID: 2, Path: "/d/a/b/c/report-2024.pdf", Name: "report-2024.pdf"},
+		{FileID: 3, Path: "/d/a/b/c/d/e/Report.pdf", Name: "Report.pdf"},
+	}
+	for _, m := range metas {
+		p.setPathToID(m.Path, m.FileID)
+		p.setMeta(m)
+	}
+	buildTestIndexes(t, filepath.Join(tmpDir, "searchidx"), metas)
+
+	hits, err := SearchIndexHits("report", "", 0, 50, "", 0, nil)
+	if err != nil {
+		t.Fatalf("SearchIndexHits: %v", err)
+	}
+	got := make([]string, len(hits))
+	for i, h := range hits {
+		got[i] = h.Path
+	}
+	want := []string{metas[2].Path, metas[1].Path, metas[0].Path}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(hits[2].NameMatches, []MatchRange{{Start: 4, Length: 6}}) {
+		t.Fatalf("unexpected name matches %+v", hits[2].NameMatches)
+	}
+}
+
+func TestSearchIndexHits_ToleratesTypos(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+	}()
+	pebGet = p.get
+
+	metas := []FileMeta{
+		{FileID: 1, Path: "/d/vacation-2023.jpg", Name: "vacation-2023.jpg"},
+		{FileID: 2, Path: "/d/vacancy.txt", Name: "vacancy.txt"},
+		{FileID: 3, Path: "/d/vacaton.txt", Name: "vacaton.txt"},
+	}
+	for _, m := range metas {
+		p.setPathToID(m.Path, m.FileID)
+		p.setMeta(m)
+	}
+	buildTestIndexes(t, filepath.Join(tmpDir, "searchidx"), metas)
+
+	// The exact match ranks first, the one-edit match after it; vacancy is three edits away.
+	got, err := SearchIndex("vacaton", "", 0, 50, "", 0)
+	if err != nil {
+		t.Fatalf("SearchIndex: %v", err)
+	}
+	want := []string{metas[2].Path, metas[0].Path}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	// Digits must match exactly.
+	got, err = SearchIndex("vacation 2022", "", 0, 50, "", 0)
+	if err != nil {
+		t.Fatalf("SearchIndex: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no match for a different year, got %v", got)
+	}
+}
+
+func TestTokenize_Unicode(t *testing.T) {
+	cases := map[string][]string{
+		"Café Menu.pdf":            {"café", "menu", "pdf"},
+		"Cafe\u0301.txt":           {"café", "txt"},
+		"Привет мир.DOCX":          {"привет", "мир", "docx"},
+		"Straße_ΣΟΦΙΑ-2024":        {"strasse", "σοφια", "2024"},
+		"Don't Stop (Live).mp3":    {"don't", "stop", "live", "mp3"},
+		"東京 trip 한국어.jpg":          {"trip", "한국어", "jpg"},
+		"report_v2.final (1).xlsx": {"report", "v2", "final", "1", "xlsx"},
+	}
+	for in, want := range cases {
+		if got := tokenize(in); !reflect.DeepEqual(got, want) {
+			t.Errorf("tokenize(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if got := foldedTokens(tokenize("Café Ångström résumé")); !reflect.DeepEqual(got, []string{"cafe", "angstrom", "resume"}) {
+		t.Errorf("foldedTokens = %q", got)
+	}
+}
+
+func TestSearchIndexHits_IgnoresDiacriticsAndNormalization(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+	}()
+	pebGet = p.get
+
+	// File 1 was created on macOS and has a decomposed (NFD) name.
+	metas := []FileMeta{
+		{FileID: 1, Path: "/d/Cafe\u0301 Paris.jpg", Name: "Cafe\u0301 Paris.jpg"},
+		{FileID: 2, Path: "/d/cafe.txt", Name: "cafe.txt"},
+		{FileID: 3, Path: "/d/Привет.txt", Name: "Привет.txt"},
+	}
+	for _, m := range metas {
+		p.setPathToID(m.Path, m.FileID)
+		p.setMeta(m)
+	}
+	buildTestIndexes(t, filepath.Join(tmpDir, "searchidx"), metas)
+
+	for _, q := range []string{"café", "cafe", "CAFÉ"} {
+		hits, err := SearchIndexHits(q, "", 0, 50, "", 0, nil)
+		if err != nil {
+			t.Fatalf("SearchIndexHits: %v", err)
+		}
+		if len(hits) != 2 {
+			t.Fatalf("%q: expected both cafe files, got %+v", q, hits)
+		}
+		for _, h := range hits {
+			if h.Path == metas[0].Path && !reflect.DeepEqual(h.NameMatches, []MatchRange{{Start: 0, Length: 5}}) {
+				t.Fatalf("%q: unexpected name matches %+v", q, h.NameMatches)
+			}
+		}
+	}
+
+	got, err := SearchIndex("привет", "", 0, 50, "", 0)
+	if err != nil {
+		t.Fatalf("SearchIndex: %v", err)
+	}
+	if len(got) != 1 || got[0] != metas[2].Path {
+		t.Fatalf("expected %q, got %v", metas[2].Path, got)
+	}
+}
+
+func TestSearchIndexHits_MatchesPinyin(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+	}()
+	pebGet = p.get
+
+	metas := []FileMeta{
+		{FileID: 1, Path: "/music/周杰伦 - 稻香.mp3", Name: "周杰伦 - 稻香.mp3"},
+		{FileID: 2, Path: "/music/a/b/zjl live.mp3", Name: "zjl live.mp3"},
+		{FileID: 3, Path: "/music/林俊杰.mp3", Name: "林俊杰.mp3"},
+	}
+	for _, m := range metas {
+		p.setPathToID(m.Path, m.FileID)
+		p.setMeta(m)
+	}
+	buildTestIndexes(t, filepath.Join(tmpDir, "searchidx"), metas)
+
+	// Direct matches rank above transliterated ones, even when deeper in the tree.
+	hits, err := SearchIndexHits("zjl", "", 0, 50, "", 0, nil)
+	if err != nil {
+		t.Fatalf("SearchIndexHits: %v", err)
+	}
+	got := make([]string, len(hits))
+	for i, h := range hits {
+		got[i] = h.Path
+	}
+	if want := []string{metas[1].Path, metas[0].Path}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for _, q := range []string{"zhoujielun", "zhou jie lun", "daoxiang"} {
+		got, err := SearchIndex(q, "", 0, 50, "", 0)
+		if err != nil {
+			t.Fatalf("SearchIndex: %v", err)
+		}
+		if len(got) != 1 || got[0] != metas[0].Path {
+			t.Fatalf("%q: expected %q, got %v", q, metas[0].Path, got)
+		}
+	}
+}
+
+func TestToUTF16Ranges_CountsSurrogatePairs(t *testing.T) {
+	s := "😀 résumé"
+	got := toUTF16Ranges(s, findFolded(s, []string{"sum"}))
+	// 😀 is two UTF-16 units, "é" one.
+	if !reflect.DeepEqual(got, []MatchRange{{Start: 5, Length: 3}}) {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestIndexBuilder_SpilledRunsMergeLikeInMemoryBuild(t *testing.T) {
+	oldIdx := indexDirOverride
+	oldBudget := indexMemoryBudget
+	t.Cleanup(func() {
+		indexDirOverride = oldIdx
+		indexMemoryBudget = oldBudget
+	})
+	indexDirOverride = t.TempDir()
+	// Small enough to spill every few postings.
+	indexMemoryBudget = func() int64 { return 512 }
+
+	ib, err := newIndexBuilder()
+	if err != nil {
+		t.Fatalf("new builder: %v", err)
+	}
+	dictPath, datPath, idxPath := segmentFiles(indexDir(), segName)
+	seg := ib.segment(dictPath, datPath, idxPath)
+	want := make(termMap)
+	words := []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+	for id := uint64(1); id <= 200; id++ {
+		for i, w := range words {
+			if id%uint64(i+1) != 0 {
+				continue
+			}
+			if err := ib.add(seg, w, id*7919%1000); err != nil {
+				t.Fatalf("add: %v", err)
+			}
+			want[w] = append(want[w], id*7919%1000)
+		}
+	}
+	if ib.runs < 2 {
+		t.Fatalf("expected spills, got %d runs", ib.runs)
+	}
+	if err := ib.finish(); err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+	if dirs, _ := filepath.Glob(filepath.Join(indexDir(), buildDirPattern)); len(dirs) != 0 {
+		t.Fatalf("scratch dirs left behind: %v", dirs)
+	}
+
+	m, err := openMmapIndex(dictPath, datPath, idxPath)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer m.close()
+	if len(m.dict) != len(words) {
+		t.Fatalf("dict size: got %d want %d", len(m.dict), len(words))
+	}
+	for _, w := range words {
+		got, err := m.posting(m.dict[w])
+		if err != nil {
+			t.Fatalf("posting %s: %v", w, err)
+		}
+		ids := want[w]
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+		if !reflect.DeepEqual(got, ids) {
+			t.Fatalf("posting %s: got %v want %v", w, got, ids)
+		}
+	}
+}
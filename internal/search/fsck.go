@@ -0,0 +1,78 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"ismartcoding/plainnas/internal/db"
+)
+
+// CheckCatalog cross-checks the file metadata (f:) with the disk, the path keys (p:) and the
+// extracted contents (ct:). Entries of files gone from a mounted volume are removed; the index
+// segments still list them until the volume is re-indexed, and search drops hits without
+// metadata.
+func CheckCatalog(r *db.FsckReport) error {
+	const check = "files"
+	peb := db.GetDefault()
+	paths := map[uint64]string{}
+
+	if err := peb.Iterate([]byte("f:"), func(key []byte, value []byte) error {
+		r.Entry()
+		k := string(key)
+		var m FileMeta
+		if err := json.Unmarshal(value, &m); err != nil || k != string(keyFileMeta(m.FileID)) {
+			r.Report(check, k, "corrupt record", func() error { return peb.Delete([]byte(k)) })
+			return nil
+		}
+		paths[m.FileID] = m.Path
+		pk := keyPathToID(m.Path)
+		if metaOnline(&m) {
+			if _, err := os.Lstat(m.Path); os.IsNotExist(err) {
+				id := m.FileID
+				r.Report(check, k, "file missing on disk", func() error {
+					delete(paths, id)
+					if v, _ := peb.Get(pk); string(v) == strconv.FormatUint(id, 10) {
+						_ = peb.Delete(pk)
+					}
+					return peb.Delete([]byte(k))
+				})
+				return nil
+			}
+		}
+		v, _ := peb.Get(pk)
+		if v == nil {
+			id := m.FileID
+			r.Report(check, string(pk), "missing path key", func() error { return peb.Set(pk, []byte(fmt.Sprintf("%d", id)), nil) })
+		} else if string(v) != strconv.FormatUint(m.FileID, 10) {
+			r.Report(check, string(pk), "path key owned by another record", nil)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := peb.Iterate([]byte("p:"), func(key []byte, value []byte) error {
+		r.Entry()
+		k := string(key)
+		id, err := strconv.ParseUint(string(value), 10, 64)
+		if p, ok := paths[id]; err != nil || !ok || k != string(keyPathToID(p)) {
+			r.Report(check, k, "dangling path key", func() error { return peb.Delete([]byte(k)) })
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return peb.Iterate([]byte("ct:"), func(key []byte, _ []byte) error {
+		r.Entry()
+		k := string(key)
+		id, err := strconv.ParseUint(strings.TrimPrefix(k, "ct:"), 10, 64)
+		if _, ok := paths[id]; err != nil || !ok {
+			r.Report(check, k, "dangling content", func() error { return peb.Delete([]byte(k)) })
+		}
+		return nil
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 
 	"golang.org/x/sys/unix"
@@ -14,10 +15,19 @@ type mmapIndex struct {
 	dict map[string]uint32
 	dat  []byte
 	idx  []byte
+	// dictPath and dictVersion (size and mtime of the dict file) key the cached typo tree
+	dictPath    string
+	dictVersion string
+	// fold is the diacritic-folded segment of a name or path index, if opened
+	fold *mmapIndex
 }
 
 func openMmapIndex(dictPath, datPath, idxPath string) (*mmapIndex, error) {
 	// load dict
+	dst, err := os.Stat(dictPath)
+	if err != nil {
+		return nil, err
+	}
 	b, err := os.ReadFile(dictPath)
 	if err != nil {
 		return nil, err
@@ -57,7 +67,8 @@ func openMmapIndex(dictPath, datPath, idxPath string) (*mmapIndex, error) {
 		_ = unix.Munmap(dat)
 		return nil, err
 	}
-	return &mmapIndex{dict: dict, dat: dat, idx: idx}, nil
+	version := fmt.Sprintf("%d:%d", dst.Size(), dst.ModTime().UnixNano())
+	return &mmapIndex{dict: dict, dat: dat, idx: idx, dictPath: dictPath, dictVersion: version}, nil
 }
 
 func (m *mmapIndex) close() {
@@ -70,6 +81,23 @@ func (m *mmapIndex) close() {
 	if m.idx != nil {
 		_ = unix.Munmap(m.idx)
 	}
+	m.fold.close()
+}
+
+// termPosting returns the documents containing token t. With a fold segment attached it also
+// returns documents whose token differs from t only in diacritics.
+func (m *mmapIndex) termPosting(t string) []uint64 {
+	ids, _ := m.posting(m.dict[t])
+	if m.fold == nil {
+		return ids
+	}
+	f := foldDiacritics(t)
+	if f != t {
+		plain, _ := m.posting(m.dict[f])
+		ids = unionSorted(ids, plain)
+	}
+	folded, _ := m.fold.posting(m.fold.dict[f])
+	return unionSorted(ids, folded)
 }
 
 // read posting list for a term id
@@ -142,3 +170,39 @@ func (m *mmapIndex) postingCapped(termID uint32, capN int) ([]uint64, error) {
 	}
 	return out, nil
 }
+
+// postingEntries reads a posting list written by buildPayloadIndexFiles
+func (m *mmapIndex) postingEntries(termID uint32) ([]docEntry, error) {
+	if termID == 0 {
+		return nil, nil
+	}
+	off := int64((termID - 1) * 12)
+	if off+12 > int64(len(m.idx)) {
+		return nil, nil
+	}
+	o := binary.LittleEndian.Uint64(m.idx[off : off+8])
+	l := binary.LittleEndian.Uint32(m.idx[off+8 : off+12])
+	p := int(o)
+	end := p + int(l)
+	docCount, n := binary.Uvarint(m.dat[p:end])
+	if n <= 0 {
+		return nil, errors.New("uvarint decode error")
+	}
+	p += n
+	out := make([]docEntry, 0, docCount)
+	var last uint64
+	for i := 0; i < int(docCount) && p < end; i++ {
+		var vals [3]uint64
+		for k := range vals {
+			v, n2 := binary.Uvarint(m.dat[p:end])
+			if n2 <= 0 {
+				return nil, errors.New("uvarint decode error")
+			}
+			p += n2
+			vals[k] = v
+		}
+		last += vals[0]
+		out = append(out, docEntry{id: last, tf: uint32(vals[1]), dl: uint32(vals[2])})
+	}
+	return out, nil
+}
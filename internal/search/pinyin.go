@@ -0,0 +1,66 @@
+package search
+
+import (
+	"sort"
+
+	"ismartcoding/plainnas/internal/config"
+)
+
+// Pinyin transliteration of Chinese file names (see pinyin.Tokens) is kept in the name_pinyin
+// segment of each volume, so names can be found without an IME: "zhoujielun" or "zjl" for 周杰伦.
+
+func pinyinEnabled() bool {
+	return config.GetDefault().GetString("search.pinyin") != "false"
+}
+
+// pinyinSegmentOK reports whether the volume in dir has the pinyin segment when enabled.
+// Enabling pinyin therefore makes VolumeIndexExists fail and triggers a rebuild.
+func pinyinSegmentOK(dir string) bool {
+	return !pinyinEnabled() || segmentExists(dir, segNamePinyin)
+}
+
+// pinyinMatches returns the files whose name transliterates to every query term. Only
+// all-ASCII-letter queries are looked up; anything else is matched on the characters directly.
+func pinyinMatches(terms []string) map[uint64]struct{} {
+	if len(terms) == 0 || !pinyinEnabled() {
+		return nil
+	}
+	for _, t := range terms {
+		for i := 0; i < len(t); i++ {
+			if t[i] < 'a' || t[i] > 'z' {
+				return nil
+			}
+		}
+	}
+	pm, err := openVolumeIndex(segNamePinyin, "")
+	if err != nil {
+		return nil
+	}
+	defer pm.close()
+	var ids []uint64
+	for i, t := range terms {
+		pl := pm.term(t)
+		if i == 0 {
+			ids = pl
+		} else {
+			ids = intersectSorted(ids, pl)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+	}
+	out := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		out[id] = struct{}{}
+	}
+	return out
+}
+
+func sortedSet(m map[uint64]struct{}) []uint64 {
+	out := make([]uint64, 0, len(m))
+	for id := range m {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
@@ -0,0 +1,201 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Score tiers for name matches. Boosts stay below the tier gap, so a better match kind always
+// ranks first and recency/depth only order results within a tier. Pinyin matches rank just below
+// direct character matches. Typo matches lose typoPenalty per edit, so they rank between token
+// and ngram matches and never above an exact token match.
+const (
+	scoreExact  = 4000
+	scorePrefix = 3000
+	scoreToken  = 2000
+	scorePinyin = 1900
+	scoreTypo   = 1800
+	scoreNgram  = 1000
+	typoPenalty = 200
+
+	recencyBoost    = 300
+	recencyHalfDays = 30
+	depthBoost      = 200
+	bm25K1          = 1.2
+	bm25B           = 0.75
+)
+
+// MatchRange marks a match inside a string, in UTF-16 code units so it can be applied to
+// JavaScript strings directly.
+type MatchRange struct {
+	Start  int
+	Length int
+}
+
+// Hit is a ranked file search result.
+type Hit struct {
+	Path  string
+	Score float64
+	// NameMatches highlight the query inside the base name of Path.
+	NameMatches []MatchRange
+	// Online is false for files of a volume that is not mounted; Meta then describes the file
+	// as it was last indexed.
+	Online bool
+	// Meta is the indexed metadata; nil for hits resolved on the filesystem.
+	Meta *FileMeta
+}
+
+// nameScore ranks a name match: exact name > prefix > token > pinyin > typo > ngram, boosted by
+// recency and shallow depth. typoDist is the summed edit distance of a typo match, 0 otherwise.
+func nameScore(m *FileMeta, query string, tokenMatch, pinyinMatch bool, typoDist int, now time.Time) float64 {
+	name := normalizeText(m.Name)
+	stem := strings.TrimSuffix(name, normalizeText(nameExt(m.Name)))
+	var score float64
+	switch {
+	case name == query || stem == query:
+		score = scoreExact
+	case strings.HasPrefix(name, query):
+		score = scorePrefix
+	case tokenMatch:
+		score = scoreToken
+	case pinyinMatch:
+		score = scorePinyin
+	case typoDist > 0:
+		score = math.Max(scoreTypo-typoPenalty*float64(typoDist), scoreNgram)
+	default:
+		score = scoreNgram
+	}
+	if m.MTime > 0 {
+		ageDays := now.Sub(time.Unix(m.MTime, 0)).Hours() / 24
+		if ageDays < 0 {
+			ageDays = 0
+		}
+		score += recencyBoost / (1 + ageDays/recencyHalfDays)
+	}
+	depth := strings.Count(strings.Trim(m.Path, "/"), "/")
+	score += depthBoost / float64(1+depth)
+	return score
+}
+
+func nameExt(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i > 0 {
+		return name[i:]
+	}
+	return ""
+}
+
+// rankNameHits scores metas for a name query and sorts them best first.
+func rankNameHits(metas []FileMeta, text string, exact, translit map[uint64]struct{}, typos map[uint64]int) []Hit {
+	now := time.Now()
+	query := normalizeText(strings.TrimSpace(text))
+	tokens := tokenize(text)
+	ngrams := buildQueryNgrams(text)
+	hits := make([]Hit, len(metas))
+	for i := range metas {
+		_, tokenMatch := exact[metas[i].FileID]
+		_, pinyinMatch := translit[metas[i].FileID]
+		hits[i] = Hit{
+			Path:        metas[i].Path,
+			Score:       nameScore(&metas[i], query, tokenMatch, pinyinMatch, typos[metas[i].FileID], now),
+			NameMatches: nameMatchRanges(metas[i].Name, text, tokens, ngrams),
+			Online:      metaOnline(&metas[i]),
+			Meta:        &metas[i],
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+	return hits
+}
+
+// nameMatchRanges highlights the query in name, ignoring case and diacritics: the whole query
+// for exact/prefix matches, otherwise each token, falling back to ngrams for fuzzy and CJK matches.
+func nameMatchRanges(name, text string, tokens, ngrams []string) []MatchRange {
+	ft := foldForMatch(name)
+	query := foldDiacritics(normalizeText(strings.TrimSpace(text)))
+	var ranges [][2]int
+	if query != "" && strings.HasPrefix(ft.text, query) {
+		ranges = ft.original([][2]int{{0, len(query)}})
+	}
+	if len(ranges) == 0 {
+		ranges = findFolded(name, tokens)
+	}
+	if len(ranges) == 0 {
+		ranges = findFolded(name, ngrams)
+	}
+	return toUTF16Ranges(name, ranges)
+}
+
+// findAll returns the byte ranges of every occurrence of the needles in s.
+func findAll(s string, needles []string) [][2]int {
+	var out [][2]int
+	for _, n := range needles {
+		if n == "" {
+			continue
+		}
+		for from := 0; from < len(s); {
+			i := strings.Index(s[from:], n)
+			if i < 0 {
+				break
+			}
+			start := from + i
+			out = append(out, [2]int{start, start + len(n)})
+			from = start + len(n)
+		}
+	}
+	return out
+}
+
+// toUTF16Ranges merges overlapping byte ranges of s and converts them to UTF-16 offsets.
+func toUTF16Ranges(s string, ranges [][2]int) []MatchRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1] {
+			if r[1] > last[1] {
+				last[1] = r[1]
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	out := make([]MatchRange, 0, len(merged))
+	for _, r := range merged {
+		start := utf16Len(s[:r[0]])
+		out = append(out, MatchRange{Start: start, Length: utf16Len(s[r[0]:r[1]])})
+	}
+	return out
+}
+
+func utf16Len(s string) int {
+	n := 0
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		n += utf16.RuneLen(r)
+		s = s[size:]
+	}
+	return n
+}
+
+// bm25 scores one document for the query terms given their postings entries for it.
+func bm25(entries []docEntry, dfs []int, st contentStats) float64 {
+	n := float64(st.Docs)
+	avg := st.AvgLen
+	if avg <= 0 {
+		avg = 1
+	}
+	var score float64
+	for i, e := range entries {
+		df := float64(dfs[i])
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		tf := float64(e.tf)
+		score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*float64(e.dl)/avg))
+	}
+	return score
+}
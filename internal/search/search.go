@@ -6,6 +6,8 @@ type FilterField struct {
 	Name  string
 	Op    string
 	Value string
+	// Or joins this field to the previous one ("ext:jpg OR ext:png") instead of AND.
+	Or bool
 }
 
 type queryGroup struct {
@@ -16,7 +18,10 @@ type queryGroup struct {
 	value  string
 }
 
-const notType = "NOT"
+const (
+	notType = "NOT"
+	orType  = "OR"
+)
 
 var invert = map[string]string{
 	"=":   "!=",
@@ -130,10 +135,78 @@ func splitGroup(q string) queryGroup {
 	return queryGroup{length: len(parts), field: field, query: query, op: typ, value: value}
 }
 
+// inlineOpFields may be written without a colon, e.g. "modified>=2024-01-01".
+var inlineOpFields = []string{"modified", "file_size"}
+
+// splitInlineOp rewrites "modified>=2024-01-01" to "modified:>=2024-01-01".
+func splitInlineOp(group string) (string, bool) {
+	for _, name := range inlineOpFields {
+		rest, ok := strings.CutPrefix(group, name)
+		if !ok || rest == "" {
+			continue
+		}
+		if strings.IndexAny(rest[:1], "<>=!") == 0 {
+			return name + ":" + rest, true
+		}
+	}
+	return group, false
+}
+
+// isFieldGroup reports whether group is a "field:value" (or inline operator) term.
+func isFieldGroup(group string) bool {
+	if _, ok := splitInlineOp(group); ok {
+		return true
+	}
+	name, _, ok := strings.Cut(group, ":")
+	if !ok || name == "" {
+		return false
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// stripOrParens removes the parentheses around OR alternatives: "(ext:jpg OR ext:png)".
+// Parentheses that do not enclose an OR are left alone, since file names often contain them.
+func stripOrParens(groups []string) []string {
+	for i := 0; i < len(groups); i++ {
+		if !strings.HasPrefix(groups[i], "(") {
+			continue
+		}
+		for j := i; j < len(groups); j++ {
+			if !strings.HasSuffix(groups[j], ")") || (j == i && len(groups[i]) < 2) {
+				continue
+			}
+			hasOr := false
+			for k := i + 1; k < j; k++ {
+				if groups[k] == orType {
+					hasOr = true
+				}
+			}
+			if hasOr {
+				groups[j] = strings.TrimSuffix(groups[j], ")")
+				groups[i] = strings.TrimPrefix(groups[i], "(")
+			}
+			break
+		}
+	}
+	out := groups[:0]
+	for _, g := range groups {
+		if g != "" {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
 func parseGroup(group string) FilterField {
-	if group == notType {
-		return FilterField{Op: notType}
+	if group == notType || group == orType {
+		return FilterField{Op: group}
 	}
+	group, _ = splitInlineOp(group)
 	parts := splitGroup(group)
 	if parts.field == "is" {
 		return FilterField{Name: parts.query, Op: "", Value: "true"}
@@ -148,29 +221,48 @@ func Parse(q string) []FilterField {
 	if strings.TrimSpace(q) == "" {
 		return []FilterField{}
 	}
-	groups := splitInGroup(q)
+	groups := stripOrParens(splitInGroup(q))
 	fields := make([]FilterField, 0, len(groups))
 	for _, g := range groups {
+		// "-ext:jpg" is shorthand for "NOT ext:jpg"; a leading dash on plain words is kept.
+		if len(g) > 1 && g[0] == '-' && isFieldGroup(g[1:]) {
+			fields = append(fields, FilterField{Op: notType})
+			g = g[1:]
+		}
 		fields = append(fields, parseGroup(g))
 	}
 	invertNext := false
+	orNext := false
+	hasPrev := false
 	for i := range fields {
-		if fields[i].Op == notType {
+		switch fields[i].Op {
+		case notType:
 			invertNext = true
 			continue
+		case orType:
+			orNext = hasPrev
+			continue
 		}
 		if invertNext {
 			if v, ok := invert[fields[i].Op]; ok {
 				fields[i].Op = v
+			} else if fields[i].Name == "text" {
+				fields[i].Op = "!="
+			} else if fields[i].Op == "" && fields[i].Value == "true" {
+				// is:dir -> is not a dir
+				fields[i].Value = "false"
 			} else {
 				fields[i].Op = ""
 			}
 			invertNext = false
 		}
+		fields[i].Or = orNext
+		orNext = false
+		hasPrev = true
 	}
 	out := fields[:0]
 	for _, f := range fields {
-		if f.Op != notType {
+		if f.Op != notType && f.Op != orType {
 			out = append(out, f)
 		}
 	}
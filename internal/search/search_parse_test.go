@@ -41,3 +41,51 @@ func TestParse_FileSize(t *testing.T) {
 		}
 	}
 }
+
+func TestParse_BooleanAndInlineOperators(t *testing.T) {
+	cases := []struct {
+		q      string
+		expect []FilterField
+	}{
+		{
+			q: "ext:jpg OR ext:png",
+			expect: []FilterField{
+				{Name: "ext", Op: "=", Value: "jpg"},
+				{Name: "ext", Op: "=", Value: "png", Or: true},
+			},
+		},
+		{
+			q: "(type:image OR type:video) modified>=2024-01-01",
+			expect: []FilterField{
+				{Name: "type", Op: "=", Value: "image"},
+				{Name: "type", Op: "=", Value: "video", Or: true},
+				{Name: "modified", Op: ">=", Value: "2024-01-01"},
+			},
+		},
+		{
+			q: "-ext:tmp NOT is:dir NOT draft",
+			expect: []FilterField{
+				{Name: "ext", Op: "!=", Value: "tmp"},
+				{Name: "dir", Op: "", Value: "false"},
+				{Name: "text", Op: "!=", Value: "draft"},
+			},
+		},
+		{
+			q: "-draft (1)",
+			expect: []FilterField{
+				{Name: "text", Op: "", Value: "-draft"},
+				{Name: "text", Op: "", Value: "(1)"},
+			},
+		},
+		{
+			q:      "OR modified<7d",
+			expect: []FilterField{{Name: "modified", Op: "<", Value: "7d"}},
+		},
+	}
+	for _, c := range cases {
+		got := Parse(c.q)
+		if !reflect.DeepEqual(got, c.expect) {
+			t.Errorf("Parse(%q) got %+v, want %+v", c.q, got, c.expect)
+		}
+	}
+}
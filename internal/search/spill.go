@@ -0,0 +1,302 @@
+package search
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"ismartcoding/plainnas/internal/config"
+)
+
+const (
+	// defaultIndexMemoryBudget bounds the postings held in RAM while building the file index.
+	defaultIndexMemoryBudget = 256 << 20
+	// Rough per-entry costs used to account for the budget: an id in a growing slice
+	// (including append slack) and a new term (map entry, slice header, string).
+	postingEntryBytes = 16
+	termEntryBytes    = 96
+)
+
+// indexMemoryBudget returns the RAM budget in bytes for an index build (search.index_memory_mb).
+var indexMemoryBudget = func() int64 {
+	if n := config.GetDefault().GetInt("search.index_memory_mb"); n > 0 {
+		return int64(n) << 20
+	}
+	return defaultIndexMemoryBudget
+}
+
+// buildDirPattern names the scratch directories holding sorted runs while an index is built.
+const buildDirPattern = ".build-*"
+
+// removeStaleBuildDirs deletes scratch directories left behind by an interrupted build.
+func removeStaleBuildDirs() {
+	dirs, _ := filepath.Glob(filepath.Join(indexDir(), buildDirPattern))
+	for _, d := range dirs {
+		_ = os.RemoveAll(d)
+	}
+}
+
+// indexBuilder accumulates postings for one or more segments within a memory budget. Once the
+// budget is exceeded, every segment writes its postings as a sorted run file to a scratch
+// directory under the index directory and starts over; finish k-way merges the runs with what
+// is left in memory into the final segment files.
+type indexBuilder struct {
+	dir    string
+	budget int64
+	used   int64
+	runs   int
+	segs   []*builderSegment
+	// onSpill is called after each spill with the total number of runs written so far.
+	onSpill func(runs int)
+}
+
+type builderSegment struct {
+	terms                      termMap
+	runs                       []string
+	dictPath, datPath, idxPath string
+}
+
+func newIndexBuilder() (*indexBuilder, error) {
+	if err := os.MkdirAll(indexDir(), 0o755); err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp(indexDir(), buildDirPattern)
+	if err != nil {
+		return nil, err
+	}
+	return &indexBuilder{dir: dir, budget: indexMemoryBudget()}, nil
+}
+
+// segment registers a segment written to the given files by finish.
+func (b *indexBuilder) segment(dictPath, datPath, idxPath string) *builderSegment {
+	s := &builderSegment{terms: make(termMap), dictPath: dictPath, datPath: datPath, idxPath: idxPath}
+	b.segs = append(b.segs, s)
+	return s
+}
+
+// add appends id to the posting list of term in s, spilling all segments when over budget.
+func (b *indexBuilder) add(s *builderSegment, term string, id uint64) error {
+	ids, ok := s.terms[term]
+	if !ok {
+		b.used += termEntryBytes + int64(len(term))
+	}
+	s.terms[term] = append(ids, id)
+	b.used += postingEntryBytes
+	if b.used > b.budget {
+		return b.spill()
+	}
+	return nil
+}
+
+func (b *indexBuilder) spill() error {
+	for i, s := range b.segs {
+		if len(s.terms) == 0 {
+			continue
+		}
+		path := filepath.Join(b.dir, "run-"+strconv.Itoa(i)+"-"+strconv.Itoa(len(s.runs)))
+		if err := writeRun(path, s.terms); err != nil {
+			return err
+		}
+		s.runs = append(s.runs, path)
+		s.terms = make(termMap)
+		b.runs++
+	}
+	b.used = 0
+	if b.onSpill != nil {
+		b.onSpill(b.runs)
+	}
+	return nil
+}
+
+// finish writes every segment and removes the scratch directory. Segments that never spilled are
+// written straight from memory.
+func (b *indexBuilder) finish() error {
+	defer b.close()
+	for _, s := range b.segs {
+		if len(s.runs) == 0 {
+			if err := buildIndexFiles(s.terms, s.dictPath, s.datPath, s.idxPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(s.terms) > 0 {
+			path := filepath.Join(b.dir, "run-tail-"+strconv.Itoa(len(s.runs))+"-"+filepath.Base(s.datPath))
+			if err := writeRun(path, s.terms); err != nil {
+				return err
+			}
+			s.runs = append(s.runs, path)
+			s.terms = nil
+		}
+		if err := mergeRuns(s.runs, s.dictPath, s.datPath, s.idxPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close drops the scratch directory; it is safe to call more than once.
+func (b *indexBuilder) close() {
+	if b.dir != "" {
+		_ = os.RemoveAll(b.dir)
+		b.dir = ""
+	}
+}
+
+// writeRun writes terms in sorted order as records of
+// uvarint(len(term)) term uvarint(count) uvarint(delta id)...
+func writeRun(path string, terms termMap) error {
+	keys := make([]string, 0, len(terms))
+	for k := range terms {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriterSize(f, 1<<20)
+	for _, k := range keys {
+		if _, err := writeUvarint(w, uint64(len(k))); err != nil {
+			return err
+		}
+		if _, err := w.WriteString(k); err != nil {
+			return err
+		}
+		ids := terms[k]
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+		if _, err := writePostingIDs(w, ids); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// runReader streams the records of a run file.
+type runReader struct {
+	f    *os.File
+	r    *bufio.Reader
+	term string
+	ids  []uint64
+}
+
+func openRun(path string) (*runReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &runReader{f: f, r: bufio.NewReaderSize(f, 256<<10)}, nil
+}
+
+// next loads the next record, returning io.EOF after the last one.
+func (rr *runReader) next() error {
+	n, err := binary.ReadUvarint(rr.r)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(rr.r, buf); err != nil {
+		return unexpectedEOF(err)
+	}
+	rr.term = string(buf)
+	count, err := binary.ReadUvarint(rr.r)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	rr.ids = rr.ids[:0]
+	var last uint64
+	for i := uint64(0); i < count; i++ {
+		d, err := binary.ReadUvarint(rr.r)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		last += d
+		rr.ids = append(rr.ids, last)
+	}
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// runHeap orders run readers by their current term.
+type runHeap []*runReader
+
+func (h runHeap) Len() int           { return len(h) }
+func (h runHeap) Less(i, j int) bool { return h[i].term < h[j].term }
+func (h runHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *runHeap) Push(x any)        { *h = append(*h, x.(*runReader)) }
+func (h *runHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// mergeRuns k-way merges sorted runs into segment files. Only the posting list of the term being
+// written is held in memory.
+func mergeRuns(paths []string, dictPath, datPath, idxPath string) error {
+	h := make(runHeap, 0, len(paths))
+	defer func() {
+		for _, rr := range h {
+			rr.f.Close()
+		}
+	}()
+	for _, p := range paths {
+		rr, err := openRun(p)
+		if err != nil {
+			return err
+		}
+		if err := rr.next(); err != nil {
+			rr.f.Close()
+			if err == io.EOF {
+				continue
+			}
+			return err
+		}
+		h = append(h, rr)
+	}
+	heap.Init(&h)
+
+	pw, err := newPostingWriter(dictPath, datPath, idxPath)
+	if err != nil {
+		return err
+	}
+	defer pw.abort()
+	var ids []uint64
+	for len(h) > 0 {
+		term := h[0].term
+		ids = ids[:0]
+		for len(h) > 0 && h[0].term == term {
+			rr := h[0]
+			ids = append(ids, rr.ids...)
+			if err := rr.next(); err != nil {
+				if err != io.EOF {
+					return err
+				}
+				rr.f.Close()
+				heap.Pop(&h)
+				continue
+			}
+			heap.Fix(&h, 0)
+		}
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+		if err := pw.add(term, func(w *bufio.Writer) (int, error) { return writePostingIDs(w, ids) }); err != nil {
+			return err
+		}
+	}
+	return pw.close()
+}
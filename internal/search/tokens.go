@@ -2,40 +2,125 @@ package search
 
 import (
 	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
-// tokenize lowers ASCII and splits on separators.
+// tokenize normalizes s (see normalizeText) and splits it into words.
 func tokenize(s string) []string {
-	s = strings.ToLower(s)
-	repl := func(r rune) rune {
-		switch r {
-		case '/', '.', '_', '-', ' ':
-			return ' '
+	return segmentWords(normalizeText(s))
+}
+
+// normalizeText returns s in NFC with Unicode case folding applied ("Straße" → "strasse"), so
+// names created on macOS (NFD) and elsewhere (NFC) produce the same tokens.
+func normalizeText(s string) string {
+	return cases.Fold().String(norm.NFC.String(s))
+}
+
+// foldDiacritics strips combining marks: "café" → "cafe". Letters without a decomposition
+// (ø, ł, đ) are kept.
+func foldDiacritics(s string) string {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s
+	}
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	out, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return out
+}
+
+// foldedTokens returns the diacritic-folded form of the tokens that have one; these go into the
+// *_fold segments.
+func foldedTokens(tokens []string) []string {
+	var out []string
+	for _, t := range tokens {
+		if f := foldDiacritics(t); f != t {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// segmentWords splits normalized text into words following the Unicode word boundary rules
+// (UAX #29) for letters, digits, marks and apostrophes, with two changes for file names: all
+// other punctuation, including '.' and '_', separates words, and Han, Hiragana and Katakana are
+// left out since they have no word separators and are searched by bigrams instead.
+func segmentWords(s string) []string {
+	var out []string
+	var b strings.Builder
+	var prev rune
+	flush := func() {
+		if b.Len() > 0 {
+			out = append(out, b.String())
+			b.Reset()
+		}
+	}
+	for i, r := range s {
+		switch {
+		case isWordRune(r):
+			b.WriteRune(r)
+			prev = r
+		case b.Len() > 0 && (unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me)):
+			// Marks extend the word they follow (WB4).
+			b.WriteRune(r)
+		case b.Len() > 0 && (r == 0x200D || unicode.Is(unicode.Cf, r)):
+			// Joiners and other format characters are ignored inside words (WB4).
+		case (r == '\'' || r == '’') && b.Len() > 0 && unicode.IsLetter(prev) && letterFollows(s[i+utf8.RuneLen(r):]):
+			// Apostrophes between letters stay inside the word: "don't" (WB6, WB7).
+			b.WriteRune(r)
 		default:
-			if r < 128 {
-				return r
-			}
-			return ' '
+			flush()
 		}
 	}
-	s = strings.Map(repl, s)
-	return strings.Fields(s)
+	flush()
+	return out
+}
+
+func isWordRune(r rune) bool {
+	if unicode.IsDigit(r) {
+		return true
+	}
+	return unicode.IsLetter(r) && !isIdeographic(r)
+}
+
+func letterFollows(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return isWordRune(r) && !unicode.IsDigit(r)
+}
+
+func isIdeographic(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
 }
 
 // buildQueryNgrams returns query ngrams for fuzzy search.
-// ASCII: lowercase; split by separators; 2-gram for tokens with length >= 3
+// Words: normalized and diacritic-folded; rune 2-grams for words of 3 or more runes
 // CJK: bigram on contiguous CJK sequences without using any dictionary
 func buildQueryNgrams(s string) []string {
-	s = strings.ToLower(s)
-	toks := tokenize(s)
+	s = normalizeText(s)
+	toks := segmentWords(s)
 	out := make([]string, 0, 16)
-	// ASCII 2-gram
+	// Word 2-grams (Hangul words are covered by the CJK bigrams below)
 	for _, t := range toks {
-		if len(t) < 3 {
+		rs := []rune(foldDiacritics(t))
+		if len(rs) < 3 || isCJK(rs[0]) {
 			continue
 		}
-		for i := 0; i+2 <= len(t); i++ {
-			out = append(out, t[i:i+2])
+		for i := 0; i+2 <= len(rs); i++ {
+			out = append(out, string(rs[i:i+2]))
 		}
 	}
 	// CJK bigrams from original string
@@ -78,3 +163,62 @@ func isCJK(r rune) bool {
 		return false
 	}
 }
+
+// foldedText is s folded for matching (case, then diacritics) rune by rune, keeping the byte
+// range of the original rune behind every folded byte so matches map back to s.
+type foldedText struct {
+	text       string
+	start, end []int
+}
+
+func foldForMatch(s string) foldedText {
+	var b strings.Builder
+	b.Grow(len(s))
+	start := make([]int, 0, len(s))
+	end := make([]int, 0, len(s))
+	caser := cases.Fold()
+	for i, r := range s {
+		n := utf8.RuneLen(r)
+		var f string
+		switch {
+		case r < utf8.RuneSelf:
+			f = string(unicode.ToLower(r))
+		case unicode.In(r, unicode.Mn):
+			// A combining mark of an NFD name folds away with the diacritics; it still belongs
+			// to the range of the letter before it.
+			for k := len(end) - 1; k >= 0 && end[k] == i; k-- {
+				end[k] = i + n
+			}
+		default:
+			f = foldDiacritics(caser.String(string(r)))
+		}
+		b.WriteString(f)
+		for range len(f) {
+			start = append(start, i)
+			end = append(end, i+n)
+		}
+	}
+	return foldedText{text: b.String(), start: start, end: end}
+}
+
+// findFolded returns the byte ranges of s that match any needle, ignoring case and diacritics.
+func findFolded(s string, needles []string) [][2]int {
+	ft := foldForMatch(s)
+	folded := make([]string, len(needles))
+	for i, n := range needles {
+		folded[i] = foldDiacritics(normalizeText(n))
+	}
+	return ft.original(findAll(ft.text, folded))
+}
+
+// original maps byte ranges of the folded text back to s.
+func (ft foldedText) original(ranges [][2]int) [][2]int {
+	out := make([][2]int, 0, len(ranges))
+	for _, r := range ranges {
+		if r[1] <= r[0] {
+			continue
+		}
+		out = append(out, [2]int{ft.start[r[0]], ft.end[r[1]-1]})
+	}
+	return out
+}
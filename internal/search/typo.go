@@ -0,0 +1,114 @@
+package search
+
+import (
+	"sort"
+
+	"ismartcoding/plainnas/internal/pkg/fuzzy"
+)
+
+const (
+	// maxTypoExpansions caps how many dictionary terms one query term may expand to.
+	maxTypoExpansions = 32
+	// typoPostingCap truncates the posting lists read for typo matching, like the ngram fallback.
+	typoPostingCap = 20000
+)
+
+// typoTree returns the BK-tree over the index dictionary, built once per index rebuild.
+func (m *mmapIndex) typoTree() *fuzzy.BKTree {
+	return fuzzy.Cached(m.dictPath, m.dictVersion, func() *fuzzy.BKTree {
+		terms := make([]string, 0, len(m.dict))
+		for t := range m.dict {
+			terms = append(terms, t)
+		}
+		sort.Strings(terms)
+		return fuzzy.NewBKTree(terms)
+	})
+}
+
+// typoMatches finds documents that match every term exactly or within fuzzy.MaxEdits edits of a
+// dictionary term. It returns the summed edit distance per document, leaving out documents that
+// match all terms exactly (those are found by the exact search).
+func typoMatches(terms []string, idx *volumeIndex) map[uint64]int {
+	if idx == nil || len(terms) == 0 {
+		return nil
+	}
+	tolerant := false
+	for _, t := range terms {
+		if fuzzy.MaxEdits(t) > 0 {
+			tolerant = true
+			break
+		}
+	}
+	if !tolerant {
+		return nil
+	}
+	var acc map[uint64]int
+	for i, t := range terms {
+		dists := make(map[uint64]int)
+		for _, id := range idx.termCapped(t, typoPostingCap) {
+			dists[id] = 0
+		}
+		if k := fuzzy.MaxEdits(t); k > 0 {
+			// Each volume has its own dictionary and tree.
+			for _, part := range idx.parts {
+				n := 0
+				for _, m := range part.typoTree().Search(t, k) {
+					if m.Distance == 0 {
+						continue
+					}
+					if n == maxTypoExpansions {
+						break
+					}
+					n++
+					pl, _ := part.postingCapped(part.dict[m.Term], typoPostingCap)
+					for _, id := range pl {
+						if d, ok := dists[id]; !ok || m.Distance < d {
+							dists[id] = m.Distance
+						}
+					}
+				}
+			}
+		}
+		if i == 0 {
+			acc = dists
+			continue
+		}
+		for id, d := range acc {
+			if d2, ok := dists[id]; ok {
+				acc[id] = d + d2
+			} else {
+				delete(acc, id)
+			}
+		}
+		if len(acc) == 0 {
+			return nil
+		}
+	}
+	for id, d := range acc {
+		if d == 0 {
+			delete(acc, id)
+		}
+	}
+	return acc
+}
+
+// searchIndexMaybeTypos runs typo matching when the exact search found few results, under the
+// same threshold as the ngram fallback.
+func searchIndexMaybeTypos(idsExact []uint64, terms []string, isPathQuery bool, limit int, nm, pm *volumeIndex) map[uint64]int {
+	if len(idsExact) >= limit*3 {
+		return nil
+	}
+	if isPathQuery {
+		return typoMatches(terms, pm)
+	}
+	return typoMatches(terms, nm)
+}
+
+func sortedIDs(m map[uint64]int) []uint64 {
+	out := make([]uint64, 0, len(m))
+	for id := range m {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
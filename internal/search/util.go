@@ -1,5 +1,7 @@
 package search
 
+import "container/heap"
+
 func intersectSorted(a, b []uint64) []uint64 {
 	i, j := 0, 0
 	out := make([]uint64, 0, min(len(a), len(b)))
@@ -70,3 +72,66 @@ func unionSorted(a, b []uint64) []uint64 {
 	}
 	return out
 }
+
+// unionAll k-way merges sorted unique uint64 slices into a sorted unique union, in one pass
+// instead of one unionSorted per list.
+func unionAll(lists [][]uint64) []uint64 {
+	h := make(cursorHeap, 0, len(lists))
+	n := 0
+	for _, l := range lists {
+		if len(l) > 0 {
+			h = append(h, l)
+			n += len(l)
+		}
+	}
+	switch len(h) {
+	case 0:
+		return nil
+	case 1:
+		return h[0]
+	}
+	heap.Init(&h)
+	out := make([]uint64, 0, n)
+	for len(h) > 0 {
+		v := h[0][0]
+		if len(out) == 0 || out[len(out)-1] != v {
+			out = append(out, v)
+		}
+		if h[0] = h[0][1:]; len(h[0]) == 0 {
+			heap.Pop(&h)
+		} else {
+			heap.Fix(&h, 0)
+		}
+	}
+	return out
+}
+
+// cursorHeap orders the remainders of sorted lists by their first element.
+type cursorHeap [][]uint64
+
+func (h cursorHeap) Len() int           { return len(h) }
+func (h cursorHeap) Less(i, j int) bool { return h[i][0] < h[j][0] }
+func (h cursorHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *cursorHeap) Push(x any)        { *h = append(*h, x.([]uint64)) }
+func (h *cursorHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// differenceSorted returns the elements of sorted a that are not in sorted b
+func differenceSorted(a, b []uint64) []uint64 {
+	out := make([]uint64, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,39 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// On-disk format versions of the index segments. Bump a version whenever the posting layout or
+// the tokenization of that segment changes: segments written with another version (or before
+// versioning existed) are treated as missing and rebuilt.
+const (
+	// filesIndexVersion covers the name, path, fold, pinyin, ngram and filter segments built by IndexVolume;
+	// v2 tokenizes Unicode words (NFC, case folded) and adds the diacritic-folded segments,
+	// v3 adds the pinyin segment.
+	filesIndexVersion = 3
+	// contentIndexVersion covers the content segment; v1 stores tf and document length per posting,
+	// v2 indexes Unicode words with diacritics folded.
+	contentIndexVersion = 2
+)
+
+// versionFile is the version file of segment in dir: a volume directory for the file
+// segments, indexDir() for the content segment.
+func versionFile(dir, segment string) string { return filepath.Join(dir, segment+".version") }
+
+func writeIndexVersion(dir, segment string, v int) error {
+	return os.WriteFile(versionFile(dir, segment), []byte(strconv.Itoa(v)), 0o644)
+}
+
+// indexVersionOK reports whether segment on disk was written with format version v.
+func indexVersionOK(dir, segment string, v int) bool {
+	b, err := os.ReadFile(versionFile(dir, segment))
+	if err != nil {
+		return false
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	return err == nil && n == v
+}
@@ -0,0 +1,267 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"ismartcoding/plainnas/internal/pkg/fuzzy"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// The file index is split into one set of segments per filesystem under
+// searchidx/vol/<key>/, where key hashes the volume's FSUUID. Each volume is built on its own
+// (IndexVolume) and queries read the segments of all volumes through volumeIndex. Volumes that
+// are not mounted stay searchable as an offline catalog; their hits are marked offline.
+// The content segment stays global.
+
+// Volume is a filesystem whose files are indexed together.
+type Volume struct {
+	// FSUUID identifies the filesystem (see media.VolumeIDForPath).
+	FSUUID string `json:"fsUUID"`
+	// Root is the mount point the volume was indexed from.
+	Root string `json:"root"`
+}
+
+// Segment names of a volume; files are <segment>.dict.json, .postings.dat and .postings.idx.
+const (
+	segName       = "name"
+	segPath       = "path"
+	segNameFold   = "name_fold"
+	segPathFold   = "path_fold"
+	segNamePinyin = "name_pinyin"
+	segNameNgram  = "name_ngram"
+	segPathNgram  = "path_ngram"
+	segFilter     = "filter"
+)
+
+// volumeSegments are the segments every volume must have; name_pinyin depends on search.pinyin.
+var volumeSegments = []string{segName, segPath, segNameFold, segPathFold, segNameNgram, segPathNgram, segFilter}
+
+func volumesRoot() string { return filepath.Join(indexDir(), "vol") }
+
+func volumeKey(fsUUID string) string { return fmt.Sprintf("%016x", xxhash.Sum64String(fsUUID)) }
+
+func volumeDir(fsUUID string) string { return filepath.Join(volumesRoot(), volumeKey(fsUUID)) }
+
+func volumeJSON(dir string) string { return filepath.Join(dir, "volume.json") }
+
+// segmentFiles returns the dictionary, postings and offsets files of segment seg in dir.
+func segmentFiles(dir, seg string) (dictPath, datPath, idxPath string) {
+	return filepath.Join(dir, seg+".dict.json"), filepath.Join(dir, seg+".postings.dat"), filepath.Join(dir, seg+".postings.idx")
+}
+
+func segmentPathList(dir, seg string) []string {
+	d, p, i := segmentFiles(dir, seg)
+	return []string{d, p, i}
+}
+
+func segmentExists(dir, seg string) bool {
+	for _, p := range segmentPathList(dir, seg) {
+		if _, err := os.Stat(p); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	volumesMu sync.RWMutex
+	// activeVolumes holds the keys of mounted volumes; nil until SetActiveVolumes is first
+	// called, meaning every volume is online.
+	activeVolumes map[string]struct{}
+)
+
+// SetActiveVolumes sets the mounted volumes (by FSUUID). Hits in other volumes are reported
+// offline (see Hit.Online).
+func SetActiveVolumes(fsUUIDs []string) {
+	m := make(map[string]struct{}, len(fsUUIDs))
+	for _, id := range fsUUIDs {
+		m[volumeKey(id)] = struct{}{}
+	}
+	volumesMu.Lock()
+	activeVolumes = m
+	volumesMu.Unlock()
+}
+
+// volumeOnline reports whether the volume fsUUID is mounted.
+func volumeOnline(fsUUID string) bool {
+	volumesMu.RLock()
+	defer volumesMu.RUnlock()
+	if activeVolumes == nil {
+		return true
+	}
+	_, ok := activeVolumes[volumeKey(fsUUID)]
+	return ok
+}
+
+// metaOnline reports whether the file is on a mounted volume. Metas written before volumes were
+// recorded count as online.
+func metaOnline(m *FileMeta) bool {
+	return m.Volume == "" || volumeOnline(m.Volume)
+}
+
+// volumeDirs lists the segment directories of all volumes, mounted or not.
+func volumeDirs() []string {
+	ents, err := os.ReadDir(volumesRoot())
+	if err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(ents))
+	for _, e := range ents {
+		// Staging directories of a build in progress contain a dot.
+		if !e.IsDir() || strings.Contains(e.Name(), ".") {
+			continue
+		}
+		out = append(out, filepath.Join(volumesRoot(), e.Name()))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Volumes returns the volumes that have segments on disk, mounted or not.
+func Volumes() []Volume {
+	ents, err := os.ReadDir(volumesRoot())
+	if err != nil {
+		return nil
+	}
+	var out []Volume
+	for _, e := range ents {
+		if !e.IsDir() || strings.Contains(e.Name(), ".") {
+			continue
+		}
+		b, err := os.ReadFile(volumeJSON(filepath.Join(volumesRoot(), e.Name())))
+		if err != nil {
+			continue
+		}
+		var v Volume
+		if json.Unmarshal(b, &v) == nil {
+			out = append(out, v)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Root < out[j].Root })
+	return out
+}
+
+// VolumeIndexExists reports whether the volume has all of its segments in the current format.
+func VolumeIndexExists(fsUUID string) bool {
+	dir := volumeDir(fsUUID)
+	if !indexVersionOK(dir, "files", filesIndexVersion) {
+		return false
+	}
+	for _, seg := range volumeSegments {
+		if !segmentExists(dir, seg) {
+			return false
+		}
+	}
+	return pinyinSegmentOK(dir)
+}
+
+// RemoveVolumeIndex deletes the segments of a volume.
+func RemoveVolumeIndex(fsUUID string) error {
+	fuzzy.Forget(volumeDir(fsUUID))
+	return os.RemoveAll(volumeDir(fsUUID))
+}
+
+// removeVolumesExcept deletes the segments of volumes whose key is not in keep.
+func removeVolumesExcept(keep map[string]struct{}) {
+	ents, _ := os.ReadDir(volumesRoot())
+	for _, e := range ents {
+		// Staging directories of a build in progress contain a dot.
+		if !e.IsDir() || strings.Contains(e.Name(), ".") {
+			continue
+		}
+		if _, ok := keep[e.Name()]; !ok {
+			dir := filepath.Join(volumesRoot(), e.Name())
+			fuzzy.Forget(dir)
+			_ = os.RemoveAll(dir)
+		}
+	}
+}
+
+// removeLegacySegments deletes the single global index written before per-volume segments.
+func removeLegacySegments() {
+	for _, seg := range append(volumeSegments, segNamePinyin) {
+		for _, p := range segmentPathList(indexDir(), seg) {
+			_ = os.Remove(p)
+		}
+	}
+	_ = os.Remove(versionFile(indexDir(), "files"))
+}
+
+// volumeIndex reads one segment across all volumes. FileIDs are unique across
+// filesystems, so the postings of a term are the union of its postings in every volume.
+type volumeIndex struct {
+	parts []*mmapIndex
+}
+
+// openVolumeIndex opens seg in every volume that has it; it fails when none does.
+// With a fold segment name, the fold segment of each volume is attached (see termPosting).
+func openVolumeIndex(seg, fold string) (*volumeIndex, error) {
+	v := &volumeIndex{}
+	for _, dir := range volumeDirs() {
+		m, err := openMmapIndex(segmentFiles(dir, seg))
+		if err != nil {
+			continue
+		}
+		if fold != "" {
+			m.fold, _ = openMmapIndex(segmentFiles(dir, fold))
+		}
+		v.parts = append(v.parts, m)
+	}
+	if len(v.parts) == 0 {
+		return nil, os.ErrNotExist
+	}
+	return v, nil
+}
+
+func (v *volumeIndex) close() {
+	if v == nil {
+		return
+	}
+	for _, m := range v.parts {
+		m.close()
+	}
+}
+
+// term returns the documents containing t.
+func (v *volumeIndex) term(t string) []uint64 {
+	var out []uint64
+	for _, m := range v.parts {
+		out = unionSorted(out, m.term(t))
+	}
+	return out
+}
+
+// termCapped is term with each volume's posting list truncated to capN entries.
+func (v *volumeIndex) termCapped(t string, capN int) []uint64 {
+	var out []uint64
+	for _, m := range v.parts {
+		ids, _ := m.postingCapped(m.dict[t], capN)
+		out = unionSorted(out, ids)
+	}
+	return out
+}
+
+// termPosting is term including diacritic-folded matches (see mmapIndex.termPosting).
+func (v *volumeIndex) termPosting(t string) []uint64 {
+	var out []uint64
+	for _, m := range v.parts {
+		out = unionSorted(out, m.termPosting(t))
+	}
+	return out
+}
+
+// unionPrefix unions the postings of all terms with prefix whose remainder passes keep.
+func (v *volumeIndex) unionPrefix(prefix string, keep func(rest string) bool) []uint64 {
+	var lists [][]uint64
+	for _, m := range v.parts {
+		lists = m.prefixPostings(lists, prefix, keep)
+	}
+	return unionAll(lists)
+}
@@ -0,0 +1,50 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchIndex_MergesVolumesAndMarksOffline(t *testing.T) {
+	p := &memPebble{m: map[string][]byte{}}
+	oldGet := pebGet
+	oldIdx := indexDirOverride
+	defer func() {
+		pebGet = oldGet
+		indexDirOverride = oldIdx
+		volumesMu.Lock()
+		activeVolumes = nil
+		volumesMu.Unlock()
+	}()
+	pebGet = p.get
+	indexDirOverride = t.TempDir()
+
+	a := []FileMeta{{FileID: 1, Path: "/mnt/usb1/report.pdf", Name: "report.pdf", Volume: "uuid-a"}}
+	b := []FileMeta{{FileID: 2, Path: "/mnt/usb2/report.txt", Name: "report.txt", Volume: "uuid-b"}}
+	for _, m := range append(a, b...) {
+		p.setPathToID(m.Path, m.FileID)
+		p.setMeta(m)
+	}
+	buildTestVolume(t, "uuid-a", a)
+	buildTestVolume(t, "uuid-b", b)
+
+	online := func() map[string]bool {
+		t.Helper()
+		hits, err := SearchIndexHits("report", "", 0, 50, "", 0, nil)
+		if err != nil {
+			t.Fatalf("SearchIndexHits: %v", err)
+		}
+		out := map[string]bool{}
+		for _, h := range hits {
+			out[h.Path] = h.Online
+		}
+		return out
+	}
+	if got, want := online(), map[string]bool{"/mnt/usb1/report.pdf": true, "/mnt/usb2/report.txt": true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("all volumes: got %v, want %v", got, want)
+	}
+	SetActiveVolumes([]string{"uuid-b"})
+	if got, want := online(), map[string]bool{"/mnt/usb1/report.pdf": false, "/mnt/usb2/report.txt": true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("uuid-a unplugged: got %v, want %v", got, want)
+	}
+}
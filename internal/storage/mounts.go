@@ -16,8 +16,11 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
+	"ismartcoding/plainnas/internal/consts"
 	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
 	"ismartcoding/plainnas/internal/pkg/log"
 )
 
@@ -74,6 +77,8 @@ func EnsureMountedUSBVolumes(ctx context.Context) error {
 	// Track occupied slots (currently mounted at /mnt/usbX) and used slots.
 	// usedSlots includes both occupied slots and reserved slots from persisted mapping.
 	mapping := map[string]int{}
+	// mounted collects the filesystems that end up mounted at /mnt/usbX.
+	mounted := map[string]db.KnownVolume{}
 	occupiedSlots := map[int]string{} // slot -> fsUUID
 	usedSlots := map[int]struct{}{}
 	for _, slot := range persisted {
@@ -89,6 +94,7 @@ func EnsureMountedUSBVolumes(ctx context.Context) error {
 			mapping[fs.FSUUID] = slot
 			occupiedSlots[slot] = fs.FSUUID
 			usedSlots[slot] = struct{}{}
+			mounted[fs.FSUUID] = db.KnownVolume{FSType: fs.FSType, SizeBytes: fs.SizeBytes, Slot: slot}
 		}
 	}
 
@@ -138,6 +144,7 @@ func EnsureMountedUSBVolumes(ctx context.Context) error {
 			db.AddEvent("mount_failed", fmt.Sprintf("UUID %s -> %s: %v", fs.FSUUID, target, err), "")
 			continue
 		}
+		mounted[fs.FSUUID] = db.KnownVolume{FSType: fs.FSType, SizeBytes: fs.SizeBytes, Slot: slot}
 		log.Infof("mounted UUID %s at %s", fs.FSUUID, target)
 		db.AddEvent("mount", fmt.Sprintf("mounted UUID %s at %s", fs.FSUUID, target), "")
 	}
@@ -152,7 +159,14 @@ func EnsureMountedUSBVolumes(ctx context.Context) error {
 	for k, v := range mapping {
 		merged[k] = v
 	}
-	return db.StoreFSUUIDSlotMap(merged)
+	if err := db.StoreFSUUIDSlotMap(merged); err != nil {
+		return err
+	}
+	if err := db.UpdateKnownVolumes(mounted, time.Now().Unix()); err != nil {
+		return err
+	}
+	eventbus.GetDefault().Publish(consts.EVENT_STORAGE_MOUNTS_CHANGED)
+	return nil
 }
 
 func scanFilesystems(ctx context.Context) ([]blockFS, error) {
@@ -0,0 +1,143 @@
+// Package textextract pulls plain text out of documents for full-text search.
+// Extraction is best-effort: formats are parsed just enough to recover readable text,
+// and output is capped so huge files cannot exhaust memory.
+package textextract
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrUnsupported is returned for files whose format has no extractor.
+var ErrUnsupported = errors.New("textextract: unsupported format")
+
+// errLimit stops extraction once the output cap was reached. It never escapes the package.
+var errLimit = errors.New("textextract: limit reached")
+
+type extractor func(path string, b *builder) error
+
+var extractors = map[string]extractor{
+	"pdf":  extractPDF,
+	"docx": extractDOCX,
+	"xlsx": extractXLSX,
+	"pptx": extractPPTX,
+	"odt":  extractODF,
+	"ods":  extractODF,
+	"odp":  extractODF,
+	"epub": extractEPUB,
+}
+
+// plainTextExts are read as UTF-8 text: prose, markup, config and source code.
+var plainTextExts = map[string]bool{
+	"txt": true, "text": true, "md": true, "markdown": true, "rst": true, "adoc": true, "org": true,
+	"tex": true, "log": true, "csv": true, "tsv": true, "srt": true, "vtt": true,
+	"json": true, "yaml": true, "yml": true, "toml": true, "ini": true, "conf": true, "cfg": true,
+	"xml": true, "html": true, "htm": true, "css": true, "scss": true, "less": true,
+	"go": true, "py": true, "js": true, "mjs": true, "ts": true, "tsx": true, "jsx": true, "vue": true,
+	"java": true, "kt": true, "kts": true, "scala": true, "groovy": true, "gradle": true,
+	"c": true, "h": true, "cc": true, "cpp": true, "hpp": true, "cs": true, "m": true, "mm": true,
+	"rs": true, "rb": true, "php": true, "pl": true, "lua": true, "r": true, "swift": true, "dart": true,
+	"sh": true, "bash": true, "zsh": true, "fish": true, "ps1": true, "bat": true, "sql": true,
+	"graphql": true, "proto": true, "dockerfile": true, "makefile": true,
+}
+
+// Supported reports whether Extract handles files with extension ext (without the dot).
+func Supported(ext string) bool {
+	ext = strings.ToLower(strings.TrimPrefix(ext, "."))
+	return plainTextExts[ext] || extractors[ext] != nil
+}
+
+func extOf(path string) string {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if ext == "" {
+		// Extension-less well-known names such as Makefile or Dockerfile.
+		ext = strings.ToLower(filepath.Base(path))
+	}
+	return ext
+}
+
+// Extract returns up to limit bytes of text from the file at path.
+func Extract(path string, limit int) (string, error) {
+	ext := extOf(path)
+	b := &builder{limit: limit}
+	var err error
+	switch {
+	case plainTextExts[ext]:
+		err = extractPlain(path, b)
+	case extractors[ext] != nil:
+		err = extractors[ext](path, b)
+	default:
+		return "", ErrUnsupported
+	}
+	if err != nil && !errors.Is(err, errLimit) {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// builder accumulates extracted text, collapsing runs of whitespace and enforcing the cap.
+type builder struct {
+	sb    strings.Builder
+	limit int
+	// pending is the separator to emit before the next non-space text: 0, ' ' or '\n'.
+	pending byte
+}
+
+func (b *builder) full() bool {
+	return b.limit > 0 && b.sb.Len() >= b.limit
+}
+
+// WriteText appends s, normalizing whitespace. It returns errLimit once the cap is hit.
+func (b *builder) WriteText(s string) error {
+	for _, r := range s {
+		if r == utf8.RuneError {
+			continue
+		}
+		switch r {
+		case '\n', '\r', '\f', '\v':
+			b.Break()
+			continue
+		case ' ', '\t', '\u00a0':
+			b.Space()
+			continue
+		}
+		if r < 0x20 {
+			continue
+		}
+		sep := b.pending != 0 && b.sb.Len() > 0
+		need := utf8.RuneLen(r)
+		if sep {
+			need++
+		}
+		if b.limit > 0 && b.sb.Len()+need > b.limit {
+			return errLimit
+		}
+		if sep {
+			b.sb.WriteByte(b.pending)
+		}
+		b.pending = 0
+		b.sb.WriteRune(r)
+		if b.full() {
+			return errLimit
+		}
+	}
+	return nil
+}
+
+// Space requests a word separator before the next text.
+func (b *builder) Space() {
+	if b.pending == 0 {
+		b.pending = ' '
+	}
+}
+
+// Break requests a line break before the next text.
+func (b *builder) Break() {
+	b.pending = '\n'
+}
+
+func (b *builder) String() string {
+	return b.sb.String()
+}
@@ -0,0 +1,160 @@
+package textextract
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeZip(t *testing.T, path string, members map[string]string) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	for name, body := range members {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zip create: %v", err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatalf("zip write: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip close: %v", err)
+	}
+}
+
+func TestExtract_PlainTextCollapsesWhitespace(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "notes.md")
+	if err := os.WriteFile(p, []byte("# Title\n\n\nsome   words\there\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got, err := Extract(p, 0)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if got != "# Title\nsome words here" {
+		t.Fatalf("unexpected text %q", got)
+	}
+}
+
+func TestExtract_BinaryTextFileIsUnsupported(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "blob.txt")
+	if err := os.WriteFile(p, []byte("abc\x00def"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := Extract(p, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+}
+
+func TestExtract_Limit(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "big.txt")
+	if err := os.WriteFile(p, []byte(strings.Repeat("word ", 1000)), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got, err := Extract(p, 100)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if len(got) > 100 || len(got) < 95 {
+		t.Fatalf("expected about 100 bytes, got %d", len(got))
+	}
+}
+
+func TestExtract_DOCX(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "report.docx")
+	writeZip(t, p, map[string]string{
+		"word/document.xml": `<?xml version="1.0"?><w:document xmlns:w="w"><w:body>` +
+			`<w:p><w:r><w:t>Quarterly</w:t></w:r><w:r><w:t xml:space="preserve"> report</w:t></w:r></w:p>` +
+			`<w:p><w:r><w:instrText>PAGE</w:instrText><w:t>Revenue grew</w:t></w:r></w:p>` +
+			`</w:body></w:document>`,
+	})
+	got, err := Extract(p, 0)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if got != "Quarterly report\nRevenue grew" {
+		t.Fatalf("unexpected text %q", got)
+	}
+}
+
+func TestExtract_ODT(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "letter.odt")
+	writeZip(t, p, map[string]string{
+		"content.xml": `<?xml version="1.0"?><office:document-content xmlns:office="o" xmlns:text="t">` +
+			`<office:automatic-styles><style>ignored</style></office:automatic-styles>` +
+			`<office:body><text:h>Dear friend</text:h><text:p>See you<text:s/>soon</text:p></office:body>` +
+			`</office:document-content>`,
+	})
+	got, err := Extract(p, 0)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if got != "Dear friend\nSee you soon" {
+		t.Fatalf("unexpected text %q", got)
+	}
+}
+
+func TestExtract_EPUBFollowsSpine(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "book.epub")
+	writeZip(t, p, map[string]string{
+		"META-INF/container.xml": `<container><rootfiles><rootfile full-path="OEBPS/content.opf"/></rootfiles></container>`,
+		"OEBPS/content.opf": `<package><manifest>` +
+			`<item id="c1" href="one.xhtml" media-type="application/xhtml+xml"/>` +
+			`<item id="c2" href="two.xhtml" media-type="application/xhtml+xml"/>` +
+			`</manifest><spine><itemref idref="c2"/><itemref idref="c1"/></spine></package>`,
+		"OEBPS/one.xhtml": `<html><head><title>skip</title></head><body><p>First&nbsp;chapter</p></body></html>`,
+		"OEBPS/two.xhtml": `<html><body><p>Second</p><script>var x;</script></body></html>`,
+	})
+	got, err := Extract(p, 0)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if got != "Second\nFirst chapter" {
+		t.Fatalf("unexpected text %q", got)
+	}
+}
+
+func TestExtract_PDFBuiltin(t *testing.T) {
+	var zbuf bytes.Buffer
+	zw := zlib.NewWriter(&zbuf)
+	_, _ = zw.Write([]byte("BT /F1 12 Tf 72 712 Td (Hello \\(PDF\\)) Tj T* [(wor) -20 (ld)] TJ ET"))
+	_ = zw.Close()
+	var doc bytes.Buffer
+	doc.WriteString("%PDF-1.4\n1 0 obj\n<< /Filter /FlateDecode >>\nstream\n")
+	doc.Write(zbuf.Bytes())
+	doc.WriteString("\nendstream\nendobj\n%%EOF\n")
+
+	p := filepath.Join(t.TempDir(), "doc.pdf")
+	if err := os.WriteFile(p, doc.Bytes(), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	b := &builder{}
+	if err := extractPDFBuiltin(p, b); err != nil {
+		t.Fatalf("extractPDFBuiltin: %v", err)
+	}
+	if got := b.String(); got != "Hello (PDF) world" {
+		t.Fatalf("unexpected text %q", got)
+	}
+}
+
+func TestSupported(t *testing.T) {
+	for _, ext := range []string{"txt", ".PDF", "docx", "epub", "ods", "go"} {
+		if !Supported(ext) {
+			t.Fatalf("expected %q to be supported", ext)
+		}
+	}
+	if Supported("jpg") {
+		t.Fatalf("jpg must not be supported")
+	}
+}
@@ -0,0 +1,224 @@
+package textextract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"time"
+)
+
+// pdftotextTimeout bounds the external extractor on pathological files.
+const pdftotextTimeout = 60 * time.Second
+
+// maxPDFBytes bounds how much of a PDF the built-in parser loads.
+const maxPDFBytes = 64 << 20
+
+// extractPDF prefers poppler's pdftotext, which handles fonts and encodings properly.
+// Without it, a built-in parser recovers text from simple text layers.
+func extractPDF(p string, b *builder) error {
+	if bin, err := exec.LookPath("pdftotext"); err == nil {
+		if err := pdftotext(bin, p, b); err == nil {
+			return nil
+		}
+	}
+	return extractPDFBuiltin(p, b)
+}
+
+func pdftotext(bin string, p string, b *builder) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pdftotextTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, bin, "-q", "-enc", "UTF-8", p, "-")
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	data, rerr := io.ReadAll(io.LimitReader(out, int64(b.limit)*2+1))
+	// Stop the tool early if the cap was reached.
+	cancel()
+	_ = cmd.Wait()
+	if rerr != nil {
+		return rerr
+	}
+	if len(data) == 0 {
+		return ErrUnsupported
+	}
+	return b.WriteText(string(bytes.ToValidUTF8(data, nil)))
+}
+
+var pdfStreamRe = regexp.MustCompile(`(?s)<<(.{0,1000}?)>>\s*stream\r?\n`)
+
+func extractPDFBuiltin(p string, b *builder) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxPDFBytes))
+	if err != nil {
+		return err
+	}
+	for _, m := range pdfStreamRe.FindAllSubmatchIndex(data, -1) {
+		dict := data[m[2]:m[3]]
+		start := m[1]
+		end := bytes.Index(data[start:], []byte("endstream"))
+		if end < 0 {
+			break
+		}
+		raw := data[start : start+end]
+		if bytes.Contains(dict, []byte("/Subtype/Image")) || bytes.Contains(dict, []byte("/Subtype /Image")) {
+			continue
+		}
+		var content []byte
+		switch {
+		case bytes.Contains(dict, []byte("/FlateDecode")):
+			zr, err := zlib.NewReader(bytes.NewReader(raw))
+			if err != nil {
+				continue
+			}
+			content, _ = io.ReadAll(io.LimitReader(zr, maxPDFBytes))
+			zr.Close()
+		case bytes.Contains(dict, []byte("/Filter")):
+			// Other filters (DCT, LZW, ...) never hold text we can read.
+			continue
+		default:
+			content = raw
+		}
+		if err := pdfContentText(content, b); err != nil {
+			return err
+		}
+	}
+	if b.sb.Len() == 0 {
+		return ErrUnsupported
+	}
+	return nil
+}
+
+// pdfContentText collects the literal strings shown by text operators in a content stream.
+// Strings are emitted between BT and ET only; positioning operators become separators.
+// Fonts with custom encodings (CID fonts) produce no readable text and are skipped.
+func pdfContentText(c []byte, b *builder) error {
+	inText := false
+	var pending [][]byte
+	flush := func() error {
+		for _, s := range pending {
+			if err := b.WriteText(string(bytes.ToValidUTF8(s, nil))); err != nil {
+				return err
+			}
+		}
+		pending = pending[:0]
+		return nil
+	}
+	for i := 0; i < len(c); {
+		ch := c[i]
+		switch {
+		case ch == '(' && inText:
+			s, n := pdfLiteral(c[i:])
+			pending = append(pending, s)
+			i += n
+		case ch == '%':
+			for i < len(c) && c[i] != '\n' && c[i] != '\r' {
+				i++
+			}
+		case isPDFRegular(ch):
+			j := i
+			for j < len(c) && isPDFRegular(c[j]) {
+				j++
+			}
+			switch string(c[i:j]) {
+			case "BT":
+				inText = true
+			case "ET":
+				inText = false
+				if err := flush(); err != nil {
+					return err
+				}
+				b.Break()
+			case "Tj", "TJ":
+				if err := flush(); err != nil {
+					return err
+				}
+			case "'", "\"", "T*", "Td", "TD":
+				if err := flush(); err != nil {
+					return err
+				}
+				b.Space()
+			}
+			i = j
+		default:
+			i++
+		}
+	}
+	return flush()
+}
+
+func isPDFRegular(ch byte) bool {
+	switch ch {
+	case ' ', '\t', '\r', '\n', '\f', 0, '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return false
+	}
+	return true
+}
+
+// pdfLiteral decodes a "(...)" string starting at c[0] and returns it with the bytes consumed.
+func pdfLiteral(c []byte) ([]byte, int) {
+	var out []byte
+	depth := 0
+	i := 0
+	for i < len(c) {
+		ch := c[i]
+		switch ch {
+		case '(':
+			depth++
+			if depth > 1 {
+				out = append(out, ch)
+			}
+		case ')':
+			depth--
+			if depth == 0 {
+				return out, i + 1
+			}
+			out = append(out, ch)
+		case '\\':
+			i++
+			if i >= len(c) {
+				return out, i
+			}
+			switch e := c[i]; e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b', 'f':
+			case '\r', '\n':
+				// Line continuation.
+			default:
+				if e >= '0' && e <= '7' {
+					v := 0
+					k := 0
+					for k < 3 && i < len(c) && c[i] >= '0' && c[i] <= '7' {
+						v = v*8 + int(c[i]-'0')
+						i++
+						k++
+					}
+					i--
+					out = append(out, byte(v))
+				} else {
+					out = append(out, e)
+				}
+			}
+		default:
+			out = append(out, ch)
+		}
+		i++
+	}
+	return out, i
+}
@@ -0,0 +1,37 @@
+package textextract
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+)
+
+// binarySniffLen is how much of a "text" file is checked for NUL bytes, which mark it as binary.
+const binarySniffLen = 8 << 10
+
+func extractPlain(path string, b *builder) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	max := int64(b.limit)
+	if max <= 0 {
+		max = 1 << 30
+	}
+	// Whitespace is collapsed, so reading a bit more than the cap still fits.
+	data, err := io.ReadAll(io.LimitReader(f, max*2))
+	if err != nil {
+		return err
+	}
+	sniff := data
+	if len(sniff) > binarySniffLen {
+		sniff = sniff[:binarySniffLen]
+	}
+	if bytes.IndexByte(sniff, 0) >= 0 {
+		return ErrUnsupported
+	}
+	return b.WriteText(strings.ToValidUTF8(string(data), ""))
+}
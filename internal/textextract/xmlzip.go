@@ -0,0 +1,309 @@
+package textextract
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxZipMemberBytes bounds how much of a single XML member is decompressed.
+const maxZipMemberBytes = 64 << 20
+
+// xmlRules says how XML elements (by local name) map to text layout.
+type xmlRules struct {
+	// text limits collection to character data inside these elements; nil collects everything.
+	text map[string]bool
+	// breaks end a line when the element closes (paragraphs, rows, list items).
+	breaks map[string]bool
+	// spaces insert a word separator (tabs, cells, explicit spaces).
+	spaces map[string]bool
+	// skip drops the element and everything inside it.
+	skip map[string]bool
+}
+
+func set(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, n := range names {
+		m[n] = true
+	}
+	return m
+}
+
+// xmlText streams r and writes the text selected by rules to b.
+func xmlText(r io.Reader, b *builder, rules xmlRules, html bool) error {
+	dec := xml.NewDecoder(r)
+	if html {
+		dec.Strict = false
+		dec.AutoClose = xml.HTMLAutoClose
+		dec.Entity = xml.HTMLEntity
+	}
+	inText := 0
+	skipDepth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// Keep whatever was extracted before the document became unreadable.
+			return nil
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := strings.ToLower(t.Name.Local)
+			if skipDepth > 0 || rules.skip[name] {
+				skipDepth++
+				continue
+			}
+			if rules.text[name] {
+				inText++
+			}
+			if rules.spaces[name] {
+				b.Space()
+			}
+		case xml.EndElement:
+			name := strings.ToLower(t.Name.Local)
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			if rules.text[name] && inText > 0 {
+				inText--
+			}
+			if rules.breaks[name] {
+				b.Break()
+			} else if rules.spaces[name] {
+				b.Space()
+			}
+		case xml.CharData:
+			if skipDepth > 0 || (rules.text != nil && inText == 0) {
+				continue
+			}
+			if err := b.WriteText(string(t)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type zipDoc struct {
+	*zip.ReadCloser
+	byName map[string]*zip.File
+}
+
+func openZipDoc(p string) (*zipDoc, error) {
+	zr, err := zip.OpenReader(p)
+	if err != nil {
+		return nil, err
+	}
+	d := &zipDoc{ReadCloser: zr, byName: make(map[string]*zip.File, len(zr.File))}
+	for _, f := range zr.File {
+		d.byName[f.Name] = f
+	}
+	return d, nil
+}
+
+var errMemberMissing = errors.New("textextract: zip member missing")
+
+func (d *zipDoc) xml(name string, b *builder, rules xmlRules, html bool) error {
+	f := d.byName[name]
+	if f == nil {
+		return errMemberMissing
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xmlText(io.LimitReader(rc, maxZipMemberBytes), b, rules, html)
+}
+
+// numberedMembers returns members like "ppt/slides/slide12.xml" sorted by their number.
+func (d *zipDoc) numberedMembers(dir, prefix string) []string {
+	type member struct {
+		name string
+		n    int
+	}
+	var ms []member
+	for name := range d.byName {
+		if path.Dir(name) != dir {
+			continue
+		}
+		base := path.Base(name)
+		if !strings.HasPrefix(base, prefix) || !strings.HasSuffix(base, ".xml") {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(base, prefix), ".xml"))
+		if err != nil {
+			continue
+		}
+		ms = append(ms, member{name, n})
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].n < ms[j].n })
+	out := make([]string, len(ms))
+	for i, m := range ms {
+		out[i] = m.name
+	}
+	return out
+}
+
+var docxRules = xmlRules{
+	text:   set("t"),
+	breaks: set("p", "br", "cr"),
+	spaces: set("tab"),
+	skip:   set("instrtext", "deltext"),
+}
+
+func extractDOCX(p string, b *builder) error {
+	d, err := openZipDoc(p)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err := d.xml("word/document.xml", b, docxRules, false); err != nil {
+		return err
+	}
+	for _, extra := range []string{"word/footnotes.xml", "word/endnotes.xml"} {
+		b.Break()
+		if err := d.xml(extra, b, docxRules, false); err != nil && !errors.Is(err, errMemberMissing) {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractXLSX(p string, b *builder) error {
+	d, err := openZipDoc(p)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	// Cell text lives in the shared string table; inline strings sit in the sheets.
+	rules := xmlRules{text: set("t"), breaks: set("si", "row"), spaces: set("c", "is")}
+	if err := d.xml("xl/sharedStrings.xml", b, rules, false); err != nil && !errors.Is(err, errMemberMissing) {
+		return err
+	}
+	for _, sheet := range d.numberedMembers("xl/worksheets", "sheet") {
+		b.Break()
+		if err := d.xml(sheet, b, xmlRules{text: set("is"), breaks: set("row"), spaces: set("c")}, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractPPTX(p string, b *builder) error {
+	d, err := openZipDoc(p)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	rules := xmlRules{text: set("t"), breaks: set("p"), spaces: set("tab", "br")}
+	for _, slide := range d.numberedMembers("ppt/slides", "slide") {
+		if err := d.xml(slide, b, rules, false); err != nil {
+			return err
+		}
+		b.Break()
+	}
+	for _, notes := range d.numberedMembers("ppt/notesSlides", "notesSlide") {
+		if err := d.xml(notes, b, rules, false); err != nil {
+			return err
+		}
+		b.Break()
+	}
+	return nil
+}
+
+// extractODF handles OpenDocument text, spreadsheets and presentations, which all keep
+// their body in content.xml.
+func extractODF(p string, b *builder) error {
+	d, err := openZipDoc(p)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	rules := xmlRules{
+		breaks: set("p", "h", "line-break", "table-row", "list-item"),
+		spaces: set("s", "tab", "table-cell"),
+		skip:   set("automatic-styles", "font-face-decls", "scripts", "annotation"),
+	}
+	return d.xml("content.xml", b, rules, false)
+}
+
+// htmlRules extract readable text from XHTML chapters.
+var htmlRules = xmlRules{
+	breaks: set("p", "div", "br", "li", "tr", "h1", "h2", "h3", "h4", "h5", "h6", "blockquote", "pre", "section", "title"),
+	spaces: set("td", "th"),
+	skip:   set("script", "style", "head"),
+}
+
+type epubContainer struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+type epubPackage struct {
+	Manifest []struct {
+		ID        string `xml:"id,attr"`
+		Href      string `xml:"href,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"manifest>item"`
+	Spine []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+func (d *zipDoc) decode(name string, v any) error {
+	f := d.byName[name]
+	if f == nil {
+		return errMemberMissing
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(io.LimitReader(rc, maxZipMemberBytes)).Decode(v)
+}
+
+// extractEPUB reads the chapters in reading (spine) order.
+func extractEPUB(p string, b *builder) error {
+	d, err := openZipDoc(p)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	var c epubContainer
+	if err := d.decode("META-INF/container.xml", &c); err != nil || len(c.Rootfiles) == 0 {
+		return ErrUnsupported
+	}
+	opf := c.Rootfiles[0].FullPath
+	var pkg epubPackage
+	if err := d.decode(opf, &pkg); err != nil {
+		return ErrUnsupported
+	}
+	hrefs := make(map[string]string, len(pkg.Manifest))
+	for _, it := range pkg.Manifest {
+		if strings.Contains(it.MediaType, "html") {
+			hrefs[it.ID] = path.Join(path.Dir(opf), it.Href)
+		}
+	}
+	for _, ref := range pkg.Spine {
+		name, ok := hrefs[ref.IDRef]
+		if !ok {
+			continue
+		}
+		if err := d.xml(name, b, htmlRules, true); err != nil && !errors.Is(err, errMemberMissing) {
+			return err
+		}
+		b.Break()
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package upload
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
+)
+
+// maxBatchErrors bounds how many per-file errors a batch summary carries.
+const maxBatchErrors = 20
+
+// BatchFile is the outcome of one file of an upload batch (e.g. a dropped folder tree).
+type BatchFile struct {
+	Path     string
+	Size     int64
+	Replaced bool
+	Renamed  bool
+	Err      error
+}
+
+type uploadBatch struct {
+	clientID string
+	id       string
+	total    int
+	dir      string
+	// files holds the last outcome per path relative to dir, so a file the client retried
+	// after a failure is counted once.
+	files     map[string]BatchFile
+	startedAt time.Time
+	touchedAt time.Time
+}
+
+var batches = struct {
+	sync.Mutex
+	m map[string]*uploadBatch
+}{m: map[string]*uploadBatch{}}
+
+// BatchPath returns path relative to the batch directory dir, with / separators. Paths outside
+// dir, or any path when dir is unknown, are returned as given.
+func BatchPath(dir, path string) string {
+	if dir == "" || !filepath.IsAbs(path) {
+		return filepath.ToSlash(path)
+	}
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// RecordBatchFile adds the outcome of one file to batch batchID of clientID. total is the
+// number of files the client announced for the batch and dir its upload directory, if known.
+// f.Path is the file's path relative to dir (see BatchPath); a later outcome for the same path
+// replaces the earlier one. Once every file has been reported, an EVENT_UPLOAD_BATCH_DONE
+// summary is published to the client. Calls without a batch id are ignored, so single-file
+// uploads work as before.
+func RecordBatchFile(clientID string, batchID string, total int, dir string, f BatchFile) {
+	if batchID == "" || total <= 0 {
+		return
+	}
+	now := time.Now().UTC()
+	key := clientID + "\x00" + batchID
+
+	batches.Lock()
+	b := batches.m[key]
+	if b == nil {
+		b = &uploadBatch{clientID: clientID, id: batchID, total: total, dir: dir, files: map[string]BatchFile{}, startedAt: now}
+		batches.m[key] = b
+	}
+	if b.dir == "" {
+		b.dir = dir
+	}
+	b.files[f.Path] = f
+	b.touchedAt = now
+	finished := len(b.files) >= b.total
+	if finished {
+		delete(batches.m, key)
+	}
+	batches.Unlock()
+
+	if finished {
+		publishBatch(b, false)
+	}
+}
+
+func publishBatch(b *uploadBatch, incomplete bool) {
+	paths := make([]string, 0, len(b.files))
+	for p := range b.files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	var (
+		uploaded, replaced, renamed, failed int
+		bytes                               int64
+		errs                                []map[string]any
+	)
+	for _, p := range paths {
+		f := b.files[p]
+		if f.Err != nil {
+			failed++
+			if len(errs) < maxBatchErrors {
+				errs = append(errs, map[string]any{"path": p, "error": f.Err.Error()})
+			}
+			continue
+		}
+		uploaded++
+		bytes += f.Size
+		if f.Replaced {
+			replaced++
+		}
+		if f.Renamed {
+			renamed++
+		}
+	}
+	eventbus.GetDefault().Publish(consts.EVENT_UPLOAD_BATCH_DONE, b.clientID, map[string]any{
+		"id":         b.id,
+		"dir":        b.dir,
+		"total":      b.total,
+		"uploaded":   uploaded,
+		"replaced":   replaced,
+		"renamed":    renamed,
+		"failed":     failed,
+		"bytes":      bytes,
+		"errors":     errs,
+		"incomplete": incomplete,
+		"startedAt":  b.startedAt,
+		"finishedAt": b.touchedAt,
+	})
+}
+
+// CleanupStaleBatches closes batches whose client stopped reporting files (e.g. the browser
+// tab was closed) and publishes their summary marked incomplete.
+func CleanupStaleBatches(now time.Time) int {
+	cutoff := now.Add(-sessionExpiration())
+	var stale []*uploadBatch
+	batches.Lock()
+	for k, b := range batches.m {
+		if b.touchedAt.Before(cutoff) {
+			stale = append(stale, b)
+			delete(batches.m, k)
+		}
+	}
+	batches.Unlock()
+	for _, b := range stale {
+		publishBatch(b, true)
+	}
+	return len(stale)
+}
@@ -0,0 +1,110 @@
+package upload
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrInvalidRelativePath is returned for relative paths that are absolute, empty or try to
+// leave the upload directory.
+var ErrInvalidRelativePath = errors.New("invalid relative path")
+
+// ResolveRelative joins a client-supplied relative path ("Trip/day 1/a.jpg", always with
+// forward slashes) onto dir. Absolute paths, "..", "." and empty segments are rejected rather
+// than cleaned away, so a malicious path never silently lands somewhere else.
+func ResolveRelative(dir string, rel string) (string, error) {
+	if rel == "" || strings.HasPrefix(rel, "/") || strings.ContainsAny(rel, "\\\x00") {
+		return "", ErrInvalidRelativePath
+	}
+	for _, seg := range strings.Split(rel, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return "", ErrInvalidRelativePath
+		}
+	}
+	base := filepath.Clean(dir)
+	p := filepath.Join(base, filepath.FromSlash(path.Clean(rel)))
+	if !strings.HasPrefix(p, base+string(filepath.Separator)) && base != string(filepath.Separator) {
+		return "", ErrInvalidRelativePath
+	}
+	return p, nil
+}
+
+// MkdirTree creates the directories of rel (slash separated, already validated) under base.
+// Unlike os.MkdirAll it refuses to traverse symlinks or non-directories, so an existing link
+// inside the tree cannot redirect the upload outside base. Concurrent uploads of the same tree
+// may race to create a directory; losing that race is not an error. If a later segment fails,
+// directories created by this call are removed again.
+func MkdirTree(base string, rel string) error {
+	base = filepath.Clean(base)
+	if err := os.MkdirAll(base, 0o755); err != nil {
+		return err
+	}
+	if rel == "" || rel == "." {
+		return nil
+	}
+	var created []string
+	rollback := func() {
+		for i := len(created) - 1; i >= 0; i-- {
+			_ = os.Remove(created[i])
+		}
+	}
+	cur := base
+	for _, seg := range strings.Split(rel, "/") {
+		cur = filepath.Join(cur, seg)
+		err := os.Mkdir(cur, 0o755)
+		if err == nil {
+			created = append(created, cur)
+			continue
+		}
+		if !os.IsExist(err) {
+			rollback()
+			return err
+		}
+		fi, lerr := os.Lstat(cur)
+		if lerr != nil {
+			rollback()
+			return lerr
+		}
+		if !fi.IsDir() {
+			rollback()
+			return fmt.Errorf("%w: %s is not a directory", ErrInvalidRelativePath, cur)
+		}
+	}
+	return nil
+}
+
+// PrepareDest resolves the destination of an uploaded file and applies the replace/rename
+// policy: with replace an existing file is overwritten, otherwise (or when a directory is in
+// the way) a unique "name (N).ext" is picked. rel may be empty for a plain file name upload.
+func PrepareDest(dir string, rel string, name string, replace bool) (dest string, replaced bool, renamed bool, err error) {
+	dir = filepath.Clean(dir)
+	if rel != "" {
+		dest, err = ResolveRelative(dir, rel)
+		if err != nil {
+			return "", false, false, err
+		}
+		if err := MkdirTree(dir, path.Dir(path.Clean(rel))); err != nil {
+			return "", false, false, err
+		}
+	} else {
+		if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+			return "", false, false, ErrInvalidRelativePath
+		}
+		dest = filepath.Join(dir, name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return "", false, false, err
+		}
+	}
+	fi, serr := os.Lstat(dest)
+	if serr != nil {
+		return dest, false, false, nil
+	}
+	if replace && fi.Mode().IsRegular() {
+		return dest, true, false, nil
+	}
+	return makeUniquePath(dest), false, true, nil
+}
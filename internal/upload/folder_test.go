@@ -0,0 +1,90 @@
+package upload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/pkg/eventbus"
+)
+
+func TestResolveRelative(t *testing.T) {
+	ok := map[string]string{
+		"a.jpg":            "/data/a.jpg",
+		"Trip/day 1/b.jpg": "/data/Trip/day 1/b.jpg",
+	}
+	for rel, want := range ok {
+		if got, err := ResolveRelative("/data", rel); err != nil || got != want {
+			t.Fatalf("ResolveRelative(%q) = %q, %v; want %q", rel, got, err, want)
+		}
+	}
+	for _, rel := range []string{"", "/etc/passwd", "../x", "a/../../x", "a//b", "./a", `a\b`, "a/.."} {
+		if _, err := ResolveRelative("/data", rel); !errors.Is(err, ErrInvalidRelativePath) {
+			t.Fatalf("ResolveRelative(%q) err = %v, want ErrInvalidRelativePath", rel, err)
+		}
+	}
+}
+
+func TestPrepareDestTree(t *testing.T) {
+	dir := t.TempDir()
+	dest, replaced, renamed, err := PrepareDest(dir, "Trip/day1/a.jpg", "a.jpg", false)
+	if err != nil || replaced || renamed || dest != filepath.Join(dir, "Trip", "day1", "a.jpg") {
+		t.Fatalf("PrepareDest = %q %v %v %v", dest, replaced, renamed, err)
+	}
+	if fi, err := os.Stat(filepath.Dir(dest)); err != nil || !fi.IsDir() {
+		t.Fatalf("intermediate dirs not created: %v", err)
+	}
+	if err := os.WriteFile(dest, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if d, _, renamed, _ := PrepareDest(dir, "Trip/day1/a.jpg", "a.jpg", false); !renamed || filepath.Base(d) != "a (1).jpg" {
+		t.Fatalf("expected rename, got %q", d)
+	}
+	if d, replaced, _, _ := PrepareDest(dir, "Trip/day1/a.jpg", "a.jpg", true); !replaced || d != dest {
+		t.Fatalf("expected replace, got %q", d)
+	}
+
+	// A symlink inside the tree must not redirect the upload.
+	outside := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(dir, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := PrepareDest(dir, "link/x/a.jpg", "a.jpg", false); !errors.Is(err, ErrInvalidRelativePath) {
+		t.Fatalf("err = %v, want ErrInvalidRelativePath", err)
+	}
+	if _, err := os.Stat(filepath.Join(outside, "x")); !os.IsNotExist(err) {
+		t.Fatalf("directory created through symlink")
+	}
+}
+
+func TestRecordBatchFile(t *testing.T) {
+	got := make(chan map[string]any, 1)
+	h := func(cid string, payload map[string]any) {
+		if cid == "c1" {
+			got <- payload
+		}
+	}
+	_ = eventbus.GetDefault().Subscribe(consts.EVENT_UPLOAD_BATCH_DONE, h)
+	defer func() { _ = eventbus.GetDefault().Unsubscribe(consts.EVENT_UPLOAD_BATCH_DONE, h) }()
+
+	RecordBatchFile("c1", "b1", 3, "/data", BatchFile{Path: "a", Size: 10})
+	RecordBatchFile("c1", "b1", 3, "/data", BatchFile{Path: "b", Err: errors.New("reset")})
+	// A retried file replaces its earlier outcome instead of counting twice.
+	RecordBatchFile("c1", "b1", 3, "/data", BatchFile{Path: "b", Size: 5, Renamed: true})
+	select {
+	case <-got:
+		t.Fatalf("summary published before the batch completed")
+	default:
+	}
+	RecordBatchFile("c1", "b1", 3, "/data", BatchFile{Path: BatchPath("/data", "/data/sub/c"), Err: errors.New("boom")})
+
+	p := <-got
+	if p["uploaded"] != 2 || p["failed"] != 1 || p["renamed"] != 1 || p["bytes"] != int64(15) || p["incomplete"] != false {
+		t.Fatalf("unexpected summary: %v", p)
+	}
+	if errs, _ := p["errors"].([]map[string]any); len(errs) != 1 || errs[0]["path"] != "sub/c" {
+		t.Fatalf("unexpected errors: %v", p["errors"])
+	}
+}
@@ -0,0 +1,146 @@
+package upload
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/fs"
+	"ismartcoding/plainnas/internal/media"
+	"ismartcoding/plainnas/internal/pkg/log"
+	"ismartcoding/plainnas/internal/pkg/shortid"
+)
+
+// ErrHashNotFound is returned by InstantUpload when no usable file has the requested content.
+var ErrHashNotFound = errors.New("no file with this content hash on the destination disk")
+
+// instantSource returns an indexed file with content sum that can be linked into dir,
+// which requires both to be on the same filesystem and that filesystem to support a reflink,
+// or a hardlink when those are enabled.
+func instantSource(sum string, size int64, dir string) *media.MediaFile {
+	for _, m := range media.FindByContentHash(sum, size) {
+		if (dir == "" || fs.SameFilesystem(m.Path, dir)) && canLink(m.Path) {
+			return &m
+		}
+	}
+	return nil
+}
+
+// instantHardlink reports whether instant uploads may hardlink (`upload.instant_hardlink`).
+var instantHardlink = func() bool {
+	return config.GetDefault().GetString("upload.instant_hardlink") == "true"
+}
+
+// canLink reports whether a file on the filesystem holding p can be created without copying
+// its data.
+func canLink(p string) bool {
+	return fs.CanReflink(p) || (instantHardlink() && fs.CanHardlink(p))
+}
+
+// CheckHashes returns the hashes (hex SHA-256) whose content is already on the NAS on a
+// filesystem that can reflink or hardlink it, so the client can call InstantUpload instead of
+// sending bytes. With dir set, only hashes that can be linked into dir count.
+func CheckHashes(hashes []string, dir string) []string {
+	if dir != "" {
+		dir = filepath.Clean(dir)
+	}
+	out := []string{}
+	seen := map[string]bool{}
+	for _, h := range hashes {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if seen[h] {
+			continue
+		}
+		seen[h] = true
+		if instantSource(h, -1, dir) != nil {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// InstantUpload creates path from an existing file with the same content instead of
+// receiving bytes. A reflink (copy-on-write clone) is preferred so the two files stay
+// independent. Filesystems without reflink support get a hardlink when
+// `upload.instant_hardlink = true` (hardlinked files share edits and inode-based identity);
+// otherwise the content is reported as not found and the client uploads the bytes.
+// Existing files are replaced or a unique name is picked, as for regular uploads.
+// Returns the final file name.
+func InstantUpload(sum string, size int64, path string, replace bool) (string, error) {
+	sum = strings.ToLower(strings.TrimSpace(sum))
+	if !media.ValidContentHash(sum) || strings.TrimSpace(path) == "" {
+		return "", fmt.Errorf("invalid arguments")
+	}
+	dest := filepath.Clean(path)
+	if !filepath.IsAbs(dest) {
+		return "", fmt.Errorf("path must be absolute")
+	}
+	src := instantSource(sum, size, filepath.Dir(dest))
+	if src == nil {
+		return "", ErrHashNotFound
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return "", err
+	}
+	if !replace {
+		dest = makeUniquePath(dest)
+	}
+	if dest == filepath.Clean(src.Path) {
+		// Re-uploading a file onto itself.
+		return filepath.Base(dest), nil
+	}
+
+	staging := filepath.Join(filepath.Dir(dest), ".plainnas-upload-"+shortid.New()+".part")
+	if err := linkOrClone(src.Path, staging); err != nil {
+		return "", err
+	}
+	if err := os.Rename(staging, dest); err != nil {
+		_ = os.Remove(staging)
+		return "", err
+	}
+
+	RecordUpload(dest, sum)
+	log.Infof("[upload] instant upload %q from %q", dest, src.Path)
+	return filepath.Base(dest), nil
+}
+
+// RecordUpload indexes a newly uploaded file and remembers its content hash for later
+// instant uploads. sum may be empty when the hash is not known yet.
+func RecordUpload(path string, sum string) {
+	if err := media.ScanFile(path); err != nil {
+		log.Errorf("[upload] index file error for %q: %v", path, err)
+		return
+	}
+	if sum == "" {
+		var err error
+		if sum, err = media.HashFile(path); err != nil {
+			log.Errorf("[upload] hash file error for %q: %v", path, err)
+			return
+		}
+	}
+	if err := media.RecordContentHash(path, sum); err != nil {
+		log.Errorf("[upload] record hash error for %q: %v", path, err)
+	}
+}
+
+func linkOrClone(src, dst string) error {
+	err := fs.Reflink(src, dst)
+	if errors.Is(err, fs.ErrCloneUnsupported) {
+		if !instantHardlink() {
+			// An XFS volume formatted without reflink support.
+			return ErrHashNotFound
+		}
+		return os.Link(src, dst)
+	}
+	if err != nil {
+		return err
+	}
+	// A clone is a new inode; keep the original timestamps like the uploaded file would have.
+	if fi, serr := os.Stat(src); serr == nil {
+		_ = os.Chtimes(dst, fi.ModTime(), fi.ModTime())
+	}
+	return nil
+}
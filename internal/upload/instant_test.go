@@ -0,0 +1,62 @@
+package upload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"ismartcoding/plainnas/internal/fs"
+)
+
+func TestInstantUpload(t *testing.T) {
+	// The test directory may not support reflinks; hardlinks work everywhere it can live.
+	defer func(f func() bool) { instantHardlink = f }(instantHardlink)
+	instantHardlink = func() bool { return true }
+
+	dir := t.TempDir()
+	content := []byte("camera roll photo")
+	src := filepath.Join(dir, "IMG_0001.jpg")
+	if err := os.WriteFile(src, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256Hex(content)
+	RecordUpload(src, sum)
+
+	missing := sha256Hex([]byte("not uploaded"))
+	if got := CheckHashes([]string{sum, missing, sum}, dir); !reflect.DeepEqual(got, []string{sum}) {
+		t.Fatalf("CheckHashes = %v", got)
+	}
+
+	name, err := InstantUpload(sum, int64(len(content)), filepath.Join(dir, "again", "IMG_0001.jpg"), false)
+	if err != nil {
+		t.Fatalf("InstantUpload: %v", err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "again", name))
+	if err != nil || string(got) != string(content) {
+		t.Fatalf("content = %q, err = %v", got, err)
+	}
+
+	// Same path again without replace gets a unique name.
+	if name, err := InstantUpload(sum, int64(len(content)), src, false); err != nil || name != "IMG_0001 (1).jpg" {
+		t.Fatalf("name = %q, err = %v", name, err)
+	}
+
+	if !fs.CanReflink(dir) {
+		// Without reflinks and hardlinks the content cannot be reused without copying it.
+		instantHardlink = func() bool { return false }
+		if got := CheckHashes([]string{sum}, dir); len(got) != 0 {
+			t.Fatalf("CheckHashes without hardlinks = %v", got)
+		}
+		instantHardlink = func() bool { return true }
+	}
+
+	if _, err := InstantUpload(missing, 1, filepath.Join(dir, "x"), false); !errors.Is(err, ErrHashNotFound) {
+		t.Fatalf("err = %v, want ErrHashNotFound", err)
+	}
+	// A size that does not match the indexed file is not a match either.
+	if _, err := InstantUpload(sum, 1, filepath.Join(dir, "y"), false); !errors.Is(err, ErrHashNotFound) {
+		t.Fatalf("err = %v, want ErrHashNotFound", err)
+	}
+}
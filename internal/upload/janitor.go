@@ -0,0 +1,34 @@
+package upload
+
+import (
+	"context"
+	"time"
+
+	"ismartcoding/plainnas/internal/pkg/log"
+)
+
+const janitorInterval = 30 * time.Minute
+
+// RunJanitor periodically removes expired tus uploads and stale chunked uploads,
+// together with their staging data, and closes abandoned upload batches. It returns when ctx is done.
+func RunJanitor(ctx context.Context) {
+	ticker := time.NewTicker(janitorInterval)
+	defer ticker.Stop()
+	for {
+		now := time.Now().UTC()
+		if n := CleanupExpiredTus(now); n > 0 {
+			log.Infof("[upload] removed %d expired tus uploads", n)
+		}
+		if n := CleanupStaleSessions(now); n > 0 {
+			log.Infof("[upload] removed %d stale chunked uploads", n)
+		}
+		if n := CleanupStaleBatches(now); n > 0 {
+			log.Infof("[upload] closed %d incomplete upload batches", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
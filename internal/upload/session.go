@@ -0,0 +1,472 @@
+package upload
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/consts"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/fs"
+	"ismartcoding/plainnas/internal/pkg/log"
+)
+
+const sessionKeyPrefix = "upload:session:"
+
+// defaultSessionExpiration is how long a chunked upload may stay idle before its chunks are removed.
+// Override with `upload.chunk_expiration_hours` in config.toml.
+const defaultSessionExpiration = 24 * time.Hour
+
+var (
+	ErrInvalidFileID       = errors.New("invalid fileId")
+	ErrChunkHashMismatch   = errors.New("chunk hash mismatch")
+	ErrInsufficientSpace   = errors.New("not enough free space on the destination disk")
+	ErrSessionBusy         = errors.New("upload is being merged")
+	errChunkHashMalformed  = errors.New("chunk hash must be a hex sha256 digest")
+	errChunkMissing        = errors.New("missing chunk")
+	errChunkSizeMismatched = errors.New("chunk size changed since upload")
+)
+
+// ChunkRecord describes a chunk that was fully received.
+type ChunkRecord struct {
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ChunkSession is the persisted state of a chunked upload (POST /upload_chunk + mergeChunks).
+// Chunks live in ChunkDir(FileID); the session tracks what was received so stale uploads can
+// be expired and chunks can be verified before they are merged.
+type ChunkSession struct {
+	FileID string `json:"file_id"`
+	// Path and Size are optional hints sent with the chunks; they enable the free-space check
+	// before any data is written.
+	Path      string               `json:"path,omitempty"`
+	Size      int64                `json:"size,omitempty"`
+	Chunks    map[int]*ChunkRecord `json:"chunks"`
+	CreatedAt time.Time            `json:"created_at"`
+	TouchedAt time.Time            `json:"touched_at"`
+}
+
+// ChunkInfo is what a client tells about a chunk it is uploading.
+type ChunkInfo struct {
+	FileID string
+	Index  int
+	// Hash is the optional hex sha256 of the chunk, checked on receipt.
+	Hash string
+	// Path is the optional final destination, Size the optional total file size.
+	Path string
+	Size int64
+}
+
+// ChunkDir returns the staging directory of a chunked upload.
+func ChunkDir(fileID string) string {
+	return filepath.Join(consts.DATA_DIR, "upload_tmp", fileID)
+}
+
+func chunkPath(fileID string, index int) string {
+	return filepath.Join(ChunkDir(fileID), "chunk_"+strconv.Itoa(index))
+}
+
+func sessionKey(fileID string) string {
+	return sessionKeyPrefix + fileID
+}
+
+func sessionExpiration() time.Duration {
+	if h := config.GetDefault().GetInt("upload.chunk_expiration_hours"); h > 0 {
+		return time.Duration(h) * time.Hour
+	}
+	return defaultSessionExpiration
+}
+
+// validFileID rejects ids that would escape the upload_tmp directory.
+func validFileID(fileID string) bool {
+	return fileID != "" && fileID != "." && fileID != ".." && !strings.ContainsAny(fileID, `/\`)
+}
+
+// sessionLocks serializes the work on one upload session. An entry is dropped once no caller
+// holds or waits for it, so finished uploads leave nothing behind.
+var sessionLocks = struct {
+	sync.Mutex
+	m map[string]*sessionLockEntry
+}{m: map[string]*sessionLockEntry{}}
+
+type sessionLockEntry struct {
+	sync.Mutex
+	refs int
+}
+
+// lockSession locks the session of fileID and returns the function that unlocks it.
+func lockSession(fileID string) (unlock func()) {
+	e := acquireSessionLock(fileID)
+	e.Lock()
+	return func() { releaseSessionLock(fileID, e, true) }
+}
+
+// tryLockSession is lockSession without waiting; ok is false when the session is busy.
+func tryLockSession(fileID string) (unlock func(), ok bool) {
+	e := acquireSessionLock(fileID)
+	if !e.TryLock() {
+		releaseSessionLock(fileID, e, false)
+		return nil, false
+	}
+	return func() { releaseSessionLock(fileID, e, true) }, true
+}
+
+func acquireSessionLock(fileID string) *sessionLockEntry {
+	sessionLocks.Lock()
+	defer sessionLocks.Unlock()
+	e := sessionLocks.m[fileID]
+	if e == nil {
+		e = &sessionLockEntry{}
+		sessionLocks.m[fileID] = e
+	}
+	e.refs++
+	return e
+}
+
+func releaseSessionLock(fileID string, e *sessionLockEntry, locked bool) {
+	if locked {
+		e.Unlock()
+	}
+	sessionLocks.Lock()
+	defer sessionLocks.Unlock()
+	if e.refs--; e.refs == 0 {
+		delete(sessionLocks.m, fileID)
+	}
+}
+
+// GetSession loads the session of fileID, or nil if there is none.
+func GetSession(fileID string) *ChunkSession {
+	var s ChunkSession
+	if err := db.GetDefault().LoadJSON(sessionKey(fileID), &s); err != nil || s.FileID == "" {
+		return nil
+	}
+	if s.Chunks == nil {
+		s.Chunks = map[int]*ChunkRecord{}
+	}
+	return &s
+}
+
+func saveSession(s *ChunkSession) error {
+	return db.GetDefault().StoreJSON(sessionKey(s.FileID), s)
+}
+
+func removeSession(fileID string) {
+	_ = os.RemoveAll(ChunkDir(fileID))
+	_ = db.GetDefault().DeleteByKey(sessionKey(fileID))
+}
+
+// EnsureFreeSpace returns ErrInsufficientSpace if fewer than need bytes are available on the
+// filesystem that would hold path.
+func EnsureFreeSpace(path string, need int64) error {
+	if need <= 0 {
+		return nil
+	}
+	avail, err := fs.FreeSpace(path)
+	if err != nil {
+		// Unknown is not the same as full; let the write itself fail if it must.
+		log.Debugf("[upload] free space check for %q failed: %v", path, err)
+		return nil
+	}
+	if uint64(need) > avail {
+		return fmt.Errorf("%w: need %d bytes, %d available", ErrInsufficientSpace, need, avail)
+	}
+	return nil
+}
+
+// SaveChunk stores one chunk of a chunked upload and records it in the session.
+// The chunk is written to a temp file and renamed, so a chunk file that exists is complete.
+func SaveChunk(info ChunkInfo, r io.Reader) (*ChunkRecord, error) {
+	if !validFileID(info.FileID) || info.Index < 0 {
+		return nil, ErrInvalidFileID
+	}
+	want := strings.ToLower(strings.TrimSpace(info.Hash))
+	if want != "" {
+		if b, err := hex.DecodeString(want); err != nil || len(b) != sha256.Size {
+			return nil, errChunkHashMalformed
+		}
+	}
+
+	if err := touchSession(info); err != nil {
+		return nil, err
+	}
+
+	dir := ChunkDir(info.FileID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	final := chunkPath(info.FileID, info.Index)
+	tmp, err := os.CreateTemp(dir, filepath.Base(final)+".*.part")
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tmp, h), r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return nil, err
+	}
+	rec := &ChunkRecord{Size: n, SHA256: hex.EncodeToString(h.Sum(nil))}
+	if want != "" && want != rec.SHA256 {
+		_ = os.Remove(tmp.Name())
+		return nil, ErrChunkHashMismatch
+	}
+	if err := os.Rename(tmp.Name(), final); err != nil {
+		_ = os.Remove(tmp.Name())
+		return nil, err
+	}
+
+	unlock := lockSession(info.FileID)
+	defer unlock()
+	s := GetSession(info.FileID)
+	if s == nil {
+		// Expired while the chunk was in flight.
+		_ = os.Remove(final)
+		return nil, ErrInvalidFileID
+	}
+	s.Chunks[info.Index] = rec
+	s.TouchedAt = time.Now().UTC()
+	if err := saveSession(s); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// touchSession creates or refreshes the session before a chunk is written.
+// The first time a destination and total size are known, free space is checked so a
+// doomed upload is rejected before any data is transferred.
+func touchSession(info ChunkInfo) error {
+	unlock := lockSession(info.FileID)
+	defer unlock()
+
+	now := time.Now().UTC()
+	s := GetSession(info.FileID)
+	if s == nil {
+		s = &ChunkSession{FileID: info.FileID, Chunks: map[int]*ChunkRecord{}, CreatedAt: now}
+	}
+	if s.Size == 0 && info.Size > 0 && info.Path != "" {
+		if err := EnsureFreeSpace(filepath.Dir(filepath.Clean(info.Path)), info.Size); err != nil {
+			return err
+		}
+		// Chunks are staged under DATA_DIR before merging, which may be a different disk.
+		if err := EnsureFreeSpace(ChunkDir(info.FileID), info.Size); err != nil {
+			return err
+		}
+		s.Path = filepath.Clean(info.Path)
+		s.Size = info.Size
+	}
+	s.TouchedAt = now
+	return saveSession(s)
+}
+
+// UploadedChunks returns the indexes of chunks already received for fileID, sorted.
+func UploadedChunks(fileID string) []int {
+	if !validFileID(fileID) {
+		return []int{}
+	}
+	out := []int{}
+	if s := GetSession(fileID); s != nil {
+		for i := range s.Chunks {
+			if _, err := os.Stat(chunkPath(fileID, i)); err == nil {
+				out = append(out, i)
+			}
+		}
+		sort.Ints(out)
+		return out
+	}
+
+	// Chunks written before sessions were tracked.
+	entries, err := os.ReadDir(ChunkDir(fileID))
+	if err != nil {
+		return out
+	}
+	for _, e := range entries {
+		if idx, ok := strings.CutPrefix(e.Name(), "chunk_"); ok {
+			if v, err := strconv.Atoi(idx); err == nil {
+				out = append(out, v)
+			}
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+// MergeChunks assembles chunks 0..totalChunks-1 into path and indexes the result.
+// Chunks recorded in the session are re-hashed while copying; a chunk that no longer
+// matches is dropped (so the client re-uploads it) and the merge fails. The file is built
+// in a hidden staging file next to the destination and renamed into place on success.
+// Returns the final file name and whether it replaced an existing file.
+func MergeChunks(fileID string, totalChunks int, path string, replace bool) (name string, replaced bool, err error) {
+	if strings.TrimSpace(path) == "" || totalChunks <= 0 {
+		return "", false, fmt.Errorf("invalid arguments")
+	}
+	if !validFileID(fileID) {
+		return "", false, ErrInvalidFileID
+	}
+	unlock, ok := tryLockSession(fileID)
+	if !ok {
+		return "", false, ErrSessionBusy
+	}
+	defer unlock()
+
+	s := GetSession(fileID)
+	var total int64
+	for i := 0; i < totalChunks; i++ {
+		fi, err := os.Stat(chunkPath(fileID, i))
+		if err != nil {
+			return "", false, fmt.Errorf("%w %d", errChunkMissing, i)
+		}
+		total += fi.Size()
+	}
+
+	dest := filepath.Clean(path)
+	if !replace {
+		dest = makeUniquePath(dest)
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return "", false, err
+	}
+	need := total
+	if replace {
+		if fi, err := os.Stat(dest); err == nil && fi.Mode().IsRegular() {
+			need -= fi.Size()
+		}
+	}
+	if err := EnsureFreeSpace(filepath.Dir(dest), need); err != nil {
+		return "", false, err
+	}
+
+	staging := filepath.Join(filepath.Dir(dest), ".plainnas-upload-"+fileID+".part")
+	out, err := os.OpenFile(staging, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return "", false, err
+	}
+	// The whole-file hash feeds the content hash index used by instant uploads.
+	fileHash := sha256.New()
+	w := io.MultiWriter(out, fileHash)
+	fail := func(err error) (string, bool, error) {
+		_ = out.Close()
+		_ = os.Remove(staging)
+		return "", false, err
+	}
+	for i := 0; i < totalChunks; i++ {
+		var rec *ChunkRecord
+		if s != nil {
+			rec = s.Chunks[i]
+		}
+		if err := appendChunk(w, fileID, i, rec); err != nil {
+			if s != nil && (errors.Is(err, ErrChunkHashMismatch) || errors.Is(err, errChunkSizeMismatched)) {
+				log.Errorf("[upload] chunk %d of %s is corrupted: %v", i, fileID, err)
+				_ = os.Remove(chunkPath(fileID, i))
+				delete(s.Chunks, i)
+				_ = saveSession(s)
+			}
+			return fail(fmt.Errorf("chunk %d: %w", i, err))
+		}
+	}
+	if err := out.Sync(); err != nil {
+		return fail(err)
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(staging)
+		return "", false, err
+	}
+	if replace {
+		if fi, err := os.Lstat(dest); err == nil && fi.Mode().IsRegular() {
+			replaced = true
+		}
+	}
+	if err := os.Rename(staging, dest); err != nil {
+		_ = os.Remove(staging)
+		return "", false, err
+	}
+
+	removeSession(fileID)
+	RecordUpload(dest, hex.EncodeToString(fileHash.Sum(nil)))
+	return filepath.Base(dest), replaced, nil
+}
+
+func appendChunk(out io.Writer, fileID string, index int, rec *ChunkRecord) error {
+	f, err := os.Open(chunkPath(fileID, index))
+	if err != nil {
+		return errChunkMissing
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(out, h), f)
+	if err != nil {
+		return err
+	}
+	if rec == nil {
+		return nil
+	}
+	if n != rec.Size {
+		return errChunkSizeMismatched
+	}
+	if hex.EncodeToString(h.Sum(nil)) != rec.SHA256 {
+		return ErrChunkHashMismatch
+	}
+	return nil
+}
+
+// CleanupStaleSessions removes chunked uploads not touched within the expiration window,
+// and chunk directories older than that which have no session at all.
+// Returns the number of uploads removed.
+func CleanupStaleSessions(now time.Time) int {
+	cutoff := now.Add(-sessionExpiration())
+	known := map[string]bool{}
+	var stale []string
+	_ = db.GetDefault().Iterate([]byte(sessionKeyPrefix), func(_ []byte, value []byte) error {
+		var s ChunkSession
+		if err := json.Unmarshal(value, &s); err != nil || s.FileID == "" {
+			return nil
+		}
+		known[s.FileID] = true
+		if s.TouchedAt.Before(cutoff) {
+			stale = append(stale, s.FileID)
+		}
+		return nil
+	})
+
+	removed := 0
+	for _, id := range stale {
+		unlock, ok := tryLockSession(id)
+		if !ok {
+			continue
+		}
+		removeSession(id)
+		unlock()
+		removed++
+	}
+
+	entries, err := os.ReadDir(filepath.Join(consts.DATA_DIR, "upload_tmp"))
+	if err != nil {
+		return removed
+	}
+	for _, e := range entries {
+		if !e.IsDir() || known[e.Name()] {
+			continue
+		}
+		fi, err := e.Info()
+		if err != nil || !fi.ModTime().Before(cutoff) {
+			continue
+		}
+		_ = os.RemoveAll(filepath.Join(consts.DATA_DIR, "upload_tmp", e.Name()))
+		removed++
+	}
+	return removed
+}
@@ -0,0 +1,113 @@
+package upload
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestChunkSessionMerge(t *testing.T) {
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "out.bin")
+	parts := [][]byte{[]byte("hello "), []byte("chunked "), []byte("world")}
+
+	for i, p := range parts {
+		info := ChunkInfo{FileID: "merge1", Index: i, Hash: sha256Hex(p), Path: dest, Size: 19}
+		if _, err := SaveChunk(info, bytes.NewReader(p)); err != nil {
+			t.Fatalf("SaveChunk %d: %v", i, err)
+		}
+	}
+	if got := UploadedChunks("merge1"); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Fatalf("UploadedChunks = %v", got)
+	}
+
+	// A chunk whose content does not match the announced hash is rejected.
+	if _, err := SaveChunk(ChunkInfo{FileID: "merge1", Index: 3, Hash: sha256Hex([]byte("x"))}, bytes.NewReader([]byte("y"))); !errors.Is(err, ErrChunkHashMismatch) {
+		t.Fatalf("err = %v, want ErrChunkHashMismatch", err)
+	}
+
+	name, replaced, err := MergeChunks("merge1", len(parts), dest, false)
+	if err != nil {
+		t.Fatalf("MergeChunks: %v", err)
+	}
+	if name != "out.bin" || replaced {
+		t.Fatalf("name = %q, replaced = %v", name, replaced)
+	}
+	if b, _ := os.ReadFile(dest); string(b) != "hello chunked world" {
+		t.Fatalf("merged content = %q", b)
+	}
+	if GetSession("merge1") != nil {
+		t.Fatalf("session should be removed after merge")
+	}
+	if _, err := os.Stat(ChunkDir("merge1")); !os.IsNotExist(err) {
+		t.Fatalf("chunk dir should be removed, stat err = %v", err)
+	}
+	sessionLocks.Lock()
+	_, locked := sessionLocks.m["merge1"]
+	sessionLocks.Unlock()
+	if locked {
+		t.Fatalf("session lock should be dropped after merge")
+	}
+}
+
+func TestMergeDetectsCorruptedChunk(t *testing.T) {
+	dest := filepath.Join(t.TempDir(), "out.bin")
+	for i, p := range [][]byte{[]byte("aaaa"), []byte("bbbb")} {
+		if _, err := SaveChunk(ChunkInfo{FileID: "corrupt1", Index: i}, bytes.NewReader(p)); err != nil {
+			t.Fatalf("SaveChunk %d: %v", i, err)
+		}
+	}
+	if err := os.WriteFile(chunkPath("corrupt1", 1), []byte("bbbc"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := MergeChunks("corrupt1", 2, dest, false); !errors.Is(err, ErrChunkHashMismatch) {
+		t.Fatalf("err = %v, want ErrChunkHashMismatch", err)
+	}
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		t.Fatalf("destination must not exist after a failed merge")
+	}
+	// The corrupted chunk is dropped so the client uploads it again.
+	if got := UploadedChunks("corrupt1"); !reflect.DeepEqual(got, []int{0}) {
+		t.Fatalf("UploadedChunks = %v", got)
+	}
+}
+
+func TestSaveChunkRejectsBadInput(t *testing.T) {
+	if _, err := SaveChunk(ChunkInfo{FileID: "../x", Index: 0}, bytes.NewReader(nil)); !errors.Is(err, ErrInvalidFileID) {
+		t.Fatalf("err = %v, want ErrInvalidFileID", err)
+	}
+	info := ChunkInfo{FileID: "big1", Index: 0, Path: filepath.Join(t.TempDir(), "f"), Size: 1 << 62}
+	if _, err := SaveChunk(info, bytes.NewReader([]byte("x"))); !errors.Is(err, ErrInsufficientSpace) {
+		t.Fatalf("err = %v, want ErrInsufficientSpace", err)
+	}
+}
+
+func TestCleanupStaleSessions(t *testing.T) {
+	if _, err := SaveChunk(ChunkInfo{FileID: "stale1", Index: 0}, bytes.NewReader([]byte("x"))); err != nil {
+		t.Fatalf("SaveChunk: %v", err)
+	}
+	if n := CleanupStaleSessions(time.Now().UTC()); n != 0 {
+		t.Fatalf("fresh session removed (n=%d)", n)
+	}
+	if n := CleanupStaleSessions(time.Now().UTC().Add(48 * time.Hour)); n < 1 {
+		t.Fatalf("stale session not removed")
+	}
+	if GetSession("stale1") != nil {
+		t.Fatalf("session record should be removed")
+	}
+	if _, err := os.Stat(ChunkDir("stale1")); !os.IsNotExist(err) {
+		t.Fatalf("chunk dir should be removed, stat err = %v", err)
+	}
+}
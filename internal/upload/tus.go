@@ -0,0 +1,405 @@
+package upload
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ismartcoding/plainnas/internal/config"
+	"ismartcoding/plainnas/internal/db"
+	"ismartcoding/plainnas/internal/pkg/log"
+	"ismartcoding/plainnas/internal/pkg/shortid"
+)
+
+// TusVersion is the only tus protocol version implemented.
+const TusVersion = "1.0.0"
+
+// TusExtensions lists the supported tus protocol extensions.
+const TusExtensions = "creation,termination,checksum,expiration"
+
+// TusChecksumAlgorithms lists the algorithms accepted in Upload-Checksum.
+const TusChecksumAlgorithms = "sha1,sha256,md5"
+
+const tusKeyPrefix = "tus:"
+
+// defaultTusExpiration is how long an upload stays resumable after its last write.
+// Override with `upload.tus_expiration_hours` in config.toml.
+const defaultTusExpiration = 24 * time.Hour
+
+var (
+	ErrTusNotFound         = errors.New("upload not found")
+	ErrTusExpired          = errors.New("upload expired")
+	ErrTusOffsetMismatch   = errors.New("offset mismatch")
+	ErrTusChecksumMismatch = errors.New("checksum mismatch")
+	ErrTusBadChecksum      = errors.New("unsupported checksum")
+	ErrTusTooLarge         = errors.New("body exceeds upload length")
+	ErrTusLocked           = errors.New("upload is locked by another request")
+	ErrTusForbidden        = errors.New("upload belongs to another client")
+)
+
+// TusUpload is the persisted state of a tus upload.
+// Bytes are written in place to StagingPath, a hidden file in the destination
+// directory, so completing the upload is a same-filesystem rename.
+type TusUpload struct {
+	ID          string            `json:"id"`
+	ClientID    string            `json:"client_id"`
+	Length      int64             `json:"length"`
+	Offset      int64             `json:"offset"`
+	Metadata    map[string]string `json:"metadata"`
+	Dest        string            `json:"dest"`
+	Replace     bool              `json:"replace"`
+	StagingPath string            `json:"staging_path"`
+	// FinalPath is set once the upload completed and was moved into place.
+	FinalPath string    `json:"final_path,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Done reports whether all bytes were received.
+func (u *TusUpload) Done() bool {
+	return u.Offset >= u.Length
+}
+
+func tusKey(id string) string {
+	return tusKeyPrefix + id
+}
+
+func tusExpiration() time.Duration {
+	if h := config.GetDefault().GetInt("upload.tus_expiration_hours"); h > 0 {
+		return time.Duration(h) * time.Hour
+	}
+	return defaultTusExpiration
+}
+
+var tusLocks sync.Map // id -> *sync.Mutex
+
+func lockTus(id string) (func(), bool) {
+	v, _ := tusLocks.LoadOrStore(id, &sync.Mutex{})
+	mu := v.(*sync.Mutex)
+	if !mu.TryLock() {
+		return nil, false
+	}
+	return mu.Unlock, true
+}
+
+// ParseTusMetadata decodes an Upload-Metadata header ("key b64value,key2 b64value2").
+func ParseTusMetadata(header string) (map[string]string, error) {
+	out := map[string]string{}
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, encoded, _ := strings.Cut(pair, " ")
+		if key == "" {
+			return nil, fmt.Errorf("invalid Upload-Metadata")
+		}
+		val, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("invalid Upload-Metadata value for %q", key)
+		}
+		out[key] = string(val)
+	}
+	return out, nil
+}
+
+// CreateTus registers a new upload of length bytes.
+// Metadata must contain "filename" (or "name") and "dir"; "replace" = "true" overwrites
+// an existing file on completion instead of picking a unique name.
+func CreateTus(clientID string, length int64, meta map[string]string) (*TusUpload, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("invalid Upload-Length")
+	}
+	name := strings.TrimSpace(meta["filename"])
+	if name == "" {
+		name = strings.TrimSpace(meta["name"])
+	}
+	dir := strings.TrimSpace(meta["dir"])
+	if name == "" || dir == "" {
+		return nil, fmt.Errorf("metadata must include filename and dir")
+	}
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		return nil, fmt.Errorf("invalid filename")
+	}
+	dir = filepath.Clean(dir)
+	if !filepath.IsAbs(dir) {
+		return nil, fmt.Errorf("dir must be absolute")
+	}
+	if err := EnsureFreeSpace(dir, length); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	u := &TusUpload{
+		ID:        shortid.New(),
+		ClientID:  clientID,
+		Length:    length,
+		Metadata:  meta,
+		Dest:      filepath.Join(dir, name),
+		Replace:   strings.EqualFold(strings.TrimSpace(meta["replace"]), "true"),
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(tusExpiration()),
+	}
+	u.StagingPath = filepath.Join(dir, ".plainnas-upload-"+u.ID+".part")
+
+	f, err := os.OpenFile(u.StagingPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	_ = f.Close()
+
+	if err := saveTus(u); err != nil {
+		_ = os.Remove(u.StagingPath)
+		return nil, err
+	}
+	// Empty uploads are complete as soon as they are created.
+	if u.Done() {
+		if err := finishTus(u); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+func saveTus(u *TusUpload) error {
+	return db.GetDefault().StoreJSON(tusKey(u.ID), u)
+}
+
+// GetTus loads an upload for clientID.
+func GetTus(clientID string, id string) (*TusUpload, error) {
+	var u TusUpload
+	if err := db.GetDefault().LoadJSON(tusKey(id), &u); err != nil || u.ID == "" {
+		return nil, ErrTusNotFound
+	}
+	if u.ClientID != clientID {
+		return nil, ErrTusForbidden
+	}
+	if u.FinalPath == "" && time.Now().UTC().After(u.ExpiresAt) {
+		return nil, ErrTusExpired
+	}
+	return &u, nil
+}
+
+// TusChecksum is a parsed Upload-Checksum header.
+type TusChecksum struct {
+	h    hash.Hash
+	want []byte
+}
+
+// ParseTusChecksum parses "<algorithm> <base64 digest>".
+func ParseTusChecksum(header string) (*TusChecksum, error) {
+	alg, encoded, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok {
+		return nil, ErrTusBadChecksum
+	}
+	want, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, ErrTusBadChecksum
+	}
+	var h hash.Hash
+	switch strings.ToLower(alg) {
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	case "md5":
+		h = md5.New()
+	default:
+		return nil, ErrTusBadChecksum
+	}
+	return &TusChecksum{h: h, want: want}, nil
+}
+
+// WriteTus appends body to the upload at offset, which must equal the current offset.
+// Without a checksum, bytes received before a dropped connection are kept so the client
+// can resume from there. With a checksum, the whole request is rolled back unless the
+// digest matches. When the last byte arrives the staging file is moved into place.
+func WriteTus(clientID string, id string, offset int64, body io.Reader, sum *TusChecksum) (*TusUpload, error) {
+	unlock, ok := lockTus(id)
+	if !ok {
+		return nil, ErrTusLocked
+	}
+	defer unlock()
+
+	u, err := GetTus(clientID, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.FinalPath != "" || offset != u.Offset {
+		return u, ErrTusOffsetMismatch
+	}
+
+	f, err := os.OpenFile(u.StagingPath, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	rollback := func() {
+		_ = f.Truncate(offset)
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	remaining := u.Length - offset
+	var w io.Writer = f
+	if sum != nil {
+		w = io.MultiWriter(f, sum.h)
+	}
+	// Read one byte past the announced length to detect oversized bodies.
+	n, copyErr := io.Copy(w, io.LimitReader(body, remaining+1))
+	if n > remaining {
+		rollback()
+		f.Close()
+		return u, ErrTusTooLarge
+	}
+	if sum != nil && (copyErr != nil || !hashEqual(sum.h.Sum(nil), sum.want)) {
+		rollback()
+		f.Close()
+		if copyErr != nil {
+			return u, copyErr
+		}
+		return u, ErrTusChecksumMismatch
+	}
+	if err := f.Sync(); err != nil && copyErr == nil {
+		copyErr = err
+	}
+	_ = f.Close()
+
+	now := time.Now().UTC()
+	u.Offset = offset + n
+	u.UpdatedAt = now
+	u.ExpiresAt = now.Add(tusExpiration())
+	if err := saveTus(u); err != nil {
+		return u, err
+	}
+	if copyErr != nil {
+		return u, copyErr
+	}
+	if u.Done() {
+		if err := finishTus(u); err != nil {
+			return u, err
+		}
+	}
+	return u, nil
+}
+
+func hashEqual(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	var v byte
+	for i := range a {
+		v |= a[i] ^ b[i]
+	}
+	return v == 0
+}
+
+// finishTus moves the staging file to its destination and indexes it.
+// The record is kept (with FinalPath) until it expires so a client retrying the last
+// PATCH or issuing HEAD still sees a completed upload.
+func finishTus(u *TusUpload) error {
+	dest := u.Dest
+	if !u.Replace {
+		dest = makeUniquePath(dest)
+	}
+	if err := os.Rename(u.StagingPath, dest); err != nil {
+		return err
+	}
+	u.FinalPath = dest
+	if err := saveTus(u); err != nil {
+		return err
+	}
+	RecordUpload(dest, "")
+	log.Infof("[tus] upload %s completed path=%q", u.ID, dest)
+	return nil
+}
+
+// TerminateTus deletes an upload and its staging data.
+func TerminateTus(clientID string, id string) error {
+	unlock, ok := lockTus(id)
+	if !ok {
+		return ErrTusLocked
+	}
+	defer unlock()
+
+	u, err := GetTus(clientID, id)
+	if err != nil {
+		return err
+	}
+	removeTus(u)
+	return nil
+}
+
+func removeTus(u *TusUpload) {
+	if u.FinalPath == "" && u.StagingPath != "" {
+		_ = os.Remove(u.StagingPath)
+	}
+	_ = db.GetDefault().Delete([]byte(tusKey(u.ID)))
+	tusLocks.Delete(u.ID)
+}
+
+// CleanupExpiredTus removes uploads whose expiration passed, along with their staging files.
+// Returns the number of uploads removed.
+func CleanupExpiredTus(now time.Time) int {
+	var expired []*TusUpload
+	_ = db.GetDefault().Iterate([]byte(tusKeyPrefix), func(_ []byte, value []byte) error {
+		var u TusUpload
+		if err := json.Unmarshal(value, &u); err != nil || u.ID == "" {
+			return nil
+		}
+		if now.After(u.ExpiresAt) {
+			expired = append(expired, &u)
+		}
+		return nil
+	})
+	removed := 0
+	for _, u := range expired {
+		unlock, ok := lockTus(u.ID)
+		if !ok {
+			continue
+		}
+		removeTus(u)
+		unlock()
+		removed++
+	}
+	return removed
+}
+
+// makeUniquePath returns path, or "name (N).ext" if path already exists.
+func makeUniquePath(path string) string {
+	if _, err := os.Stat(path); err != nil {
+		return path
+	}
+	dir := filepath.Dir(path)
+	base := filepath.Base(path)
+	name := base
+	ext := ""
+	if i := strings.LastIndex(base, "."); i > 0 {
+		name = base[:i]
+		ext = base[i:]
+	}
+	for i := 1; ; i++ {
+		candidate := filepath.Join(dir, name+" ("+strconv.Itoa(i)+")"+ext)
+		if _, err := os.Stat(candidate); os.IsNotExist(err) {
+			return candidate
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package upload
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ismartcoding/plainnas/internal/consts"
+)
+
+func TestMain(m *testing.M) {
+	tmp, err := os.MkdirTemp("", "plainnas-upload-test-*")
+	if err != nil {
+		panic(err)
+	}
+	consts.DATA_DIR = tmp
+	code := m.Run()
+	_ = os.RemoveAll(tmp)
+	os.Exit(code)
+}
+
+func TestParseTusMetadata(t *testing.T) {
+	raw := "filename " + base64.StdEncoding.EncodeToString([]byte("a b.txt")) + ",dir " + base64.StdEncoding.EncodeToString([]byte("/x")) + ",empty"
+	meta, err := ParseTusMetadata(raw)
+	if err != nil {
+		t.Fatalf("ParseTusMetadata: %v", err)
+	}
+	if meta["filename"] != "a b.txt" || meta["dir"] != "/x" || meta["empty"] != "" {
+		t.Fatalf("unexpected metadata: %v", meta)
+	}
+}
+
+func TestTusResumeAndChecksum(t *testing.T) {
+	dir := t.TempDir()
+	payload := []byte("hello tus world")
+	u, err := CreateTus("c1", int64(len(payload)), map[string]string{"filename": "f.txt", "dir": dir})
+	if err != nil {
+		t.Fatalf("CreateTus: %v", err)
+	}
+
+	// First chunk without checksum.
+	u, err = WriteTus("c1", u.ID, 0, bytes.NewReader(payload[:5]), nil)
+	if err != nil || u.Offset != 5 {
+		t.Fatalf("WriteTus #1: offset=%d err=%v", u.Offset, err)
+	}
+
+	// Wrong offset is rejected.
+	if _, err := WriteTus("c1", u.ID, 3, bytes.NewReader(payload[3:]), nil); !errors.Is(err, ErrTusOffsetMismatch) {
+		t.Fatalf("err = %v, want ErrTusOffsetMismatch", err)
+	}
+
+	// Bad checksum rolls the chunk back.
+	bad, _ := ParseTusChecksum("sha1 " + base64.StdEncoding.EncodeToString(make([]byte, sha1.Size)))
+	if _, err := WriteTus("c1", u.ID, 5, bytes.NewReader(payload[5:]), bad); !errors.Is(err, ErrTusChecksumMismatch) {
+		t.Fatalf("err = %v, want ErrTusChecksumMismatch", err)
+	}
+	if fi, _ := os.Stat(u.StagingPath); fi == nil || fi.Size() != 5 {
+		t.Fatalf("staging file was not rolled back")
+	}
+
+	// Other clients cannot see the upload.
+	if _, err := GetTus("c2", u.ID); !errors.Is(err, ErrTusForbidden) {
+		t.Fatalf("err = %v, want ErrTusForbidden", err)
+	}
+
+	digest := sha1.Sum(payload[5:])
+	good, _ := ParseTusChecksum("sha1 " + base64.StdEncoding.EncodeToString(digest[:]))
+	u, err = WriteTus("c1", u.ID, 5, bytes.NewReader(payload[5:]), good)
+	if err != nil {
+		t.Fatalf("WriteTus #2: %v", err)
+	}
+	if u.FinalPath != filepath.Join(dir, "f.txt") {
+		t.Fatalf("FinalPath = %q", u.FinalPath)
+	}
+	got, _ := os.ReadFile(u.FinalPath)
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("content = %q", got)
+	}
+	if _, err := os.Stat(u.StagingPath); !os.IsNotExist(err) {
+		t.Fatalf("staging file should be gone")
+	}
+}
+
+func TestTusTerminateAndExpire(t *testing.T) {
+	dir := t.TempDir()
+	u, err := CreateTus("c1", 10, map[string]string{"filename": "g.bin", "dir": dir})
+	if err != nil {
+		t.Fatalf("CreateTus: %v", err)
+	}
+	if _, err := WriteTus("c1", u.ID, 0, bytes.NewReader(make([]byte, 11)), nil); !errors.Is(err, ErrTusTooLarge) {
+		t.Fatalf("err = %v, want ErrTusTooLarge", err)
+	}
+	if err := TerminateTus("c1", u.ID); err != nil {
+		t.Fatalf("TerminateTus: %v", err)
+	}
+	if _, err := os.Stat(u.StagingPath); !os.IsNotExist(err) {
+		t.Fatalf("staging file should be removed")
+	}
+
+	u2, err := CreateTus("c1", 10, map[string]string{"filename": "h.bin", "dir": dir})
+	if err != nil {
+		t.Fatalf("CreateTus: %v", err)
+	}
+	if n := CleanupExpiredTus(time.Now().UTC().Add(48 * time.Hour)); n < 1 {
+		t.Fatalf("CleanupExpiredTus removed %d, want at least 1", n)
+	}
+	if _, err := os.Stat(u2.StagingPath); !os.IsNotExist(err) {
+		t.Fatalf("expired staging file should be removed")
+	}
+	if _, err := GetTus("c1", u2.ID); !errors.Is(err, ErrTusNotFound) {
+		t.Fatalf("err = %v, want ErrTusNotFound", err)
+	}
+}